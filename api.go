@@ -0,0 +1,118 @@
+package flagsmithapi
+
+//go:generate mockery
+
+// The interfaces below group the Client methods by resource so that callers can depend on the subset they use and
+// substitute fakes(see the mocks package) or decorators(i.e: caching, metrics) for the concrete *Client.
+
+type ProjectAPI interface {
+	GetProject(projectUUID string) (*Project, error)
+	GetProjectByID(projectID int64) (*Project, error)
+	CreateProject(project *Project) error
+	UpdateProject(project *Project) error
+	DeleteProject(projectID int64) error
+	ListProjects(organisationID int64) ([]Project, error)
+}
+
+type OrganisationAPI interface {
+	GetOrganisationByUUID(orgUUID string) (*Organisation, error)
+	GetOrganisationUsers(orgID int64) ([]User, error)
+	GetOrganisationUserByEmail(orgID int64, email string) (*User, error)
+}
+
+type EnvironmentAPI interface {
+	GetEnvironment(apiKey string) (*Environment, error)
+	GetEnvironmentByUUID(uuid string) (*Environment, error)
+	CreateEnvironment(environment *Environment) error
+	CloneEnvironment(sourceKey, name string, serverSideKeys ...*ServerSideEnvKey) (*Environment, error)
+	UpdateEnvironment(environment *Environment) error
+	DeleteEnvironment(apiKey string) error
+	ListEnvironments(projectID int64) ([]Environment, error)
+	CompareEnvironments(environmentKeys ...string) (*EnvironmentComparison, error)
+	GetEnvironmentDocument(environmentKey string) (*EnvironmentDocument, error)
+	GetServerSideEnvKeys(environmentKey string) ([]ServerSideEnvKey, error)
+	CreateServerSideEnvKey(environmentKey string, key *ServerSideEnvKey) error
+	UpdateServerSideEnvKey(environmentKey string, key *ServerSideEnvKey) error
+	DeleteServerSideEnvKey(environmentKey string, keyID int64) error
+}
+
+type FeatureAPI interface {
+	GetFeature(featureUUID string) (*Feature, error)
+	CreateFeature(feature *Feature) error
+	UpdateFeature(feature *Feature) error
+	DeleteFeature(projectID, featureID int64) error
+	ListFeatures(projectID int64) ([]Feature, error)
+	AddFeatureOwners(feature *Feature, ownerIDs []int64) error
+	RemoveFeatureOwners(feature *Feature, ownerIDs []int64) error
+	AddFeatureGroupOwners(feature *Feature, groupIDs []int64) error
+	RemoveFeatureGroupOwners(feature *Feature, groupIDs []int64) error
+	GetFeatureMVOption(featureUUID, mvOptionUUID string) (*FeatureMultivariateOption, error)
+	ListFeatureMVOptions(projectID, featureID int64) ([]FeatureMultivariateOption, error)
+	CreateFeatureMVOption(featureMVOption *FeatureMultivariateOption) error
+	UpdateFeatureMVOption(featureMVOption *FeatureMultivariateOption) error
+	DeleteFeatureMVOption(projectID, featureID, mvOptionID int64) error
+}
+
+type FeatureStateAPI interface {
+	GetEnvironmentFeatureState(environmentKey string, featureID int64) (*FeatureState, error)
+	GetFeatureState(featureStateUUID string) (*FeatureState, error)
+	ListEnvironmentFeatureStates(environmentKey string) ([]FeatureState, error)
+	ListSegmentOverrides(environmentKey string, featureID int64) ([]FeatureState, error)
+	UpdateFeatureState(featureState *FeatureState, updateSegmentPriority bool) error
+	UpdateFeatureStates(changes []FeatureStateChange, opts BulkUpdateOptions) (*BulkUpdateResult, error)
+	KillFeature(featureUUID string, opts KillOptions) (*FeatureSnapshot, error)
+	RestoreFeature(snapshot *FeatureSnapshot) error
+	CreateSegmentOverride(featureState *FeatureState) error
+	CreateChangeRequest(changeRequest *ChangeRequest) error
+	PromoteEnvironment(sourceKey, destinationKey string, opts PromoteOptions) (*Promotion, error)
+}
+
+type SegmentAPI interface {
+	GetSegment(segmentUUID string) (*Segment, error)
+	CreateSegment(segment *Segment) error
+	UpdateSegment(segment *Segment) error
+	DeleteSegment(projectID, segmentID int64) error
+	ListSegments(projectID int64) ([]Segment, error)
+	GetFeatureSegmentByID(featureSegmentID int64) (*FeatureSegment, error)
+	ListFeatureSegments(environmentID, featureID int64) ([]FeatureSegment, error)
+	CreateFeatureSegment(featureSegment *FeatureSegment) error
+	UpdateFeatureSegmentPriority(featureSegmentID, priority int64) error
+	DeleteFeatureSegment(featureSegmentID int64) error
+}
+
+type TagAPI interface {
+	GetTag(projectUUID string, tagUUID string) (*Tag, error)
+	CreateTag(tag *Tag) error
+	UpdateTag(tag *Tag) error
+	DeleteTag(projectID, tagID int64) error
+	ListTags(projectID int64) ([]Tag, error)
+}
+
+type IdentityAPI interface {
+	GetIdentity(environmentKey string, identityID int64) (*Identity, error)
+	ListIdentities(environmentKey string, query string) ([]Identity, error)
+	CreateIdentity(environmentKey string, identity *Identity) error
+	DeleteIdentity(environmentKey string, identityID int64) error
+	GetTraits(environmentKey string, identityID int64) ([]Trait, error)
+	CreateTrait(environmentKey string, identityID int64, trait *Trait) error
+	UpdateTrait(environmentKey string, identityID int64, trait *Trait) error
+	DeleteTrait(environmentKey string, identityID int64, traitID int64) error
+	ListIdentityOverrides(environmentKey string, identityID int64) ([]FeatureState, error)
+	CreateIdentityOverride(environmentKey string, identityID int64, featureState *FeatureState) error
+	UpdateIdentityOverride(environmentKey string, identityID int64, featureState *FeatureState) error
+	DeleteIdentityOverride(environmentKey string, identityID, featureStateID int64) error
+}
+
+// API is implemented by *Client and groups all of the resource interfaces
+type API interface {
+	ProjectAPI
+	OrganisationAPI
+	EnvironmentAPI
+	FeatureAPI
+	FeatureStateAPI
+	SegmentAPI
+	TagAPI
+	IdentityAPI
+}
+
+var _ API = (*Client)(nil)
@@ -5,9 +5,13 @@ import (
 )
 
 func (c *Client) GetServerSideEnvKeys(environmentKey string) ([]ServerSideEnvKey, error) {
+	c, op := c.startOperation("GetServerSideEnvKeys", "server_side_key", "list",
+		environmentKeyAttribute(environmentKey))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/api-keys/", c.baseURL, environmentKey)
 	keys := []ServerSideEnvKey{}
-	resp, err := c.client.R().SetResult(&keys).Get(url)
+	resp, err := c.request().SetResult(&keys).Get(url)
 	if err != nil {
 		return nil, err
 	}
@@ -18,9 +22,13 @@ func (c *Client) GetServerSideEnvKeys(environmentKey string) ([]ServerSideEnvKey
 	return keys, nil
 }
 func (c *Client) CreateServerSideEnvKey(environmentKey string, key *ServerSideEnvKey) error {
+	c, op := c.startOperation("CreateServerSideEnvKey", "server_side_key", "create",
+		environmentKeyAttribute(environmentKey))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/api-keys/", c.baseURL, environmentKey)
 
-	resp, err := c.client.R().SetBody(key).SetResult(&key).Post(url)
+	resp, err := c.request().SetBody(key).SetResult(&key).Post(url)
 	if err != nil {
 		return err
 	}
@@ -31,8 +39,12 @@ func (c *Client) CreateServerSideEnvKey(environmentKey string, key *ServerSideEn
 
 }
 func (c *Client) UpdateServerSideEnvKey(environmentKey string, key *ServerSideEnvKey) error {
+	c, op := c.startOperation("UpdateServerSideEnvKey", "server_side_key", "update",
+		environmentKeyAttribute(environmentKey), idAttribute("server_side_key", key.ID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/api-keys/%d/", c.baseURL, environmentKey, key.ID)
-	resp, err := c.client.R().SetBody(key).SetResult(&key).Put(url)
+	resp, err := c.request().SetBody(key).SetResult(&key).Put(url)
 	if err != nil {
 		return nil
 	}
@@ -43,8 +55,12 @@ func (c *Client) UpdateServerSideEnvKey(environmentKey string, key *ServerSideEn
 	return nil
 }
 func (c *Client) DeleteServerSideEnvKey(environmentKey string, keyID int64) error {
+	c, op := c.startOperation("DeleteServerSideEnvKey", "server_side_key", "delete",
+		environmentKeyAttribute(environmentKey), idAttribute("server_side_key", keyID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/api-keys/%d/", c.baseURL, environmentKey, keyID)
-	resp, err := c.client.R().Delete(url)
+	resp, err := c.request().Delete(url)
 	if err != nil {
 		return err
 	}
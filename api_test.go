@@ -0,0 +1,53 @@
+package flagsmithapi_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/mocks"
+)
+
+// archiveFeature is an example of code that depends on a resource interface rather than *Client
+func archiveFeature(api flagsmithapi.FeatureAPI, featureUUID string) error {
+	feature, err := api.GetFeature(featureUUID)
+	if err != nil {
+		return err
+	}
+	feature.IsArchived = true
+	return api.UpdateFeature(feature)
+}
+
+func TestClientImplementsAPI(t *testing.T) {
+	var api flagsmithapi.API = flagsmithapi.NewClient(MasterAPIKey, "")
+	assert.NotNil(t, api)
+}
+
+func TestFeatureAPIMock(t *testing.T) {
+	// Given
+	featureAPI := mocks.NewFeatureAPI(t)
+	feature := flagsmithapi.Feature{Name: FeatureName, UUID: FeatureUUID}
+	featureAPI.EXPECT().GetFeature(FeatureUUID).Return(&feature, nil)
+	featureAPI.EXPECT().UpdateFeature(&feature).Return(nil)
+
+	// When
+	err := archiveFeature(featureAPI, FeatureUUID)
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, feature.IsArchived)
+}
+
+func TestFeatureAPIMockError(t *testing.T) {
+	// Given
+	featureAPI := mocks.NewFeatureAPI(t)
+	featureAPI.EXPECT().GetFeature(FeatureUUID).Return(nil, errors.New("boom"))
+
+	// When
+	err := archiveFeature(featureAPI, FeatureUUID)
+
+	// Then
+	assert.EqualError(t, err, "boom")
+}
@@ -0,0 +1,105 @@
+package flagsmithapi
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Schemes of the Authorization header accepted by the Admin API
+const (
+	AuthSchemeAPIKey = "Api-Key"
+	AuthSchemeToken  = "Token"
+)
+
+// Authenticator sets the credentials of the requests of a client(see WithAuthenticator)
+type Authenticator interface {
+	// Authenticate is called before each request is sent; an error fails the request
+	Authenticate(req *http.Request) error
+}
+
+// MasterAPIKey authenticates with an organisation master API key, which is what NewClient uses by default
+type MasterAPIKey string
+
+func (k MasterAPIKey) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", AuthSchemeAPIKey+" "+string(k))
+	return nil
+}
+
+// UserToken authenticates as a user with the API token of their account, so that requests are made with the
+// permissions of the user
+type UserToken string
+
+func (t UserToken) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", AuthSchemeToken+" "+string(t))
+	return nil
+}
+
+// CredentialProvider authenticates with a secret read from an environment variable or a file. The secret is read
+// again when it changes, so that rotated keys are used without recreating the client.
+type CredentialProvider struct {
+	scheme   string
+	variable string
+	path     string
+
+	mu      sync.Mutex
+	secret  string
+	modTime time.Time
+	size    int64
+}
+
+// EnvCredentials reads the secret from the environment variable before each request, i.e:
+//
+//	flagsmithapi.EnvCredentials(flagsmithapi.AuthSchemeToken, "FLAGSMITH_API_TOKEN")
+func EnvCredentials(scheme, variable string) *CredentialProvider {
+	return &CredentialProvider{scheme: scheme, variable: variable}
+}
+
+// FileCredentials reads the secret from the file, i.e: a mounted Kubernetes secret, ignoring surrounding whitespace.
+// The file is read again when its modification time or size changes.
+func FileCredentials(scheme, path string) *CredentialProvider {
+	return &CredentialProvider{scheme: scheme, path: path}
+}
+
+func (p *CredentialProvider) Authenticate(req *http.Request) error {
+	secret, err := p.Secret()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", p.scheme+" "+secret)
+	return nil
+}
+
+// Secret returns the current secret
+func (p *CredentialProvider) Secret() (string, error) {
+	if p.path == "" {
+		secret := strings.TrimSpace(os.Getenv(p.variable))
+		if secret == "" {
+			return "", fmt.Errorf("flagsmithapi: Error loading credentials: %s is not set", p.variable)
+		}
+		return secret, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return "", fmt.Errorf("flagsmithapi: Error loading credentials: %w", err)
+	}
+	if p.secret != "" && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return p.secret, nil
+	}
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return "", fmt.Errorf("flagsmithapi: Error loading credentials: %w", err)
+	}
+	secret := strings.TrimSpace(string(data))
+	if secret == "" {
+		return "", fmt.Errorf("flagsmithapi: Error loading credentials: '%s' is empty", p.path)
+	}
+	p.secret, p.modTime, p.size = secret, info.ModTime(), info.Size()
+	return secret, nil
+}
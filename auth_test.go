@@ -0,0 +1,81 @@
+package flagsmithapi_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+func TestUserToken(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	token := flagsmithapi.UserToken(flagsmithtest.UserToken)
+	client := flagsmithapi.NewClient("", server.URL, flagsmithapi.WithAuthenticator(token))
+
+	// When
+	projects, err := client.ListProjects(server.Organisation().ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Empty(t, projects)
+}
+
+func TestEnvCredentials(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	provider := flagsmithapi.EnvCredentials(flagsmithapi.AuthSchemeAPIKey, "FLAGSMITHAPI_TEST_KEY")
+	client := flagsmithapi.NewClient("", server.URL, flagsmithapi.WithAuthenticator(provider))
+
+	// When
+	_, err := client.ListProjects(server.Organisation().ID)
+
+	// Then
+	assert.ErrorContains(t, err, "flagsmithapi: Error loading credentials: FLAGSMITHAPI_TEST_KEY is not set")
+
+	// When
+	t.Setenv("FLAGSMITHAPI_TEST_KEY", flagsmithtest.MasterAPIKey)
+	_, err = client.ListProjects(server.Organisation().ID)
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestFileCredentialsReloadRotatedSecrets(t *testing.T) {
+	// Given
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+	provider := flagsmithapi.FileCredentials(flagsmithapi.AuthSchemeToken, path)
+
+	// When
+	secret, err := provider.Secret()
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "first", secret)
+
+	// When
+	require.NoError(t, os.WriteFile(path, []byte("second"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	secret, err = provider.Secret()
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "second", secret)
+
+	// When
+	require.NoError(t, os.WriteFile(path, []byte(" \n"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+	_, err = provider.Secret()
+
+	// Then
+	assert.EqualError(t, err, "flagsmithapi: Error loading credentials: '"+path+"' is empty")
+}
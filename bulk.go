@@ -0,0 +1,155 @@
+package flagsmithapi
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DefaultBulkConcurrency is the number of changes UpdateFeatureStates applies at the same time when
+// BulkUpdateOptions.Concurrency is not set
+const DefaultBulkConcurrency = 4
+
+// FeatureStateChange is a change of the environment default state of a feature
+type FeatureStateChange struct {
+	EnvironmentKey string
+	FeatureID      int64
+	// Enabled and Value are left unchanged when nil
+	Enabled *bool
+	Value   *FeatureStateValue
+}
+
+type BulkUpdateOptions struct {
+	// Concurrency is the maximum number of changes applied at the same time; defaults to DefaultBulkConcurrency
+	Concurrency int
+	// StopOnError skips the changes that have not started once a change fails
+	StopOnError bool
+	// Rollback restores the previous state of the applied changes once a change fails; implies StopOnError
+	Rollback bool
+}
+
+type FeatureStateChangeResult struct {
+	Change FeatureStateChange
+	// Previous is the feature state before the change and Updated the feature state after it; Updated is nil if the
+	// change was not applied
+	Previous *FeatureState
+	Updated  *FeatureState
+	Err      error
+	// Skipped is set for the changes that were not attempted because another change failed
+	Skipped bool
+	// RolledBack is set when the previous state was restored; RollbackErr is the error restoring it otherwise
+	RolledBack  bool
+	RollbackErr error
+}
+
+type BulkUpdateResult struct {
+	// Results are in the order of the changes
+	Results []FeatureStateChangeResult
+}
+
+// UpdateFeatureStates applies the changes with at most opts.Concurrency requests in flight. Every change is
+// reported in the result; the error is a BulkUpdateError if any change(or rollback) failed.
+func (c *Client) UpdateFeatureStates(changes []FeatureStateChange, opts BulkUpdateOptions) (*BulkUpdateResult, error) {
+	c, op := c.startOperation("UpdateFeatureStates", "feature_state", "bulk_update",
+		attribute.Int("flagsmith.changes", len(changes)))
+	defer op.end()
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+	stopOnError := opts.StopOnError || opts.Rollback
+	result := &BulkUpdateResult{Results: make([]FeatureStateChangeResult, len(changes))}
+
+	var failed atomic.Bool
+	forEachConcurrently(len(changes), concurrency, func(i int) {
+		r := &result.Results[i]
+		r.Change = changes[i]
+		if stopOnError && failed.Load() {
+			r.Skipped = true
+			return
+		}
+		r.Previous, r.Updated, r.Err = c.applyFeatureStateChange(changes[i])
+		if r.Err != nil {
+			failed.Store(true)
+		}
+	})
+
+	if opts.Rollback && failed.Load() {
+		forEachConcurrently(len(changes), concurrency, func(i int) {
+			r := &result.Results[i]
+			if r.Updated == nil {
+				return
+			}
+			previous := *r.Previous
+			r.RollbackErr = c.UpdateFeatureState(&previous, false)
+			r.RolledBack = r.RollbackErr == nil
+		})
+	}
+	if err := result.Err(); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+func (c *Client) applyFeatureStateChange(change FeatureStateChange) (*FeatureState, *FeatureState, error) {
+	featureState, err := c.GetEnvironmentFeatureState(change.EnvironmentKey, change.FeatureID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// decoding the response replaces the values and slices of the feature state, so a shallow copy is a snapshot
+	previous := *featureState
+	previous.EnvironmentKey = change.EnvironmentKey
+	if change.Enabled != nil {
+		featureState.Enabled = *change.Enabled
+	}
+	if change.Value != nil {
+		featureState.FeatureStateValue = change.Value
+	}
+	if err := c.UpdateFeatureState(featureState, false); err != nil {
+		return &previous, nil, err
+	}
+	return &previous, featureState, nil
+}
+
+// Err returns a BulkUpdateError listing the failed changes and rollbacks, or nil
+func (r *BulkUpdateResult) Err() error {
+	err := BulkUpdateError{total: len(r.Results)}
+	for _, result := range r.Results {
+		if result.Err != nil {
+			err.failed++
+			err.errs = append(err.errs, fmt.Errorf("environment '%s', feature %d: %w",
+				result.Change.EnvironmentKey, result.Change.FeatureID, result.Err))
+		}
+		if result.RollbackErr != nil {
+			err.errs = append(err.errs, fmt.Errorf("rolling back environment '%s', feature %d: %w",
+				result.Change.EnvironmentKey, result.Change.FeatureID, result.RollbackErr))
+		}
+	}
+	if len(err.errs) == 0 {
+		return nil
+	}
+	return err
+}
+
+// forEachConcurrently calls fn with the indexes 0 to n-1, in order, from at most concurrency goroutines
+func forEachConcurrently(n, concurrency int, fn func(i int)) {
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < concurrency && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
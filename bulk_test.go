@@ -0,0 +1,197 @@
+package flagsmithapi_test
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+// inFlightTransport records the maximum number of concurrent PUT requests
+type inFlightTransport struct {
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (t *inFlightTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPut {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+	t.mu.Lock()
+	t.inFlight++
+	if t.inFlight > t.maxInFlight {
+		t.maxInFlight = t.inFlight
+	}
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		t.inFlight--
+		t.mu.Unlock()
+	}()
+	time.Sleep(5 * time.Millisecond)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// enableEverywhere returns the changes enabling the feature with the value "on" in each environment
+func enableEverywhere(environments []flagsmithapi.Environment, featureID int64) []flagsmithapi.FeatureStateChange {
+	enabled := true
+	value := "on"
+	changes := []flagsmithapi.FeatureStateChange{}
+	for _, environment := range environments {
+		changes = append(changes, flagsmithapi.FeatureStateChange{
+			EnvironmentKey: environment.APIKey,
+			FeatureID:      featureID,
+			Enabled:        &enabled,
+			Value:          &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value},
+		})
+	}
+	return changes
+}
+
+func TestUpdateFeatureStates(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	transport := &inFlightTransport{}
+	client := server.Client(flagsmithapi.WithTransport(transport))
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	environments := []flagsmithapi.Environment{}
+	for i := 0; i < 6; i++ {
+		environment := flagsmithapi.Environment{Name: "environment", ProjectID: project.ID}
+		require.NoError(t, client.CreateEnvironment(&environment))
+		environments = append(environments, environment)
+	}
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID, InitialValue: "1"}
+	require.NoError(t, client.CreateFeature(&feature))
+
+	// When
+	changes := enableEverywhere(environments, *feature.ID)
+	result, err := client.UpdateFeatureStates(changes, flagsmithapi.BulkUpdateOptions{Concurrency: 2})
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, result.Results, 6)
+	for i, environment := range environments {
+		r := result.Results[i]
+		assert.Equal(t, environment.APIKey, r.Change.EnvironmentKey)
+		assert.False(t, r.Previous.Enabled)
+		assert.True(t, r.Updated.Enabled)
+		featureState, err := client.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+		require.NoError(t, err)
+		assert.True(t, featureState.Enabled)
+		assert.Equal(t, "on", *featureState.FeatureStateValue.StringValue)
+	}
+	assert.Equal(t, 2, transport.maxInFlight)
+}
+
+func TestUpdateFeatureStatesAggregatesErrors(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	environments := []flagsmithapi.Environment{}
+	for i := 0; i < 2; i++ {
+		environment := flagsmithapi.Environment{Name: "environment", ProjectID: project.ID}
+		require.NoError(t, client.CreateEnvironment(&environment))
+		environments = append(environments, environment)
+	}
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID, InitialValue: "1"}
+	require.NoError(t, client.CreateFeature(&feature))
+	changes := enableEverywhere(environments, *feature.ID)
+	changes = append(changes[:1], flagsmithapi.FeatureStateChange{EnvironmentKey: "missing", FeatureID: *feature.ID}, changes[1])
+
+	// When
+	result, err := client.UpdateFeatureStates(changes, flagsmithapi.BulkUpdateOptions{Concurrency: 1})
+
+	// Then
+	var bulkErr flagsmithapi.BulkUpdateError
+	require.True(t, errors.As(err, &bulkErr))
+	assert.ErrorContains(t, err, "flagsmithapi: 1 of 3 feature state changes failed: environment 'missing', feature ")
+	assert.NoError(t, result.Results[0].Err)
+	assert.Error(t, result.Results[1].Err)
+	assert.NoError(t, result.Results[2].Err)
+	featureState, err := client.GetEnvironmentFeatureState(environments[1].APIKey, *feature.ID)
+	require.NoError(t, err)
+	assert.True(t, featureState.Enabled)
+}
+
+func TestUpdateFeatureStatesStopsOnError(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	environments := []flagsmithapi.Environment{}
+	for i := 0; i < 2; i++ {
+		environment := flagsmithapi.Environment{Name: "environment", ProjectID: project.ID}
+		require.NoError(t, client.CreateEnvironment(&environment))
+		environments = append(environments, environment)
+	}
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID, InitialValue: "1"}
+	require.NoError(t, client.CreateFeature(&feature))
+	changes := enableEverywhere(environments, *feature.ID)
+	changes = append(changes[:1], flagsmithapi.FeatureStateChange{EnvironmentKey: "missing", FeatureID: *feature.ID}, changes[1])
+
+	// When
+	result, err := client.UpdateFeatureStates(changes, flagsmithapi.BulkUpdateOptions{Concurrency: 1, StopOnError: true})
+
+	// Then
+	assert.Error(t, err)
+	assert.NotNil(t, result.Results[0].Updated)
+	assert.False(t, result.Results[0].RolledBack)
+	assert.True(t, result.Results[2].Skipped)
+	featureState, err := client.GetEnvironmentFeatureState(environments[0].APIKey, *feature.ID)
+	require.NoError(t, err)
+	assert.True(t, featureState.Enabled)
+	featureState, err = client.GetEnvironmentFeatureState(environments[1].APIKey, *feature.ID)
+	require.NoError(t, err)
+	assert.False(t, featureState.Enabled)
+}
+
+func TestUpdateFeatureStatesRollsBack(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	environments := []flagsmithapi.Environment{}
+	for i := 0; i < 2; i++ {
+		environment := flagsmithapi.Environment{Name: "environment", ProjectID: project.ID}
+		require.NoError(t, client.CreateEnvironment(&environment))
+		environments = append(environments, environment)
+	}
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID, InitialValue: "1"}
+	require.NoError(t, client.CreateFeature(&feature))
+	initial, err := client.GetEnvironmentFeatureState(environments[0].APIKey, *feature.ID)
+	require.NoError(t, err)
+	changes := enableEverywhere(environments, *feature.ID)
+	changes = append(changes[:1], flagsmithapi.FeatureStateChange{EnvironmentKey: "missing", FeatureID: *feature.ID}, changes[1])
+
+	// When
+	result, err := client.UpdateFeatureStates(changes, flagsmithapi.BulkUpdateOptions{Concurrency: 1, Rollback: true})
+
+	// Then
+	assert.Error(t, err)
+	assert.True(t, result.Results[0].RolledBack)
+	assert.NoError(t, result.Results[0].RollbackErr)
+	assert.True(t, result.Results[2].Skipped)
+	for _, environment := range environments {
+		featureState, err := client.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+		require.NoError(t, err)
+		assert.False(t, featureState.Enabled)
+		assert.Equal(t, initial.FeatureStateValue, featureState.FeatureStateValue)
+	}
+}
@@ -0,0 +1,133 @@
+// Package bundle exports a Flagsmith project to a portable JSON bundle and imports bundles into new projects, for
+// backups and for copying projects between Flagsmith instances.
+//
+// Bundles identify resources by UUID and name rather than by the IDs of the instance they were exported from;
+// references between resources(i.e: the segment of a segment override) use the UUID of the referenced resource in
+// the bundle. Identities, feature owners and environment API keys are instance specific and are not exported, and
+// neither are feature-specific segments.
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// Version is the version of the bundle format written by Export
+const Version = 1
+
+type Bundle struct {
+	Version      int           `json:"version"`
+	ExportedAt   time.Time     `json:"exported_at"`
+	Project      Project       `json:"project"`
+	Tags         []Tag         `json:"tags"`
+	Segments     []Segment     `json:"segments"`
+	Features     []Feature     `json:"features"`
+	Environments []Environment `json:"environments"`
+}
+
+type Project struct {
+	UUID                           string `json:"uuid"`
+	Name                           string `json:"name"`
+	HideDisabledFlags              bool   `json:"hide_disabled_flags"`
+	PreventFlagDefaults            bool   `json:"prevent_flag_defaults"`
+	OnlyAllowLowerCaseFeatureNames bool   `json:"only_allow_lower_case_feature_names"`
+	FeatureNameRegex               string `json:"feature_name_regex,omitempty"`
+	StaleFlagsLimitDays            int64  `json:"stale_flags_limit_days,omitempty"`
+	EnableRealtimeUpdates          bool   `json:"enable_realtime_updates"`
+}
+
+type Tag struct {
+	UUID        string `json:"uuid"`
+	Name        string `json:"name"`
+	Colour      string `json:"colour"`
+	Description string `json:"description,omitempty"`
+}
+
+type Segment struct {
+	UUID        string              `json:"uuid"`
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Rules       []flagsmithapi.Rule `json:"rules"`
+}
+
+type Feature struct {
+	UUID           string `json:"uuid"`
+	Name           string `json:"name"`
+	Type           string `json:"type,omitempty"`
+	Description    string `json:"description,omitempty"`
+	InitialValue   string `json:"initial_value,omitempty"`
+	DefaultEnabled bool   `json:"default_enabled"`
+	IsArchived     bool   `json:"is_archived"`
+	// Tags are the UUIDs of the feature tags
+	Tags                []string             `json:"tags"`
+	MultivariateOptions []MultivariateOption `json:"multivariate_options"`
+}
+
+type MultivariateOption struct {
+	UUID                        string                          `json:"uuid"`
+	Value                       *flagsmithapi.FeatureStateValue `json:"value"`
+	DefaultPercentageAllocation float64                         `json:"default_percentage_allocation"`
+}
+
+type Environment struct {
+	UUID                              string         `json:"uuid"`
+	Name                              string         `json:"name"`
+	Description                       string         `json:"description,omitempty"`
+	AllowClientTraits                 bool           `json:"allow_client_traits"`
+	BannerText                        string         `json:"banner_text,omitempty"`
+	BannerColour                      string         `json:"banner_colour,omitempty"`
+	HideDisabledFlags                 bool           `json:"hide_disabled_flags"`
+	HideSensitiveData                 bool           `json:"hide_sensitive_data"`
+	UseIdentityCompositeKeyForHashing bool           `json:"use_identity_composite_key_for_hashing"`
+	MinimumChangeRequestApprovals     int64          `json:"minimum_change_request_approvals,omitempty"`
+	FeatureStates                     []FeatureState `json:"feature_states"`
+}
+
+// FeatureState is the environment default state of a feature and its segment overrides
+type FeatureState struct {
+	// Feature is the UUID of the feature
+	Feature string                          `json:"feature"`
+	Enabled bool                            `json:"enabled"`
+	Value   *flagsmithapi.FeatureStateValue `json:"value"`
+	// MultivariateAllocations are the percentages of identities served each multivariate option in the environment;
+	// bundles exported before they were added have none, and the options keep their default allocation on import
+	MultivariateAllocations []MultivariateAllocation `json:"multivariate_allocations,omitempty"`
+	SegmentOverrides        []SegmentOverride        `json:"segment_overrides"`
+}
+
+type MultivariateAllocation struct {
+	// Option is the UUID of the multivariate option
+	Option               string  `json:"option"`
+	PercentageAllocation float64 `json:"percentage_allocation"`
+}
+
+type SegmentOverride struct {
+	// Segment is the UUID of the segment
+	Segment  string                          `json:"segment"`
+	Priority int64                           `json:"priority"`
+	Enabled  bool                            `json:"enabled"`
+	Value    *flagsmithapi.FeatureStateValue `json:"value"`
+}
+
+// Write writes the bundle as indented JSON
+func (b *Bundle) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(b)
+}
+
+// Read decodes a bundle and checks that its version is supported
+func Read(r io.Reader) (*Bundle, error) {
+	b := Bundle{}
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return nil, fmt.Errorf("bundle: Error decoding bundle: %w", err)
+	}
+	if b.Version < 1 || b.Version > Version {
+		return nil, fmt.Errorf("bundle: unsupported bundle version %d", b.Version)
+	}
+	return &b, nil
+}
@@ -0,0 +1,212 @@
+package bundle_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/bundle"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+// seedProject creates a project with a resource of every exported type
+func seedProject(t *testing.T, server *flagsmithtest.Server) *flagsmithapi.Project {
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID, StaleFlagsLimitDays: 30}
+	require.NoError(t, client.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID, AllowClientTraits: true}
+	require.NoError(t, client.CreateEnvironment(&environment))
+
+	tag := flagsmithapi.Tag{Name: "backend", Colour: "#FF0000", ProjectID: &project.ID}
+	require.NoError(t, client.CreateTag(&tag))
+	segment := flagsmithapi.Segment{
+		Name:      "pro_users",
+		ProjectID: &project.ID,
+		Rules:     []flagsmithapi.Rule{flagsmithapi.All(flagsmithapi.Any(flagsmithapi.Eq("plan", "pro")))},
+	}
+	require.NoError(t, client.CreateSegment(&segment))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID, InitialValue: "10", Tags: []int64{*tag.ID}}
+	require.NoError(t, client.CreateFeature(&feature))
+	blue := "blue"
+	option := flagsmithapi.FeatureMultivariateOption{
+		Type: "unicode", StringValue: &blue, DefaultPercentageAllocation: 30, FeatureID: feature.ID, ProjectID: &project.ID,
+	}
+	require.NoError(t, client.CreateFeatureMVOption(&option))
+
+	featureState, err := client.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+	require.NoError(t, err)
+	featureState.Enabled = true
+	// a non-default allocation of the option in the environment
+	require.Len(t, featureState.MultivariateFeatureStateValues, 1)
+	featureState.MultivariateFeatureStateValues[0].PercentageAllocation = 55
+	require.NoError(t, client.UpdateFeatureState(featureState, false))
+	value := "pro"
+	priority := int64(0)
+	override := flagsmithapi.FeatureState{
+		Feature:           *feature.ID,
+		Enabled:           true,
+		EnvironmentKey:    environment.APIKey,
+		Segment:           segment.ID,
+		SegmentPriority:   &priority,
+		FeatureStateValue: &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value},
+	}
+	require.NoError(t, client.CreateSegmentOverride(&override))
+	return &project
+}
+
+func TestExport(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	project := seedProject(t, server)
+
+	// When
+	b, err := bundle.Export(server.Client(), project.UUID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, bundle.Version, b.Version)
+	assert.Equal(t, int64(30), b.Project.StaleFlagsLimitDays)
+	require.Len(t, b.Tags, 1)
+	require.Len(t, b.Segments, 1)
+	require.Len(t, b.Features, 1)
+	assert.Equal(t, []string{b.Tags[0].UUID}, b.Features[0].Tags)
+	require.Len(t, b.Features[0].MultivariateOptions, 1)
+	assert.Equal(t, "blue", *b.Features[0].MultivariateOptions[0].Value.StringValue)
+
+	require.Len(t, b.Environments, 1)
+	featureStates := b.Environments[0].FeatureStates
+	require.Len(t, featureStates, 1)
+	assert.Equal(t, b.Features[0].UUID, featureStates[0].Feature)
+	assert.True(t, featureStates[0].Enabled)
+	assert.Equal(t, int64(10), *featureStates[0].Value.IntegerValue)
+	assert.Equal(t, []bundle.MultivariateAllocation{
+		{Option: b.Features[0].MultivariateOptions[0].UUID, PercentageAllocation: 55},
+	}, featureStates[0].MultivariateAllocations)
+	require.Len(t, featureStates[0].SegmentOverrides, 1)
+	assert.Equal(t, b.Segments[0].UUID, featureStates[0].SegmentOverrides[0].Segment)
+	assert.Equal(t, "pro", *featureStates[0].SegmentOverrides[0].Value.StringValue)
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	// Given
+	source := flagsmithtest.NewServer()
+	defer source.Close()
+	project := seedProject(t, source)
+	exported, err := bundle.Export(source.Client(), project.UUID)
+	require.NoError(t, err)
+	buf := bytes.Buffer{}
+	require.NoError(t, exported.Write(&buf))
+
+	// a second instance, where IDs differ from the source instance
+	target := flagsmithtest.NewServer()
+	defer target.Close()
+	targetClient := target.Client()
+	for i := 0; i < 3; i++ {
+		padding := flagsmithapi.Project{Name: "padding", Organisation: target.Organisation().ID}
+		require.NoError(t, targetClient.CreateProject(&padding))
+	}
+
+	// When
+	read, err := bundle.Read(&buf)
+	require.NoError(t, err)
+	result, err := bundle.Import(targetClient, read, bundle.ImportOptions{OrganisationID: target.Organisation().ID, ProjectName: "copy"})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "copy", result.Project.Name)
+	assert.NotEqual(t, project.ID, result.Project.ID)
+
+	environment := result.Environments[exported.Environments[0].UUID]
+	feature := result.Features[exported.Features[0].UUID]
+	overrides, err := targetClient.ListSegmentOverrides(environment.APIKey, *feature.ID)
+	require.NoError(t, err)
+	require.Len(t, overrides, 1)
+	assert.Equal(t, *result.Segments[exported.Segments[0].UUID].ID, *overrides[0].Segment)
+	featureState, err := targetClient.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+	require.NoError(t, err)
+	option := result.MultivariateOptions[exported.Features[0].MultivariateOptions[0].UUID]
+	require.Len(t, featureState.MultivariateFeatureStateValues, 1)
+	assert.Equal(t, option.ID, featureState.MultivariateFeatureStateValues[0].MultivariateFeatureOption)
+	assert.Equal(t, 55.0, featureState.MultivariateFeatureStateValues[0].PercentageAllocation)
+
+	reexported, err := bundle.Export(targetClient, result.Project.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, normalise(exported), normalise(reexported))
+}
+
+// normalise replaces the instance specific parts of a bundle (UUIDs and export time) with names so that bundles
+// exported from different instances can be compared
+func normalise(b *bundle.Bundle) *bundle.Bundle {
+	names := map[string]string{}
+	for i := range b.Tags {
+		names[b.Tags[i].UUID] = "tag:" + b.Tags[i].Name
+		b.Tags[i].UUID = ""
+	}
+	for i := range b.Segments {
+		names[b.Segments[i].UUID] = "segment:" + b.Segments[i].Name
+		b.Segments[i].UUID = ""
+	}
+	for i := range b.Features {
+		feature := &b.Features[i]
+		names[feature.UUID] = "feature:" + feature.Name
+		feature.UUID = ""
+		for j := range feature.Tags {
+			feature.Tags[j] = names[feature.Tags[j]]
+		}
+		for j := range feature.MultivariateOptions {
+			names[feature.MultivariateOptions[j].UUID] = fmt.Sprintf("option:%s/%d", feature.Name, j)
+			feature.MultivariateOptions[j].UUID = ""
+		}
+	}
+	for i := range b.Environments {
+		environment := &b.Environments[i]
+		environment.UUID = ""
+		for j := range environment.FeatureStates {
+			featureState := &environment.FeatureStates[j]
+			featureState.Feature = names[featureState.Feature]
+			for k := range featureState.MultivariateAllocations {
+				featureState.MultivariateAllocations[k].Option = names[featureState.MultivariateAllocations[k].Option]
+			}
+			for k := range featureState.SegmentOverrides {
+				featureState.SegmentOverrides[k].Segment = names[featureState.SegmentOverrides[k].Segment]
+			}
+		}
+	}
+	b.Project.UUID = ""
+	b.Project.Name = ""
+	b.ExportedAt = time.Time{}
+	return b
+}
+
+func TestReadRejectsUnsupportedVersion(t *testing.T) {
+	// When
+	_, err := bundle.Read(strings.NewReader(`{"version": 99}`))
+
+	// Then
+	assert.EqualError(t, err, "bundle: unsupported bundle version 99")
+}
+
+func TestImportRejectsDanglingReferences(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	b := &bundle.Bundle{
+		Version:  bundle.Version,
+		Project:  bundle.Project{Name: "project"},
+		Features: []bundle.Feature{{UUID: "feature-uuid", Name: "checkout_v2", Tags: []string{"missing-tag"}}},
+	}
+
+	// When
+	_, err := bundle.Import(server.Client(), b, bundle.ImportOptions{OrganisationID: server.Organisation().ID})
+
+	// Then
+	assert.EqualError(t, err, "bundle: feature 'checkout_v2' references unknown tag 'missing-tag'")
+	assert.Equal(t, 0, server.RequestCount("POST", "/projects/"))
+}
@@ -0,0 +1,166 @@
+package bundle
+
+import (
+	"time"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// Export reads the project and all of its resources into a bundle
+func Export(api flagsmithapi.API, projectUUID string) (*Bundle, error) {
+	project, err := api.GetProject(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	b := &Bundle{
+		Version:    Version,
+		ExportedAt: time.Now().UTC(),
+		Project: Project{
+			UUID:                           project.UUID,
+			Name:                           project.Name,
+			HideDisabledFlags:              project.HideDisabledFlags,
+			PreventFlagDefaults:            project.PreventFlagDefaults,
+			OnlyAllowLowerCaseFeatureNames: project.OnlyAllowLowerCaseFeatureNames,
+			FeatureNameRegex:               project.FeatureNameRegex,
+			StaleFlagsLimitDays:            project.StaleFlagsLimitDays,
+			EnableRealtimeUpdates:          project.EnableRealtimeUpdates,
+		},
+		Tags:         []Tag{},
+		Segments:     []Segment{},
+		Features:     []Feature{},
+		Environments: []Environment{},
+	}
+
+	tags, err := api.ListTags(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	tagUUIDs := map[int64]string{}
+	for _, tag := range tags {
+		tagUUIDs[*tag.ID] = tag.UUID
+		b.Tags = append(b.Tags, Tag{UUID: tag.UUID, Name: tag.Name, Colour: tag.Colour, Description: stringValue(tag.Description)})
+	}
+
+	segments, err := api.ListSegments(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	segmentUUIDs := map[int64]string{}
+	for _, segment := range segments {
+		// feature-specific segments(and their overrides) are not supported by the bundle format
+		if segment.FeatureID != nil {
+			continue
+		}
+		segmentUUIDs[*segment.ID] = segment.UUID
+		b.Segments = append(b.Segments, Segment{
+			UUID: segment.UUID, Name: segment.Name, Description: stringValue(segment.Description), Rules: segment.Rules,
+		})
+	}
+
+	features, err := api.ListFeatures(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	featureUUIDs := map[int64]string{}
+	optionUUIDs := map[int64]string{}
+	for _, feature := range features {
+		featureUUIDs[*feature.ID] = feature.UUID
+		exported := Feature{
+			UUID:                feature.UUID,
+			Name:                feature.Name,
+			Type:                stringValue(feature.Type),
+			Description:         stringValue(feature.Description),
+			InitialValue:        feature.InitialValue,
+			DefaultEnabled:      feature.DefaultEnabled,
+			IsArchived:          feature.IsArchived,
+			Tags:                []string{},
+			MultivariateOptions: []MultivariateOption{},
+		}
+		for _, tagID := range feature.Tags {
+			exported.Tags = append(exported.Tags, tagUUIDs[tagID])
+		}
+		options, err := api.ListFeatureMVOptions(project.ID, *feature.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, option := range options {
+			optionUUIDs[option.ID] = option.UUID
+			exported.MultivariateOptions = append(exported.MultivariateOptions, MultivariateOption{
+				UUID: option.UUID,
+				Value: &flagsmithapi.FeatureStateValue{
+					Type:         option.Type,
+					StringValue:  option.StringValue,
+					IntegerValue: option.IntegerValue,
+					BooleanValue: option.BooleanValue,
+				},
+				DefaultPercentageAllocation: option.DefaultPercentageAllocation,
+			})
+		}
+		b.Features = append(b.Features, exported)
+	}
+
+	environments, err := api.ListEnvironments(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, environment := range environments {
+		exported := Environment{
+			UUID:                              environment.UUID,
+			Name:                              environment.Name,
+			Description:                       environment.Description,
+			AllowClientTraits:                 environment.AllowClientTraits,
+			BannerText:                        environment.BannerText,
+			BannerColour:                      environment.BannerColour,
+			HideDisabledFlags:                 environment.HideDisabledFlags,
+			HideSensitiveData:                 environment.HideSensitiveData,
+			UseIdentityCompositeKeyForHashing: environment.UseIdentityCompositeKeyForHashing,
+			MinimumChangeRequestApprovals:     environment.MinimumChangeRequestApprovals,
+			FeatureStates:                     []FeatureState{},
+		}
+		featureStates, err := api.ListEnvironmentFeatureStates(environment.APIKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, featureState := range featureStates {
+			state := FeatureState{
+				Feature:                 featureUUIDs[featureState.Feature],
+				Enabled:                 featureState.Enabled,
+				Value:                   featureState.FeatureStateValue,
+				MultivariateAllocations: []MultivariateAllocation{},
+				SegmentOverrides:        []SegmentOverride{},
+			}
+			for _, value := range featureState.MultivariateFeatureStateValues {
+				state.MultivariateAllocations = append(state.MultivariateAllocations, MultivariateAllocation{
+					Option:               optionUUIDs[value.MultivariateFeatureOption],
+					PercentageAllocation: value.PercentageAllocation,
+				})
+			}
+			overrides, err := api.ListSegmentOverrides(environment.APIKey, featureState.Feature)
+			if err != nil {
+				return nil, err
+			}
+			for _, override := range overrides {
+				segmentUUID, ok := segmentUUIDs[*override.Segment]
+				if !ok {
+					continue
+				}
+				state.SegmentOverrides = append(state.SegmentOverrides, SegmentOverride{
+					Segment:  segmentUUID,
+					Priority: *override.SegmentPriority,
+					Enabled:  override.Enabled,
+					Value:    override.FeatureStateValue,
+				})
+			}
+			exported.FeatureStates = append(exported.FeatureStates, state)
+		}
+		b.Environments = append(b.Environments, exported)
+	}
+	return b, nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,255 @@
+package bundle
+
+import (
+	"fmt"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+type ImportOptions struct {
+	// OrganisationID is the organisation the project is created in
+	OrganisationID int64
+	// ProjectName overrides the name of the project in the bundle
+	ProjectName string
+}
+
+// ImportResult maps the UUIDs of the bundle to the resources created by Import
+type ImportResult struct {
+	Project *flagsmithapi.Project
+
+	Tags                map[string]*flagsmithapi.Tag
+	Segments            map[string]*flagsmithapi.Segment
+	Features            map[string]*flagsmithapi.Feature
+	MultivariateOptions map[string]*flagsmithapi.FeatureMultivariateOption
+	Environments        map[string]*flagsmithapi.Environment
+}
+
+// Import creates a new project from the bundle. Resources are created in dependency order and references are remapped
+// to the IDs of the created resources; if an error occurs, the resources created so far are returned with the error
+// and are not cleaned up.
+func Import(api flagsmithapi.API, b *Bundle, opts ImportOptions) (*ImportResult, error) {
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	result := &ImportResult{
+		Tags:                map[string]*flagsmithapi.Tag{},
+		Segments:            map[string]*flagsmithapi.Segment{},
+		Features:            map[string]*flagsmithapi.Feature{},
+		MultivariateOptions: map[string]*flagsmithapi.FeatureMultivariateOption{},
+		Environments:        map[string]*flagsmithapi.Environment{},
+	}
+
+	project := flagsmithapi.Project{
+		Name:                           b.Project.Name,
+		Organisation:                   opts.OrganisationID,
+		HideDisabledFlags:              b.Project.HideDisabledFlags,
+		PreventFlagDefaults:            b.Project.PreventFlagDefaults,
+		OnlyAllowLowerCaseFeatureNames: b.Project.OnlyAllowLowerCaseFeatureNames,
+		FeatureNameRegex:               b.Project.FeatureNameRegex,
+		StaleFlagsLimitDays:            b.Project.StaleFlagsLimitDays,
+		EnableRealtimeUpdates:          b.Project.EnableRealtimeUpdates,
+	}
+	if opts.ProjectName != "" {
+		project.Name = opts.ProjectName
+	}
+	if err := api.CreateProject(&project); err != nil {
+		return nil, err
+	}
+	result.Project = &project
+
+	for _, tag := range b.Tags {
+		created := flagsmithapi.Tag{Name: tag.Name, Colour: tag.Colour, ProjectID: &project.ID}
+		if tag.Description != "" {
+			created.Description = &tag.Description
+		}
+		if err := api.CreateTag(&created); err != nil {
+			return result, importError("tag", tag.Name, err)
+		}
+		result.Tags[tag.UUID] = &created
+	}
+
+	for _, segment := range b.Segments {
+		created := flagsmithapi.Segment{Name: segment.Name, ProjectID: &project.ID, Rules: segment.Rules}
+		if segment.Description != "" {
+			created.Description = &segment.Description
+		}
+		if err := api.CreateSegment(&created); err != nil {
+			return result, importError("segment", segment.Name, err)
+		}
+		result.Segments[segment.UUID] = &created
+	}
+
+	for _, feature := range b.Features {
+		created := flagsmithapi.Feature{
+			Name:           feature.Name,
+			InitialValue:   feature.InitialValue,
+			DefaultEnabled: feature.DefaultEnabled,
+			IsArchived:     feature.IsArchived,
+			Tags:           []int64{},
+			ProjectID:      &project.ID,
+		}
+		if feature.Type != "" {
+			created.Type = &feature.Type
+		}
+		if feature.Description != "" {
+			created.Description = &feature.Description
+		}
+		for _, tagUUID := range feature.Tags {
+			created.Tags = append(created.Tags, *result.Tags[tagUUID].ID)
+		}
+		if err := api.CreateFeature(&created); err != nil {
+			return result, importError("feature", feature.Name, err)
+		}
+		result.Features[feature.UUID] = &created
+
+		for _, option := range feature.MultivariateOptions {
+			createdOption := flagsmithapi.FeatureMultivariateOption{
+				Type:                        option.Value.Type,
+				StringValue:                 option.Value.StringValue,
+				IntegerValue:                option.Value.IntegerValue,
+				BooleanValue:                option.Value.BooleanValue,
+				DefaultPercentageAllocation: option.DefaultPercentageAllocation,
+				FeatureID:                   created.ID,
+				ProjectID:                   &project.ID,
+			}
+			if err := api.CreateFeatureMVOption(&createdOption); err != nil {
+				return result, importError("multivariate option of feature", feature.Name, err)
+			}
+			result.MultivariateOptions[option.UUID] = &createdOption
+		}
+	}
+
+	for _, environment := range b.Environments {
+		created := flagsmithapi.Environment{
+			Name:                              environment.Name,
+			Description:                       environment.Description,
+			ProjectID:                         project.ID,
+			AllowClientTraits:                 environment.AllowClientTraits,
+			BannerText:                        environment.BannerText,
+			BannerColour:                      environment.BannerColour,
+			HideDisabledFlags:                 environment.HideDisabledFlags,
+			HideSensitiveData:                 environment.HideSensitiveData,
+			UseIdentityCompositeKeyForHashing: environment.UseIdentityCompositeKeyForHashing,
+			MinimumChangeRequestApprovals:     environment.MinimumChangeRequestApprovals,
+		}
+		if err := api.CreateEnvironment(&created); err != nil {
+			return result, importError("environment", environment.Name, err)
+		}
+		result.Environments[environment.UUID] = &created
+
+		if err := importFeatureStates(api, result, &created, environment.FeatureStates); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// importFeatureStates updates the feature states created with the environment and creates the segment overrides
+func importFeatureStates(api flagsmithapi.API, result *ImportResult, environment *flagsmithapi.Environment,
+	featureStates []FeatureState) error {
+	for _, featureState := range featureStates {
+		feature := result.Features[featureState.Feature]
+		name := fmt.Sprintf("%s/%s", environment.Name, feature.Name)
+		live, err := api.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+		if err != nil {
+			return importError("feature state", name, err)
+		}
+		live.Enabled = featureState.Enabled
+		live.FeatureStateValue = featureState.Value
+		if featureState.MultivariateAllocations != nil {
+			live.MultivariateFeatureStateValues = multivariateValues(result, live, featureState.MultivariateAllocations)
+		}
+		if err := api.UpdateFeatureState(live, false); err != nil {
+			return importError("feature state", name, err)
+		}
+
+		for _, override := range featureState.SegmentOverrides {
+			segment := result.Segments[override.Segment]
+			priority := override.Priority
+			created := flagsmithapi.FeatureState{
+				Feature:           *feature.ID,
+				Enabled:           override.Enabled,
+				FeatureStateValue: override.Value,
+				EnvironmentKey:    environment.APIKey,
+				Segment:           segment.ID,
+				SegmentPriority:   &priority,
+			}
+			if err := api.CreateSegmentOverride(&created); err != nil {
+				return importError("segment override", name+"/"+segment.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// multivariateValues remaps the allocations of the bundle to the multivariate options created by Import, keeping the
+// IDs of the allocations the live feature state already has
+func multivariateValues(result *ImportResult, live *flagsmithapi.FeatureState,
+	allocations []MultivariateAllocation) []flagsmithapi.MultivariateFeatureStateValue {
+	values := []flagsmithapi.MultivariateFeatureStateValue{}
+	for _, allocation := range allocations {
+		value := flagsmithapi.MultivariateFeatureStateValue{
+			MultivariateFeatureOption: result.MultivariateOptions[allocation.Option].ID,
+			PercentageAllocation:      allocation.PercentageAllocation,
+		}
+		for _, existing := range live.MultivariateFeatureStateValues {
+			if existing.MultivariateFeatureOption == value.MultivariateFeatureOption {
+				value.ID = existing.ID
+			}
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+func importError(resource, name string, err error) error {
+	return fmt.Errorf("bundle: Error importing %s '%s': %w", resource, name, err)
+}
+
+// validate checks that every reference in the bundle resolves to a resource of the bundle
+func (b *Bundle) validate() error {
+	tags := map[string]bool{}
+	for _, tag := range b.Tags {
+		tags[tag.UUID] = true
+	}
+	segments := map[string]bool{}
+	for _, segment := range b.Segments {
+		segments[segment.UUID] = true
+	}
+	features := map[string]bool{}
+	// options maps the UUIDs of the multivariate options to the UUID of their feature
+	options := map[string]string{}
+	for _, feature := range b.Features {
+		features[feature.UUID] = true
+		for _, tag := range feature.Tags {
+			if !tags[tag] {
+				return fmt.Errorf("bundle: feature '%s' references unknown tag '%s'", feature.Name, tag)
+			}
+		}
+		for _, option := range feature.MultivariateOptions {
+			if option.Value == nil {
+				return fmt.Errorf("bundle: multivariate option '%s' of feature '%s' has no value", option.UUID, feature.Name)
+			}
+			options[option.UUID] = feature.UUID
+		}
+	}
+	for _, environment := range b.Environments {
+		for _, featureState := range environment.FeatureStates {
+			if !features[featureState.Feature] {
+				return fmt.Errorf("bundle: environment '%s' references unknown feature '%s'", environment.Name, featureState.Feature)
+			}
+			for _, allocation := range featureState.MultivariateAllocations {
+				if options[allocation.Option] != featureState.Feature {
+					return fmt.Errorf("bundle: environment '%s' references unknown multivariate option '%s'", environment.Name,
+						allocation.Option)
+				}
+			}
+			for _, override := range featureState.SegmentOverrides {
+				if !segments[override.Segment] {
+					return fmt.Errorf("bundle: environment '%s' references unknown segment '%s'", environment.Name, override.Segment)
+				}
+			}
+		}
+	}
+	return nil
+}
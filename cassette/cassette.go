@@ -0,0 +1,276 @@
+// Package cassette records the HTTP interactions of a flagsmithapi.Client to a file and replays them offline, so
+// integration tests can run in CI without a Flagsmith instance.
+//
+//	transport, err := cassette.New("testdata/features.json", cassette.ModeReplay)
+//	client := flagsmithapi.NewClient(masterAPIKey, baseURL, flagsmithapi.WithTransport(transport))
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+const Version = 1
+
+// RedactedValue replaces the redacted headers and secrets in recorded interactions; it is the placeholder the client
+// uses in its logs
+const RedactedValue = flagsmithapi.Redacted
+
+var (
+	// serverSideKeyPattern matches server-side environment keys, i.e: ser.abc123
+	serverSideKeyPattern = regexp.MustCompile(`\bser\.[A-Za-z0-9_-]+`)
+	// keyFieldPattern matches the key field of the server-side key objects of the api-keys endpoints
+	keyFieldPattern = regexp.MustCompile(`"key"\s*:\s*"[^"]*"`)
+)
+
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette file and fails requests that do not match an interaction
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the server and records the interactions, which are written by Save
+	ModeRecord
+)
+
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   url.Values  `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Transport is an http.RoundTripper that records or replays interactions
+type Transport struct {
+	path string
+	mode Mode
+
+	// Inner is the transport used to send requests in ModeRecord; defaults to http.DefaultTransport
+	Inner http.RoundTripper
+	// RedactHeaders lists the request headers whose values are replaced with RedactedValue when recording
+	RedactHeaders []string
+	// RedactBody rewrites the bodies of the responses to req before they are recorded; it defaults to
+	// RedactServerSideKeys, and recorded bodies are kept as they are when it is nil
+	RedactBody func(req *http.Request, body string) string
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// New returns a Transport for the cassette at path. In ModeReplay the cassette is loaded from path.
+func New(path string, mode Mode) (*Transport, error) {
+	t := &Transport{
+		path:          path,
+		mode:          mode,
+		RedactHeaders: []string{"Authorization"},
+		RedactBody:    RedactServerSideKeys,
+		cassette:      Cassette{Version: Version, Interactions: []Interaction{}},
+	}
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("cassette: Error reading cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, &t.cassette); err != nil {
+			return nil, fmt.Errorf("cassette: Error parsing cassette '%s': %w", path, err)
+		}
+		if t.cassette.Version != Version {
+			return nil, fmt.Errorf("cassette: unsupported cassette version %d", t.cassette.Version)
+		}
+		t.used = make([]bool, len(t.cassette.Interactions))
+	}
+	return t, nil
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if t.mode == ModeRecord {
+		return t.record(req, body)
+	}
+	return t.replay(req, body)
+}
+
+func (t *Transport) record(req *http.Request, body []byte) (*http.Response, error) {
+	inner := t.Inner
+	if inner == nil {
+		inner = http.DefaultTransport
+	}
+	resp, err := inner.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	headers := req.Header.Clone()
+	for _, name := range t.RedactHeaders {
+		if headers.Get(name) != "" {
+			headers.Set(name, RedactedValue)
+		}
+	}
+	recordedBody := string(respBody)
+	if t.RedactBody != nil {
+		recordedBody = t.RedactBody(req, recordedBody)
+	}
+	interaction := Interaction{
+		Request: Request{
+			Method:  req.Method,
+			Path:    req.URL.Path,
+			Query:   req.URL.Query(),
+			Headers: headers,
+			Body:    string(body),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    resp.Header.Clone(),
+			Body:       recordedBody,
+		},
+	}
+	t.mu.Lock()
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction)
+	t.mu.Unlock()
+	return resp, nil
+}
+
+func (t *Transport) replay(req *http.Request, body []byte) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, interaction := range t.cassette.Interactions {
+		if t.used[i] || !interaction.Request.matches(req, body) {
+			continue
+		}
+		t.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Headers.Clone(),
+			Body:          io.NopCloser(bytes.NewReader([]byte(interaction.Response.Body))),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("cassette: no recorded interaction matches %s %s", req.Method, req.URL.RequestURI())
+}
+
+// RedactServerSideKeys replaces the server-side environment keys in the body of a response to req, i.e: of the clone
+// responses, and the key fields of the api-keys responses by RedactedValue
+func RedactServerSideKeys(req *http.Request, body string) string {
+	if strings.Contains(req.URL.Path, "/api-keys/") {
+		body = keyFieldPattern.ReplaceAllString(body, `"key":"`+RedactedValue+`"`)
+	}
+	return serverSideKeyPattern.ReplaceAllString(body, RedactedValue)
+}
+
+// matches compares method, path, query and body; JSON bodies are compared semantically
+func (r *Request) matches(req *http.Request, body []byte) bool {
+	if r.Method != req.Method || r.Path != req.URL.Path {
+		return false
+	}
+	query := req.URL.Query()
+	if len(query) != len(r.Query) {
+		return false
+	}
+	for key, values := range r.Query {
+		if fmt.Sprint(values) != fmt.Sprint(query[key]) {
+			return false
+		}
+	}
+	return bodiesEqual([]byte(r.Body), body)
+}
+
+func bodiesEqual(recorded, actual []byte) bool {
+	if bytes.Equal(recorded, actual) {
+		return true
+	}
+	var recordedJSON, actualJSON interface{}
+	if json.Unmarshal(recorded, &recordedJSON) != nil || json.Unmarshal(actual, &actualJSON) != nil {
+		return false
+	}
+	recordedCanonical, _ := json.Marshal(recordedJSON)
+	actualCanonical, _ := json.Marshal(actualJSON)
+	return bytes.Equal(recordedCanonical, actualCanonical)
+}
+
+// Save writes the recorded interactions to the cassette file, creating its directory if required
+func (t *Transport) Save() error {
+	if t.mode != ModeRecord {
+		return nil
+	}
+	t.mu.Lock()
+	data, err := json.MarshalIndent(t.cassette, "", "  ")
+	t.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(t.path, data, 0o644)
+}
+
+// Unused returns the replayed interactions that were not matched by any request
+func (t *Transport) Unused() []Interaction {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	unused := []Interaction{}
+	if t.mode != ModeReplay {
+		return unused
+	}
+	for i, interaction := range t.cassette.Interactions {
+		if !t.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
@@ -0,0 +1,144 @@
+package cassette_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/cassette"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+func recordCassette(t *testing.T, path string) (*flagsmithapi.Project, *flagsmithapi.Feature) {
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+
+	recorder, err := cassette.New(path, cassette.ModeRecord)
+	require.NoError(t, err)
+	client := flagsmithapi.NewClient(flagsmithtest.MasterAPIKey, server.URL, flagsmithapi.WithTransport(recorder))
+
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	feature := flagsmithapi.Feature{Name: "test_feature", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&feature))
+	_, err = client.GetFeature(feature.UUID)
+	require.NoError(t, err)
+
+	require.NoError(t, recorder.Save())
+	return &project, &feature
+}
+
+func TestRecordRedactsAPIKey(t *testing.T) {
+	// Given
+	path := filepath.Join(t.TempDir(), "cassettes", "features.json")
+
+	// When
+	recordCassette(t, path)
+
+	// Then
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), flagsmithtest.MasterAPIKey)
+	assert.Contains(t, string(data), cassette.RedactedValue)
+}
+
+func TestRecordRedactsServerSideKeys(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "keys.json")
+	recorder, err := cassette.New(path, cassette.ModeRecord)
+	require.NoError(t, err)
+	client := server.Client(flagsmithapi.WithTransport(recorder))
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&environment))
+
+	// When
+	key := flagsmithapi.ServerSideEnvKey{Name: "backend"}
+	require.NoError(t, client.CreateServerSideEnvKey(environment.APIKey, &key))
+	require.NoError(t, recorder.Save())
+
+	// Then
+	require.True(t, strings.HasPrefix(key.Key, "ser."))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), key.Key)
+	recorded := cassette.Cassette{}
+	require.NoError(t, json.Unmarshal(data, &recorded))
+	assert.Contains(t, recorded.Interactions[2].Response.Body, `"key":"`+cassette.RedactedValue+`"`)
+}
+
+func TestRedactServerSideKeysKeepsKeyFieldsOutsideTheAPIKeysEndpoints(t *testing.T) {
+	// Given
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/environments/", nil)
+
+	// When
+	body := cassette.RedactServerSideKeys(req, `{"key":"value","api_key":"ser.abc123"}`)
+
+	// Then
+	assert.Equal(t, `{"key":"value","api_key":"`+cassette.RedactedValue+`"}`, body)
+}
+
+func TestReplay(t *testing.T) {
+	// Given
+	path := filepath.Join(t.TempDir(), "features.json")
+	project, feature := recordCassette(t, path)
+
+	replayer, err := cassette.New(path, cassette.ModeReplay)
+	require.NoError(t, err)
+	// the server is no longer running, so every response must come from the cassette
+	client := flagsmithapi.NewClient("another_key", "http://flagsmith.invalid/api/v1", flagsmithapi.WithTransport(replayer))
+
+	// When
+	replayedProject := flagsmithapi.Project{Name: "project-1", Organisation: project.Organisation}
+	projectErr := client.CreateProject(&replayedProject)
+	replayedFeature := flagsmithapi.Feature{Name: "test_feature", ProjectID: &project.ID}
+	featureErr := client.CreateFeature(&replayedFeature)
+	fetched, getErr := client.GetFeature(feature.UUID)
+
+	// Then
+	assert.NoError(t, projectErr)
+	assert.NoError(t, featureErr)
+	assert.NoError(t, getErr)
+	assert.Equal(t, project.ID, replayedProject.ID)
+	assert.Equal(t, *feature.ID, *replayedFeature.ID)
+	assert.Equal(t, feature.UUID, fetched.UUID)
+	assert.Empty(t, replayer.Unused())
+}
+
+func TestReplayFailsOnUnmatchedRequest(t *testing.T) {
+	// Given
+	path := filepath.Join(t.TempDir(), "features.json")
+	project, _ := recordCassette(t, path)
+
+	replayer, err := cassette.New(path, cassette.ModeReplay)
+	require.NoError(t, err)
+	client := flagsmithapi.NewClient("another_key", "http://flagsmith.invalid/api/v1", flagsmithapi.WithTransport(replayer))
+
+	// When
+	otherFeature := flagsmithapi.Feature{Name: "other_feature", ProjectID: &project.ID}
+	err = client.CreateFeature(&otherFeature)
+
+	// Then
+	assert.ErrorContains(t, err, "no recorded interaction matches POST")
+	assert.Len(t, replayer.Unused(), 4)
+}
+
+func TestNewReturnsErrorForMissingCassette(t *testing.T) {
+	// When
+	_, err := cassette.New(filepath.Join(t.TempDir(), "missing.json"), cassette.ModeReplay)
+
+	// Then
+	assert.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "cassette:"))
+}
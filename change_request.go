@@ -0,0 +1,27 @@
+package flagsmithapi
+
+import (
+	"fmt"
+)
+
+// CreateChangeRequest submits the change request to the environment for approval
+func (c *Client) CreateChangeRequest(changeRequest *ChangeRequest) error {
+	c, op := c.startOperation("CreateChangeRequest", "change_request", "create",
+		environmentKeyAttribute(changeRequest.EnvironmentKey))
+	defer op.end()
+
+	for i := range changeRequest.FeatureStates {
+		if err := c.validateFeatureStateValue(changeRequest.EnvironmentKey, &changeRequest.FeatureStates[i]); err != nil {
+			return err
+		}
+	}
+	url := fmt.Sprintf("%s/environments/%s/create-change-request/", c.baseURL, changeRequest.EnvironmentKey)
+	resp, err := c.request().SetBody(changeRequest).SetResult(changeRequest).Post(url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmithapi: Error creating change request: %s", resp)
+	}
+	return nil
+}
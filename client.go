@@ -1,8 +1,13 @@
 package flagsmithapi
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"log/slog"
+	"math"
 	"net/http"
+	"sort"
 	"strconv"
 
 	"github.com/go-resty/resty/v2"
@@ -11,32 +16,115 @@ import (
 const BaseAPIURL = "https://api.flagsmith.com/api/v1"
 
 type Client struct {
-	master_api_key string
-	baseURL        string
-	client         *resty.Client
+	authenticator Authenticator
+	baseURL       string
+	client        *resty.Client
+
+	valueValidator ValueValidator
+	ids            *idCache
+
+	validateFeatureNames bool
+
+	telemetry *telemetry
+	logger    *slog.Logger
+
+	beforeRequestHooks []BeforeRequestHook
+	afterResponseHooks []AfterResponseHook
+	// ctx is the context set by WithContext or of the operation the client was derived for by startOperation, nil
+	// otherwise
+	ctx context.Context
 }
 
-func NewClient(masterAPIKey string, baseURL string) *Client {
+func NewClient(masterAPIKey string, baseURL string, opts ...Option) *Client {
 	if baseURL == "" {
 		baseURL = BaseAPIURL
 	}
-	c := &Client{master_api_key: masterAPIKey, baseURL: baseURL, client: resty.New()}
+	c := &Client{
+		authenticator: MasterAPIKey(masterAPIKey),
+		baseURL:       baseURL,
+		client:        resty.New(),
+	}
 	c.client.SetHeaders(map[string]string{
-		"Accept":        "application/json",
-		"Content-type":  "application/json",
-		"Authorization": "Api-Key " + c.master_api_key,
+		"Accept":       "application/json",
+		"Content-type": "application/json",
 	})
+	c.client.JSONUnmarshal = c.unmarshalJSON
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.registerHooks()
 	return c
 
 }
 
+// WithContext returns a copy of the client whose requests are made with ctx, so that they are cancelled with it,
+// including while they wait for a rate limiter; the copy shares the configuration and caches of the client.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	derived := *c
+	derived.ctx = ctx
+	return &derived
+}
+
+// request returns a new request, in the context of the current operation if any
+func (c *Client) request() *resty.Request {
+	request := c.client.R()
+	if c.ctx != nil {
+		request.SetContext(c.ctx)
+	}
+	return request
+}
+
+// listResults fetches all the items of a list endpoint. Paginated responses are followed through their `next`
+// links, while endpoints that are not paginated return a plain JSON array.
+func listResults[T any](c *Client, url string, queryParams map[string]string) ([]T, error) {
+	items := []T{}
+	request := c.request().SetQueryParams(queryParams)
+	for url != "" {
+		resp, err := request.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.IsSuccess() {
+			return nil, fmt.Errorf("flagsmithapi: Error listing %s: %s", url, resp)
+		}
+		body := bytes.TrimSpace(resp.Body())
+		if len(body) > 0 && body[0] == '[' {
+			page := []T{}
+			if err := c.unmarshalJSON(body, &page); err != nil {
+				return nil, err
+			}
+			return append(items, page...), nil
+		}
+		page := struct {
+			Next    *string `json:"next"`
+			Results []T     `json:"results"`
+		}{}
+		if err := c.unmarshalJSON(body, &page); err != nil {
+			return nil, err
+		}
+		items = append(items, page.Results...)
+
+		url = ""
+		if page.Next != nil {
+			// the next link already includes the query parameters
+			url = *page.Next
+			request = c.request()
+		}
+	}
+	return items, nil
+}
+
 // Get the feature state associated with the environment for a given feature
 func (c *Client) GetEnvironmentFeatureState(environmentKey string, featureID int64) (*FeatureState, error) {
+	c, op := c.startOperation("GetEnvironmentFeatureState", "feature_state", "get",
+		environmentKeyAttribute(environmentKey), idAttribute("feature", featureID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/featurestates/", c.baseURL, environmentKey)
 	result := struct {
 		Results []*FeatureState `json:"results"`
 	}{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetQueryParams(map[string]string{
 			"feature": strconv.FormatInt(featureID, 10),
 		}).
@@ -51,13 +139,18 @@ func (c *Client) GetEnvironmentFeatureState(environmentKey string, featureID int
 
 	}
 	featureState := result.Results[0]
+	featureState.EnvironmentKey = environmentKey
 	return featureState, nil
 
 }
 func (c *Client) GetFeatureState(featureStateUUID string) (*FeatureState, error) {
+	c, op := c.startOperation("GetFeatureState", "feature_state", "get",
+		uuidAttribute("feature_state", featureStateUUID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/features/featurestates/get-by-uuid/%s/", c.baseURL, featureStateUUID)
 	featureState := FeatureState{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&featureState).Get(url)
 
 	if err != nil {
@@ -83,10 +176,34 @@ func (c *Client) GetFeatureState(featureStateUUID string) (*FeatureState, error)
 	return &featureState, nil
 }
 
+// List the feature states associated with the environment(excluding segment and identity overrides)
+func (c *Client) ListEnvironmentFeatureStates(environmentKey string) ([]FeatureState, error) {
+	c, op := c.startOperation("ListEnvironmentFeatureStates", "feature_state", "list",
+		environmentKeyAttribute(environmentKey))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/environments/%s/featurestates/", c.baseURL, environmentKey)
+	featureStates, err := listResults[FeatureState](c, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range featureStates {
+		featureStates[i].EnvironmentKey = environmentKey
+	}
+	return featureStates, nil
+}
+
 // Update the feature state
 func (c *Client) UpdateFeatureState(featureState *FeatureState, updateSegmentPriority bool) error {
+	c, op := c.startOperation("UpdateFeatureState", "feature_state", "update",
+		idAttribute("feature_state", featureState.ID), idAttribute("feature", featureState.Feature))
+	defer op.end()
+
+	if err := c.validateFeatureStateValue(featureState.EnvironmentKey, featureState); err != nil {
+		return err
+	}
 	url := fmt.Sprintf("%s/features/featurestates/%d/", c.baseURL, featureState.ID)
-	resp, err := c.client.R().SetBody(featureState).SetResult(&featureState).Put(url)
+	resp, err := c.request().SetBody(featureState).SetResult(&featureState).Put(url)
 	if err != nil {
 		return err
 	}
@@ -112,9 +229,12 @@ func (c *Client) UpdateFeatureState(featureState *FeatureState, updateSegmentPri
 }
 
 func (c *Client) GetFeature(featureUUID string) (*Feature, error) {
+	c, op := c.startOperation("GetFeature", "feature", "get", uuidAttribute("feature", featureUUID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/features/get-by-uuid/%s/", c.baseURL, featureUUID)
 	feature := Feature{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&feature).Get(url)
 
 	if err != nil {
@@ -127,15 +247,42 @@ func (c *Client) GetFeature(featureUUID string) (*Feature, error) {
 		}
 		return nil, fmt.Errorf("flagsmithapi: Error getting feature: %s", resp)
 	}
-	project, err := c.GetProjectByID(*feature.ProjectID)
+	c.ids.setFeature(&feature)
+	projectUUID, err := c.getProjectUUID(*feature.ProjectID)
 	if err != nil {
 		return nil, err
 	}
-	feature.ProjectUUID = project.UUID
+	feature.ProjectUUID = projectUUID
 	return &feature, nil
 }
 
+func (c *Client) ListFeatures(projectID int64) ([]Feature, error) {
+	c, op := c.startOperation("ListFeatures", "feature", "list", idAttribute("project", projectID))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/projects/%d/features/", c.baseURL, projectID)
+	features, err := listResults[Feature](c, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	projectUUID, err := c.getProjectUUID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range features {
+		features[i].ProjectUUID = projectUUID
+		c.ids.setFeature(&features[i])
+	}
+	return features, nil
+}
+
 func (c *Client) CreateFeature(feature *Feature) error {
+	c, op := c.startOperation("CreateFeature", "feature", "create", optionalIDAttribute("project", feature.ProjectID))
+	defer op.end()
+
+	if err := c.validateFeatureInitialValue(feature); err != nil {
+		return err
+	}
 	if feature.ProjectID == nil {
 		projectID, err := c.getProjectID(feature.ProjectUUID)
 		if err != nil {
@@ -143,10 +290,13 @@ func (c *Client) CreateFeature(feature *Feature) error {
 		}
 		feature.ProjectID = &projectID
 	}
+	if err := c.validateFeatureName(feature); err != nil {
+		return err
+	}
 
 	url := fmt.Sprintf("%s/projects/%d/features/", c.baseURL, *feature.ProjectID)
 
-	resp, err := c.client.R().SetBody(feature).SetResult(&feature).Post(url)
+	resp, err := c.request().SetBody(feature).SetResult(&feature).Post(url)
 
 	if err != nil {
 		return err
@@ -155,14 +305,19 @@ func (c *Client) CreateFeature(feature *Feature) error {
 	if !resp.IsSuccess() {
 		return fmt.Errorf("flagsmithapi: Error creating feature: %s", resp)
 	}
+	c.ids.setFeature(feature)
 
 	return nil
 }
 
 func (c *Client) DeleteFeature(projectID, featureID int64) error {
+	c, op := c.startOperation("DeleteFeature", "feature", "delete",
+		idAttribute("project", projectID), idAttribute("feature", featureID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/%d/features/%d/", c.baseURL, projectID, featureID)
 
-	resp, err := c.client.R().Delete(url)
+	resp, err := c.request().Delete(url)
 
 	if err != nil {
 		return err
@@ -171,12 +326,17 @@ func (c *Client) DeleteFeature(projectID, featureID int64) error {
 	if !resp.IsSuccess() {
 		return fmt.Errorf("flagsmithapi: Error deleting feature: %s", resp)
 	}
+	c.ids.deleteFeature(featureID)
 	return nil
 }
 
 func (c *Client) UpdateFeature(feature *Feature) error {
+	c, op := c.startOperation("UpdateFeature", "feature", "update",
+		optionalIDAttribute("project", feature.ProjectID), optionalIDAttribute("feature", feature.ID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/%d/features/%d/", c.baseURL, *feature.ProjectID, *feature.ID)
-	resp, err := c.client.R().SetBody(feature).SetResult(feature).Put(url)
+	resp, err := c.request().SetBody(feature).SetResult(feature).Put(url)
 
 	if err != nil {
 		return err
@@ -185,11 +345,15 @@ func (c *Client) UpdateFeature(feature *Feature) error {
 	if !resp.IsSuccess() {
 		return fmt.Errorf("flagsmithapi: Error updating feature: %s", resp)
 	}
+	c.ids.deleteFeature(*feature.ID)
 
 	return nil
 }
 
 func (c *Client) getProjectID(projectUUID string) (int64, error) {
+	if projectID, ok := c.ids.projectID(projectUUID); ok {
+		return projectID, nil
+	}
 	project, err := c.GetProject(projectUUID)
 
 	if err != nil {
@@ -205,12 +369,15 @@ func (c *Client) manageFeatureOwners(feature *Feature, ownerIDs []int64, endpoin
 	}{
 		UserIDs: ownerIDs,
 	}
-	resp, err := c.client.R().SetBody(body).Post(url)
+	resp, err := c.request().SetBody(body).Post(url)
 	return resp, err
 
 }
 
 func (c *Client) AddFeatureOwners(feature *Feature, ownerIDs []int64) error {
+	c, op := c.startOperation("AddFeatureOwners", "feature", "add_owners", optionalIDAttribute("feature", feature.ID))
+	defer op.end()
+
 	resp, err := c.manageFeatureOwners(feature, ownerIDs, "add-owners")
 	if err != nil {
 		return err
@@ -223,6 +390,10 @@ func (c *Client) AddFeatureOwners(feature *Feature, ownerIDs []int64) error {
 }
 
 func (c *Client) RemoveFeatureOwners(feature *Feature, ownerIDs []int64) error {
+	c, op := c.startOperation("RemoveFeatureOwners", "feature", "remove_owners",
+		optionalIDAttribute("feature", feature.ID))
+	defer op.end()
+
 	resp, err := c.manageFeatureOwners(feature, ownerIDs, "remove-owners")
 	if err != nil {
 		return nil
@@ -243,11 +414,15 @@ func (c *Client) manageFeatureGroupOwners(feature *Feature, groupIDs []int64, en
 	}{
 		GroupIDs: groupIDs,
 	}
-	resp, err := c.client.R().SetBody(body).Post(url)
+	resp, err := c.request().SetBody(body).Post(url)
 	return resp, err
 }
 
 func (c *Client) AddFeatureGroupOwners(feature *Feature, groupIDs []int64) error {
+	c, op := c.startOperation("AddFeatureGroupOwners", "feature", "add_group_owners",
+		optionalIDAttribute("feature", feature.ID))
+	defer op.end()
+
 	resp, err := c.manageFeatureGroupOwners(feature, groupIDs, "add-group-owners")
 	if err != nil {
 		return err
@@ -259,6 +434,10 @@ func (c *Client) AddFeatureGroupOwners(feature *Feature, groupIDs []int64) error
 }
 
 func (c *Client) RemoveFeatureGroupOwners(feature *Feature, groupIDs []int64) error {
+	c, op := c.startOperation("RemoveFeatureGroupOwners", "feature", "remove_group_owners",
+		optionalIDAttribute("feature", feature.ID))
+	defer op.end()
+
 	resp, err := c.manageFeatureGroupOwners(feature, groupIDs, "remove-group-owners")
 	if err != nil {
 		return err
@@ -270,9 +449,13 @@ func (c *Client) RemoveFeatureGroupOwners(feature *Feature, groupIDs []int64) er
 }
 
 func (c *Client) GetFeatureMVOption(featureUUID, mvOptionUUID string) (*FeatureMultivariateOption, error) {
+	c, op := c.startOperation("GetFeatureMVOption", "mv_option", "get",
+		uuidAttribute("feature", featureUUID), uuidAttribute("mv_option", mvOptionUUID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/multivariate/options/get-by-uuid/%s/", c.baseURL, mvOptionUUID)
 	featureMVOption := FeatureMultivariateOption{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&featureMVOption).
 		Get(url)
 
@@ -286,20 +469,41 @@ func (c *Client) GetFeatureMVOption(featureUUID, mvOptionUUID string) (*FeatureM
 		}
 		return nil, fmt.Errorf("flagsmithapi: Error getting feature MV option: %s", resp)
 	}
-	feature, err := c.GetFeature(featureUUID)
+	ids, err := c.getFeatureIDs(featureUUID)
 	if err != nil {
 		return nil, err
 	}
 	featureMVOption.FeatureUUID = featureUUID
-	featureMVOption.ProjectID = feature.ProjectID
+	featureMVOption.ProjectID = &ids.projectID
 
 	return &featureMVOption, nil
 }
 
+func (c *Client) ListFeatureMVOptions(projectID, featureID int64) ([]FeatureMultivariateOption, error) {
+	c, op := c.startOperation("ListFeatureMVOptions", "mv_option", "list",
+		idAttribute("project", projectID), idAttribute("feature", featureID))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/projects/%d/features/%d/mv-options/", c.baseURL, projectID, featureID)
+	options, err := listResults[FeatureMultivariateOption](c, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range options {
+		options[i].FeatureID = &featureID
+		options[i].ProjectID = &projectID
+	}
+	return options, nil
+}
+
 func (c *Client) DeleteFeatureMVOption(projectID, featureID, mvOptionID int64) error {
+	c, op := c.startOperation("DeleteFeatureMVOption", "mv_option", "delete",
+		idAttribute("project", projectID), idAttribute("feature", featureID), idAttribute("mv_option", mvOptionID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/%d/features/%d/mv-options/%d/", c.baseURL, projectID, featureID, mvOptionID)
 
-	resp, err := c.client.R().Delete(url)
+	resp, err := c.request().Delete(url)
 
 	if err != nil {
 		return err
@@ -312,9 +516,13 @@ func (c *Client) DeleteFeatureMVOption(projectID, featureID, mvOptionID int64) e
 }
 
 func (c *Client) UpdateFeatureMVOption(featureMVOption *FeatureMultivariateOption) error {
+	c, op := c.startOperation("UpdateFeatureMVOption", "mv_option", "update",
+		optionalIDAttribute("project", featureMVOption.ProjectID), optionalIDAttribute("feature", featureMVOption.FeatureID), idAttribute("mv_option", featureMVOption.ID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/%d/features/%d/mv-options/%d/", c.baseURL, *featureMVOption.ProjectID,
 		*featureMVOption.FeatureID, featureMVOption.ID)
-	resp, err := c.client.R().SetBody(featureMVOption).SetResult(featureMVOption).Put(url)
+	resp, err := c.request().SetBody(featureMVOption).SetResult(featureMVOption).Put(url)
 
 	if err != nil {
 		return err
@@ -328,19 +536,23 @@ func (c *Client) UpdateFeatureMVOption(featureMVOption *FeatureMultivariateOptio
 }
 
 func (c *Client) CreateFeatureMVOption(featureMVOption *FeatureMultivariateOption) error {
+	c, op := c.startOperation("CreateFeatureMVOption", "mv_option", "create",
+		uuidAttribute("feature", featureMVOption.FeatureUUID), optionalIDAttribute("feature", featureMVOption.FeatureID))
+	defer op.end()
+
 	if featureMVOption.FeatureID == nil {
-		feature, err := c.GetFeature(featureMVOption.FeatureUUID)
+		ids, err := c.getFeatureIDs(featureMVOption.FeatureUUID)
 		if err != nil {
 			return err
 		}
-		featureMVOption.FeatureID = feature.ID
-		featureMVOption.ProjectID = feature.ProjectID
+		featureMVOption.FeatureID = &ids.featureID
+		featureMVOption.ProjectID = &ids.projectID
 	}
 
 	url := fmt.Sprintf("%s/projects/%d/features/%d/mv-options/", c.baseURL, *featureMVOption.ProjectID,
 		*featureMVOption.FeatureID)
 
-	resp, err := c.client.R().SetBody(featureMVOption).SetResult(&featureMVOption).Post(url)
+	resp, err := c.request().SetBody(featureMVOption).SetResult(&featureMVOption).Post(url)
 
 	if err != nil {
 		return err
@@ -354,9 +566,12 @@ func (c *Client) CreateFeatureMVOption(featureMVOption *FeatureMultivariateOptio
 }
 
 func (c *Client) GetSegment(segmentUUID string) (*Segment, error) {
+	c, op := c.startOperation("GetSegment", "segment", "get", uuidAttribute("segment", segmentUUID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/segments/get-by-uuid/%s/", c.baseURL, segmentUUID)
 	segment := Segment{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&segment).Get(url)
 
 	if err != nil {
@@ -369,17 +584,39 @@ func (c *Client) GetSegment(segmentUUID string) (*Segment, error) {
 		}
 		return nil, fmt.Errorf("flagsmithapi: Error getting segment: %s", resp)
 	}
-	project, err := c.GetProjectByID(*segment.ProjectID)
+	projectUUID, err := c.getProjectUUID(*segment.ProjectID)
 	if err != nil {
 		return nil, err
 	}
-	segment.ProjectUUID = project.UUID
+	segment.ProjectUUID = projectUUID
 	return &segment, nil
 }
+func (c *Client) ListSegments(projectID int64) ([]Segment, error) {
+	c, op := c.startOperation("ListSegments", "segment", "list", idAttribute("project", projectID))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/projects/%d/segments/", c.baseURL, projectID)
+	segments, err := listResults[Segment](c, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	projectUUID, err := c.getProjectUUID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range segments {
+		segments[i].ProjectUUID = projectUUID
+	}
+	return segments, nil
+}
 func (c *Client) DeleteSegment(projectID, segmentID int64) error {
+	c, op := c.startOperation("DeleteSegment", "segment", "delete",
+		idAttribute("project", projectID), idAttribute("segment", segmentID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/%d/segments/%d/", c.baseURL, projectID, segmentID)
 
-	resp, err := c.client.R().Delete(url)
+	resp, err := c.request().Delete(url)
 	if err != nil {
 		return err
 	}
@@ -390,18 +627,24 @@ func (c *Client) DeleteSegment(projectID, segmentID int64) error {
 	return nil
 }
 func (c *Client) CreateSegment(segment *Segment) error {
+	c, op := c.startOperation("CreateSegment", "segment", "create", optionalIDAttribute("project", segment.ProjectID))
+	defer op.end()
+
+	if err := segment.ValidateRules(); err != nil {
+		return err
+	}
 	projectID := segment.ProjectID
 	if projectID == nil {
-		project, err := c.GetProject(segment.ProjectUUID)
+		id, err := c.getProjectID(segment.ProjectUUID)
 		if err != nil {
 			return err
 		}
-		projectID = &project.ID
+		projectID = &id
 	}
 	segment.ProjectID = projectID
 
 	url := fmt.Sprintf("%s/projects/%d/segments/", c.baseURL, *projectID)
-	resp, err := c.client.R().SetBody(segment).SetResult(segment).Post(url)
+	resp, err := c.request().SetBody(segment).SetResult(segment).Post(url)
 
 	if err != nil {
 		return err
@@ -414,18 +657,25 @@ func (c *Client) CreateSegment(segment *Segment) error {
 	return nil
 }
 func (c *Client) UpdateSegment(segment *Segment) error {
+	c, op := c.startOperation("UpdateSegment", "segment", "update",
+		optionalIDAttribute("project", segment.ProjectID), optionalIDAttribute("segment", segment.ID))
+	defer op.end()
+
+	if err := segment.ValidateRules(); err != nil {
+		return err
+	}
 	projectID := segment.ProjectID
 	if projectID == nil {
-		project, err := c.GetProject(segment.ProjectUUID)
+		id, err := c.getProjectID(segment.ProjectUUID)
 		if err != nil {
 			return err
 		}
-		projectID = &project.ID
+		projectID = &id
 	}
 	segment.ProjectID = projectID
 
 	url := fmt.Sprintf("%s/projects/%d/segments/%d/", c.baseURL, *projectID, *segment.ID)
-	resp, err := c.client.R().SetBody(segment).SetResult(segment).Put(url)
+	resp, err := c.request().SetBody(segment).SetResult(segment).Put(url)
 
 	if err != nil {
 		return err
@@ -439,9 +689,13 @@ func (c *Client) UpdateSegment(segment *Segment) error {
 }
 
 func (c *Client) GetFeatureSegmentByID(featureSegmentID int64) (*FeatureSegment, error) {
+	c, op := c.startOperation("GetFeatureSegmentByID", "feature_segment", "get",
+		idAttribute("feature_segment", featureSegmentID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/features/feature-segments/%d/", c.baseURL, featureSegmentID)
 	featureSegment := FeatureSegment{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&featureSegment).Get(url)
 
 	if err != nil {
@@ -454,7 +708,23 @@ func (c *Client) GetFeatureSegmentByID(featureSegmentID int64) (*FeatureSegment,
 	return &featureSegment, nil
 }
 
+func (c *Client) ListFeatureSegments(environmentID, featureID int64) ([]FeatureSegment, error) {
+	c, op := c.startOperation("ListFeatureSegments", "feature_segment", "list",
+		idAttribute("environment", environmentID), idAttribute("feature", featureID))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/features/feature-segments/", c.baseURL)
+	return listResults[FeatureSegment](c, url, map[string]string{
+		"environment": strconv.FormatInt(environmentID, 10),
+		"feature":     strconv.FormatInt(featureID, 10),
+	})
+}
+
 func (c *Client) UpdateFeatureSegmentPriority(featureSegmentID, priority int64) error {
+	c, op := c.startOperation("UpdateFeatureSegmentPriority", "feature_segment", "update_priority",
+		idAttribute("feature_segment", featureSegmentID))
+	defer op.end()
+
 	body := []struct {
 		Priority int64 `json:"priority"`
 		ID       int64 `json:"id"`
@@ -465,7 +735,7 @@ func (c *Client) UpdateFeatureSegmentPriority(featureSegmentID, priority int64)
 		},
 	}
 	url := fmt.Sprintf("%s/features/feature-segments/update-priorities/", c.baseURL)
-	resp, err := c.client.R().SetBody(body).Post(url)
+	resp, err := c.request().SetBody(body).Post(url)
 
 	if err != nil {
 		return err
@@ -479,9 +749,13 @@ func (c *Client) UpdateFeatureSegmentPriority(featureSegmentID, priority int64)
 }
 
 func (c *Client) DeleteFeatureSegment(featureSegmentID int64) error {
+	c, op := c.startOperation("DeleteFeatureSegment", "feature_segment", "delete",
+		idAttribute("feature_segment", featureSegmentID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/features/feature-segments/%d/", c.baseURL, featureSegmentID)
 
-	resp, err := c.client.R().Delete(url)
+	resp, err := c.request().Delete(url)
 	if err != nil {
 		return err
 	}
@@ -493,8 +767,12 @@ func (c *Client) DeleteFeatureSegment(featureSegmentID int64) error {
 }
 
 func (c *Client) CreateFeatureSegment(featureSegment *FeatureSegment) error {
+	c, op := c.startOperation("CreateFeatureSegment", "feature_segment", "create",
+		idAttribute("environment", featureSegment.Environment), idAttribute("feature", featureSegment.Feature), optionalIDAttribute("segment", featureSegment.Segment))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/features/feature-segments/", c.baseURL)
-	resp, err := c.client.R().SetBody(featureSegment).SetResult(featureSegment).Post(url)
+	resp, err := c.request().SetBody(featureSegment).SetResult(featureSegment).Post(url)
 
 	if err != nil {
 		return err
@@ -508,18 +786,21 @@ func (c *Client) CreateFeatureSegment(featureSegment *FeatureSegment) error {
 }
 
 func (c *Client) CreateSegmentOverride(featureState *FeatureState) error {
+	c, op := c.startOperation("CreateSegmentOverride", "segment_override", "create",
+		environmentKeyAttribute(featureState.EnvironmentKey), idAttribute("feature", featureState.Feature), optionalIDAttribute("segment", featureState.Segment))
+	defer op.end()
+
 	// fetch and set environment
-	environnmetKey := featureState.EnvironmentKey
-	environment, err := c.GetEnvironment(environnmetKey)
+	environmentID, err := c.getEnvironmentID(featureState.EnvironmentKey)
 	if err != nil {
 		return err
 	}
-	featureState.Environment = &environment.ID
+	featureState.Environment = &environmentID
 
 	// Create and set feature segment
 	featureSegment := FeatureSegment{
 		Feature:     featureState.Feature,
-		Environment: environment.ID,
+		Environment: environmentID,
 		Segment:     featureState.Segment,
 		Priority:    featureState.SegmentPriority,
 	}
@@ -532,7 +813,7 @@ func (c *Client) CreateSegmentOverride(featureState *FeatureState) error {
 
 	// Finally, create the feature state
 	url := fmt.Sprintf("%s/features/featurestates/", c.baseURL)
-	resp, err := c.client.R().SetBody(featureState).SetResult(&featureState).Post(url)
+	resp, err := c.request().SetBody(featureState).SetResult(&featureState).Post(url)
 	if err != nil {
 		return err
 	}
@@ -545,7 +826,77 @@ func (c *Client) CreateSegmentOverride(featureState *FeatureState) error {
 
 }
 
+// List the segment overrides of a feature in the environment, ordered by priority
+func (c *Client) ListSegmentOverrides(environmentKey string, featureID int64) ([]FeatureState, error) {
+	c, op := c.startOperation("ListSegmentOverrides", "segment_override", "list",
+		environmentKeyAttribute(environmentKey), idAttribute("feature", featureID))
+	defer op.end()
+
+	environmentID, err := c.getEnvironmentID(environmentKey)
+	if err != nil {
+		return nil, err
+	}
+	featureSegments, err := c.ListFeatureSegments(environmentID, featureID)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/features/featurestates/", c.baseURL)
+	featureStates, err := listResults[FeatureState](c, url, map[string]string{
+		"environment": strconv.FormatInt(environmentID, 10),
+		"feature":     strconv.FormatInt(featureID, 10),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := []FeatureState{}
+	for _, featureSegment := range featureSegments {
+		for _, featureState := range featureStates {
+			if featureState.FeatureSegment == nil || *featureState.FeatureSegment != *featureSegment.ID {
+				continue
+			}
+			featureState.EnvironmentKey = environmentKey
+			featureState.Segment = featureSegment.Segment
+			featureState.SegmentPriority = featureSegment.Priority
+			overrides = append(overrides, featureState)
+		}
+	}
+	sort.SliceStable(overrides, func(i, j int) bool {
+		return segmentPriority(overrides[i].SegmentPriority) < segmentPriority(overrides[j].SegmentPriority)
+	})
+	return overrides, nil
+}
+
+// segmentPriority returns the priority of a segment override for ordering; the API allows feature segments without a
+// priority, which rank after all the others
+func segmentPriority(priority *int64) int64 {
+	if priority == nil {
+		return math.MaxInt64
+	}
+	return *priority
+}
+
+func (c *Client) ListTags(projectID int64) ([]Tag, error) {
+	c, op := c.startOperation("ListTags", "tag", "list", idAttribute("project", projectID))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/projects/%d/tags/", c.baseURL, projectID)
+	tags, err := listResults[Tag](c, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range tags {
+		tags[i].ProjectID = &projectID
+	}
+	return tags, nil
+}
+
 func (c *Client) GetTag(projectUUID string, tagUUID string) (*Tag, error) {
+	c, op := c.startOperation("GetTag", "tag", "get",
+		uuidAttribute("project", projectUUID), uuidAttribute("tag", tagUUID))
+	defer op.end()
+
 	projectID, err := c.getProjectID(projectUUID)
 	if err != nil {
 		return nil, err
@@ -553,7 +904,7 @@ func (c *Client) GetTag(projectUUID string, tagUUID string) (*Tag, error) {
 
 	url := fmt.Sprintf("%s/projects/%d/tags/get-by-uuid/%s/", c.baseURL, projectID, tagUUID)
 	tag := Tag{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&tag).Get(url)
 
 	if err != nil {
@@ -567,6 +918,9 @@ func (c *Client) GetTag(projectUUID string, tagUUID string) (*Tag, error) {
 	return &tag, nil
 }
 func (c *Client) CreateTag(tag *Tag) error {
+	c, op := c.startOperation("CreateTag", "tag", "create", optionalIDAttribute("project", tag.ProjectID))
+	defer op.end()
+
 	if tag.ProjectID == nil {
 		projectID, err := c.getProjectID(tag.ProjectUUID)
 		if err != nil {
@@ -575,7 +929,7 @@ func (c *Client) CreateTag(tag *Tag) error {
 		tag.ProjectID = &projectID
 	}
 	url := fmt.Sprintf("%s/projects/%d/tags/", c.baseURL, *tag.ProjectID)
-	resp, err := c.client.R().SetBody(tag).SetResult(tag).Post(url)
+	resp, err := c.request().SetBody(tag).SetResult(tag).Post(url)
 
 	if err != nil {
 		return err
@@ -589,8 +943,12 @@ func (c *Client) CreateTag(tag *Tag) error {
 }
 
 func (c *Client) UpdateTag(tag *Tag) error {
+	c, op := c.startOperation("UpdateTag", "tag", "update",
+		optionalIDAttribute("project", tag.ProjectID), optionalIDAttribute("tag", tag.ID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/%d/tags/%d/", c.baseURL, *tag.ProjectID, *tag.ID)
-	resp, err := c.client.R().SetBody(tag).SetResult(tag).Put(url)
+	resp, err := c.request().SetBody(tag).SetResult(tag).Put(url)
 
 	if err != nil {
 		return err
@@ -604,9 +962,13 @@ func (c *Client) UpdateTag(tag *Tag) error {
 }
 
 func (c *Client) DeleteTag(projectID, tagID int64) error {
+	c, op := c.startOperation("DeleteTag", "tag", "delete",
+		idAttribute("project", projectID), idAttribute("tag", tagID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/%d/tags/%d/", c.baseURL, projectID, tagID)
 
-	resp, err := c.client.R().Delete(url)
+	resp, err := c.request().Delete(url)
 	if err != nil {
 		return err
 	}
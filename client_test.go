@@ -1940,3 +1940,46 @@ func TestDeleteTag(t *testing.T) {
 	// Then
 	assert.NoError(t, err)
 }
+
+func TestListSegmentOverridesSortsNilPrioritiesLast(t *testing.T) {
+	// Given
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf("/api/v1/environments/%s/", EnvironmentAPIKey), func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		_, err := io.WriteString(rw, EnvironmentJson)
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("/api/v1/features/feature-segments/", func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		_, err := fmt.Fprintf(rw, `{"results": [
+			{"id": 1, "feature": %[1]d, "segment": 301, "environment": %[2]d, "priority": null},
+			{"id": 2, "feature": %[1]d, "segment": 302, "environment": %[2]d, "priority": 1},
+			{"id": 3, "feature": %[1]d, "segment": 303, "environment": %[2]d, "priority": 0}
+		]}`, FeatureID, EnvironmentID)
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("/api/v1/features/featurestates/", func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		_, err := fmt.Fprintf(rw, `{"results": [
+			{"id": 11, "feature": %[1]d, "environment": %[2]d, "feature_segment": 1, "enabled": true, "feature_state_value": "a"},
+			{"id": 12, "feature": %[1]d, "environment": %[2]d, "feature_segment": 2, "enabled": true, "feature_state_value": "a"},
+			{"id": 13, "feature": %[1]d, "environment": %[2]d, "feature_segment": 3, "enabled": true, "feature_state_value": "a"}
+		]}`, FeatureID, EnvironmentID)
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := flagsmithapi.NewClient(MasterAPIKey, server.URL+"/api/v1")
+
+	// When
+	overrides, err := client.ListSegmentOverrides(EnvironmentAPIKey, FeatureID)
+
+	// Then
+	assert.NoError(t, err)
+	segments := []int64{}
+	for _, override := range overrides {
+		segments = append(segments, *override.Segment)
+	}
+	assert.Equal(t, []int64{303, 302, 301}, segments)
+	assert.Nil(t, overrides[2].SegmentPriority)
+}
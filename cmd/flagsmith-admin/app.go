@@ -0,0 +1,274 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+const defaultAPIURL = "https://api.flagsmith.com/api/v1"
+
+const (
+	outputJSON  = "json"
+	outputTable = "table"
+	outputYAML  = "yaml"
+)
+
+type settings struct {
+	MasterAPIKey string `yaml:"master_api_key"`
+	// APIToken is the API token of a user, used instead of the master API key when set
+	APIToken string `yaml:"api_token"`
+	APIURL   string `yaml:"api_url"`
+	Project  string `yaml:"project"`
+}
+
+// loadSettings reads the config file and overrides its values with the environment variables that are set
+func loadSettings(configPath string, getenv func(string) string) (settings, error) {
+	s := settings{}
+	explicit := configPath != ""
+	if !explicit {
+		configDir, err := os.UserConfigDir()
+		if err == nil {
+			configPath = filepath.Join(configDir, "flagsmith-admin", "config.yaml")
+		}
+	}
+	if configPath != "" {
+		data, err := os.ReadFile(configPath)
+		switch {
+		case err == nil:
+			if err := yaml.Unmarshal(data, &s); err != nil {
+				return s, fmt.Errorf("error parsing config file '%s': %w", configPath, err)
+			}
+		case explicit || !errors.Is(err, os.ErrNotExist):
+			return s, fmt.Errorf("error reading config file: %w", err)
+		}
+	}
+	for name, value := range map[string]*string{
+		"FLAGSMITH_MASTER_API_KEY": &s.MasterAPIKey,
+		"FLAGSMITH_API_TOKEN":      &s.APIToken,
+		"FLAGSMITH_API_URL":        &s.APIURL,
+		"FLAGSMITH_PROJECT":        &s.Project,
+	} {
+		if v := getenv(name); v != "" {
+			*value = v
+		}
+	}
+	if s.APIURL == "" {
+		s.APIURL = defaultAPIURL
+	}
+	return s, nil
+}
+
+type app struct {
+	settings settings
+	output   string
+	stdout   io.Writer
+
+	client *flagsmithapi.Client
+}
+
+// flagSet returns a flag set with the common flags registered
+func (a *app) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&a.settings.APIURL, "api-url", a.settings.APIURL, "base URL of the Admin API")
+	fs.StringVar(&a.settings.Project, "project", a.settings.Project, "project UUID")
+	fs.StringVar(&a.output, "output", a.output, "output format: json, table or yaml")
+	return fs
+}
+
+// parse parses the subcommand flags, which may be interspersed with the positional arguments, and validates the
+// common ones
+func (a *app) parse(fs *flag.FlagSet, args []string) error {
+	positional := []string{}
+	for {
+		if err := fs.Parse(args); err != nil {
+			return fmt.Errorf("%w: %s", errUsage, err)
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	// leave the positional arguments in fs.Args()
+	_ = fs.Parse(append([]string{"--"}, positional...))
+	switch a.output {
+	case outputJSON, outputTable, outputYAML:
+	default:
+		return fmt.Errorf("%w: unsupported output format '%s'", errUsage, a.output)
+	}
+	return nil
+}
+
+func (a *app) api() (*flagsmithapi.Client, error) {
+	if a.client != nil {
+		return a.client, nil
+	}
+	opts := []flagsmithapi.Option{}
+	switch {
+	case a.settings.APIToken != "":
+		opts = append(opts, flagsmithapi.WithAuthenticator(flagsmithapi.UserToken(a.settings.APIToken)))
+	case a.settings.MasterAPIKey == "":
+		return nil, errors.New("no master API key configured: set FLAGSMITH_MASTER_API_KEY or master_api_key in the " +
+			"config file, or FLAGSMITH_API_TOKEN or api_token to use a personal API token")
+	}
+	a.client = flagsmithapi.NewClient(a.settings.MasterAPIKey, a.settings.APIURL, opts...)
+	return a.client, nil
+}
+
+func (a *app) project() (*flagsmithapi.Project, error) {
+	if a.settings.Project == "" {
+		return nil, fmt.Errorf("%w: no project configured: pass --project or set FLAGSMITH_PROJECT", errUsage)
+	}
+	client, err := a.api()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetProject(a.settings.Project)
+}
+
+// featureByName finds a feature of the project by name
+func (a *app) featureByName(projectID int64, name string) (*flagsmithapi.Feature, error) {
+	client, err := a.api()
+	if err != nil {
+		return nil, err
+	}
+	features, err := client.ListFeatures(projectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, feature := range features {
+		if feature.Name == name {
+			return &feature, nil
+		}
+	}
+	return nil, fmt.Errorf("feature '%s' not found", name)
+}
+
+type table struct {
+	headers []string
+	rows    [][]string
+}
+
+// print writes v in the selected output format; t is used for the table format
+func (a *app) print(v interface{}, t table) error {
+	switch a.output {
+	case outputJSON:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(a.stdout, string(data))
+		return err
+	case outputYAML:
+		// round trip through JSON so that the output uses the API field names
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		var generic interface{}
+		if err := json.Unmarshal(data, &generic); err != nil {
+			return err
+		}
+		data, err = yaml.Marshal(generic)
+		if err != nil {
+			return err
+		}
+		_, err = a.stdout.Write(data)
+		return err
+	}
+	w := tabwriter.NewWriter(a.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(t.headers, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// parseValue converts a command-line value to a feature state value; valueType is one of string, integer, boolean or
+// empty to infer the type
+func parseValue(value, valueType string) (*flagsmithapi.FeatureStateValue, error) {
+	if valueType == "" {
+		switch {
+		case value == "true" || value == "false":
+			valueType = "boolean"
+		default:
+			if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+				valueType = "integer"
+			} else {
+				valueType = "string"
+			}
+		}
+	}
+	switch valueType {
+	case "string":
+		return &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value}, nil
+	case "integer":
+		intValue, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer value '%s'", value)
+		}
+		return &flagsmithapi.FeatureStateValue{Type: "int", IntegerValue: &intValue}, nil
+	case "boolean":
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boolean value '%s'", value)
+		}
+		return &flagsmithapi.FeatureStateValue{Type: "bool", BooleanValue: &boolValue}, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported value type '%s'", errUsage, valueType)
+}
+
+func formatValue(value *flagsmithapi.FeatureStateValue) string {
+	switch {
+	case value == nil:
+		return ""
+	case value.IntegerValue != nil:
+		return strconv.FormatInt(*value.IntegerValue, 10)
+	case value.BooleanValue != nil:
+		return strconv.FormatBool(*value.BooleanValue)
+	case value.StringValue != nil:
+		return *value.StringValue
+	}
+	return ""
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// visited reports whether the flag was set on the command line
+func visited(fs *flag.FlagSet, name string) bool {
+	found := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			found = true
+		}
+	})
+	return found
+}
+
+// requireFlags returns a usage error for the first of the named flags that is empty
+func requireFlags(fs *flag.FlagSet, names ...string) error {
+	for _, name := range names {
+		if fs.Lookup(name).Value.String() == "" {
+			return fmt.Errorf("%w: --%s is required", errUsage, name)
+		}
+	}
+	return nil
+}
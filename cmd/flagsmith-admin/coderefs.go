@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/Flagsmith/flagsmith-go-api-client/coderefs"
+)
+
+// codeRefsScan reports the features of the project that are not referenced in a source directory and the flag names
+// referenced in it that are not features of the project
+func codeRefsScan(a *app, args []string) error {
+	fs := a.flagSet("code-refs scan")
+	dir := fs.String("dir", ".", "directory to scan")
+	stringLiterals := fs.Bool("string-literals", false, "count string literals equal to a feature name as references")
+	strict := fs.Bool("strict", false, "fail if there are unreferenced features or unknown flag names")
+	patterns := []*regexp.Regexp{}
+	fs.Func("pattern", "regular expression matching a reference, with a capture group for the feature name(repeatable)",
+		func(value string) error {
+			pattern, err := regexp.Compile(value)
+			if err != nil {
+				return err
+			}
+			patterns = append(patterns, pattern)
+			return nil
+		})
+	if err := a.parse(fs, args); err != nil {
+		return err
+	}
+
+	project, err := a.project()
+	if err != nil {
+		return err
+	}
+	features, err := a.client.ListFeatures(project.ID)
+	if err != nil {
+		return err
+	}
+	report, err := coderefs.Scan(*dir, features, coderefs.Options{Patterns: patterns, StringLiterals: *stringLiterals})
+	if err != nil {
+		return err
+	}
+
+	t := table{headers: []string{"FEATURE", "STATUS", "LOCATION"}}
+	names := make([]string, 0, len(report.References))
+	for name := range report.References {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, reference := range report.References[name] {
+			t.rows = append(t.rows, []string{name, "referenced", reference.Path + ":" + strconv.Itoa(reference.Line)})
+		}
+	}
+	for _, name := range report.Unreferenced {
+		t.rows = append(t.rows, []string{name, "unreferenced", ""})
+	}
+	for _, reference := range report.Unknown {
+		t.rows = append(t.rows, []string{reference.Feature, "unknown", reference.Path + ":" + strconv.Itoa(reference.Line)})
+	}
+	if err := a.print(report, t); err != nil {
+		return err
+	}
+	if *strict && (len(report.Unreferenced) > 0 || len(report.Unknown) > 0) {
+		return fmt.Errorf("found %d unreferenced features and %d references to unknown features",
+			len(report.Unreferenced), len(report.Unknown))
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+func featuresTable(features ...flagsmithapi.Feature) table {
+	t := table{headers: []string{"ID", "NAME", "TYPE", "ARCHIVED", "DESCRIPTION", "UUID"}}
+	for _, feature := range features {
+		t.rows = append(t.rows, []string{
+			strconv.FormatInt(*feature.ID, 10),
+			feature.Name,
+			stringValue(feature.Type),
+			strconv.FormatBool(feature.IsArchived),
+			stringValue(feature.Description),
+			feature.UUID,
+		})
+	}
+	return t
+}
+
+func featuresList(a *app, args []string) error {
+	fs := a.flagSet("features list")
+	if err := a.parse(fs, args); err != nil {
+		return err
+	}
+	project, err := a.project()
+	if err != nil {
+		return err
+	}
+	features, err := a.client.ListFeatures(project.ID)
+	if err != nil {
+		return err
+	}
+	return a.print(features, featuresTable(features...))
+}
+
+// featureArg parses the flags of a subcommand that takes a feature UUID argument
+func featureArg(a *app, name string, args []string) (string, error) {
+	fs := a.flagSet(name)
+	if err := a.parse(fs, args); err != nil {
+		return "", err
+	}
+	if fs.NArg() != 1 {
+		return "", fmt.Errorf("%w: %s takes a feature UUID", errUsage, name)
+	}
+	return fs.Arg(0), nil
+}
+
+func featuresGet(a *app, args []string) error {
+	featureUUID, err := featureArg(a, "features get", args)
+	if err != nil {
+		return err
+	}
+	client, err := a.api()
+	if err != nil {
+		return err
+	}
+	feature, err := client.GetFeature(featureUUID)
+	if err != nil {
+		return err
+	}
+	return a.print(feature, featuresTable(*feature))
+}
+
+func featuresCreate(a *app, args []string) error {
+	fs := a.flagSet("features create")
+	name := fs.String("name", "", "feature name")
+	description := fs.String("description", "", "feature description")
+	initialValue := fs.String("initial-value", "", "initial value of the feature in every environment")
+	defaultEnabled := fs.Bool("default-enabled", false, "enable the feature in every environment")
+	if err := a.parse(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlags(fs, "name"); err != nil {
+		return err
+	}
+	project, err := a.project()
+	if err != nil {
+		return err
+	}
+	feature := flagsmithapi.Feature{
+		Name:           *name,
+		InitialValue:   *initialValue,
+		DefaultEnabled: *defaultEnabled,
+		ProjectID:      &project.ID,
+	}
+	if *description != "" {
+		feature.Description = description
+	}
+	if err := a.client.CreateFeature(&feature); err != nil {
+		return err
+	}
+	return a.print(feature, featuresTable(feature))
+}
+
+func featuresArchive(a *app, args []string) error {
+	featureUUID, err := featureArg(a, "features archive", args)
+	if err != nil {
+		return err
+	}
+	client, err := a.api()
+	if err != nil {
+		return err
+	}
+	feature, err := client.GetFeature(featureUUID)
+	if err != nil {
+		return err
+	}
+	feature.IsArchived = true
+	if err := client.UpdateFeature(feature); err != nil {
+		return err
+	}
+	return a.print(feature, featuresTable(*feature))
+}
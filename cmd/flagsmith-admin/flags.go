@@ -0,0 +1,112 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+type featureStateRow struct {
+	Feature      string                     `json:"feature"`
+	Environment  string                     `json:"environment"`
+	Identity     string                     `json:"identity,omitempty"`
+	Deleted      bool                       `json:"deleted,omitempty"`
+	FeatureState *flagsmithapi.FeatureState `json:"feature_state,omitempty"`
+}
+
+func featureStateTable(row featureStateRow) table {
+	enabled, value := "", ""
+	if row.FeatureState != nil {
+		enabled = strconv.FormatBool(row.FeatureState.Enabled)
+		value = formatValue(row.FeatureState.FeatureStateValue)
+	}
+	if row.Identity == "" {
+		return table{
+			headers: []string{"FEATURE", "ENVIRONMENT", "ENABLED", "VALUE"},
+			rows:    [][]string{{row.Feature, row.Environment, enabled, value}},
+		}
+	}
+	return table{
+		headers: []string{"FEATURE", "ENVIRONMENT", "IDENTITY", "ENABLED", "VALUE", "DELETED"},
+		rows:    [][]string{{row.Feature, row.Environment, row.Identity, enabled, value, strconv.FormatBool(row.Deleted)}},
+	}
+}
+
+// featureStateFlags registers the flags that set the enabled state and value of a feature state
+type featureStateFlags struct {
+	fs        *flag.FlagSet
+	enabled   *bool
+	value     *string
+	valueType *string
+}
+
+func newFeatureStateFlags(fs *flag.FlagSet) featureStateFlags {
+	return featureStateFlags{
+		fs:        fs,
+		enabled:   fs.Bool("enabled", false, "enable or disable the feature"),
+		value:     fs.String("value", "", "feature value"),
+		valueType: fs.String("type", "", "type of --value: string, integer or boolean(inferred by default)"),
+	}
+}
+
+func (f featureStateFlags) changed() bool {
+	return visited(f.fs, "enabled") || visited(f.fs, "value")
+}
+
+// apply sets the flags that were passed on the command line on the feature state
+func (f featureStateFlags) apply(featureState *flagsmithapi.FeatureState) error {
+	if visited(f.fs, "enabled") {
+		featureState.Enabled = *f.enabled
+	}
+	if visited(f.fs, "value") {
+		value, err := parseValue(*f.value, *f.valueType)
+		if err != nil {
+			return err
+		}
+		featureState.FeatureStateValue = value
+	}
+	return nil
+}
+
+func flagsSet(a *app, args []string) error {
+	fs := a.flagSet("flags set")
+	environmentKey := fs.String("env", "", "environment API key")
+	featureName := fs.String("feature", "", "feature name")
+	stateFlags := newFeatureStateFlags(fs)
+	if err := a.parse(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlags(fs, "env", "feature"); err != nil {
+		return err
+	}
+	if !stateFlags.changed() {
+		return fmt.Errorf("%w: pass --enabled and/or --value", errUsage)
+	}
+
+	client, err := a.api()
+	if err != nil {
+		return err
+	}
+	environment, err := client.GetEnvironment(*environmentKey)
+	if err != nil {
+		return err
+	}
+	feature, err := a.featureByName(environment.ProjectID, *featureName)
+	if err != nil {
+		return err
+	}
+	featureState, err := client.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+	if err != nil {
+		return err
+	}
+	if err := stateFlags.apply(featureState); err != nil {
+		return err
+	}
+	if err := client.UpdateFeatureState(featureState, false); err != nil {
+		return err
+	}
+	row := featureStateRow{Feature: feature.Name, Environment: environment.Name, FeatureState: featureState}
+	return a.print(row, featureStateTable(row))
+}
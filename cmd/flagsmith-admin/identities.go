@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// identitiesOverride creates, updates or deletes the override of a feature for an identity. The identity is created
+// if it does not exist yet.
+func identitiesOverride(a *app, args []string) error {
+	fs := a.flagSet("identities override")
+	environmentKey := fs.String("env", "", "environment API key")
+	identifier := fs.String("identity", "", "identity identifier")
+	featureName := fs.String("feature", "", "feature name")
+	remove := fs.Bool("delete", false, "delete the override")
+	stateFlags := newFeatureStateFlags(fs)
+	if err := a.parse(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlags(fs, "env", "identity", "feature"); err != nil {
+		return err
+	}
+	if *remove == stateFlags.changed() {
+		return fmt.Errorf("%w: pass either --delete or --enabled and/or --value", errUsage)
+	}
+
+	client, err := a.api()
+	if err != nil {
+		return err
+	}
+	environment, err := client.GetEnvironment(*environmentKey)
+	if err != nil {
+		return err
+	}
+	feature, err := a.featureByName(environment.ProjectID, *featureName)
+	if err != nil {
+		return err
+	}
+	identity, err := findOrCreateIdentity(client, environment.APIKey, *identifier)
+	if err != nil {
+		return err
+	}
+	overrides, err := client.ListIdentityOverrides(environment.APIKey, *identity.ID)
+	if err != nil {
+		return err
+	}
+	var override *flagsmithapi.FeatureState
+	for i := range overrides {
+		if overrides[i].Feature == *feature.ID {
+			override = &overrides[i]
+		}
+	}
+
+	row := featureStateRow{Feature: feature.Name, Environment: environment.Name, Identity: identity.Identifier}
+	switch {
+	case *remove:
+		if override == nil {
+			return fmt.Errorf("identity '%s' has no override for feature '%s'", identity.Identifier, feature.Name)
+		}
+		if err := client.DeleteIdentityOverride(environment.APIKey, *identity.ID, override.ID); err != nil {
+			return err
+		}
+		row.Deleted = true
+	case override == nil:
+		// start from the environment default so that only the flags passed on the command line are overridden
+		defaultState, err := client.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+		if err != nil {
+			return err
+		}
+		override = &flagsmithapi.FeatureState{
+			Feature:           *feature.ID,
+			Enabled:           defaultState.Enabled,
+			FeatureStateValue: defaultState.FeatureStateValue,
+		}
+		if err := stateFlags.apply(override); err != nil {
+			return err
+		}
+		if err := client.CreateIdentityOverride(environment.APIKey, *identity.ID, override); err != nil {
+			return err
+		}
+		row.FeatureState = override
+	default:
+		if err := stateFlags.apply(override); err != nil {
+			return err
+		}
+		if err := client.UpdateIdentityOverride(environment.APIKey, *identity.ID, override); err != nil {
+			return err
+		}
+		row.FeatureState = override
+	}
+	return a.print(row, featureStateTable(row))
+}
+
+func findOrCreateIdentity(client *flagsmithapi.Client, environmentKey, identifier string) (*flagsmithapi.Identity, error) {
+	identities, err := client.ListIdentities(environmentKey, identifier)
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		if identity.Identifier == identifier {
+			return &identity, nil
+		}
+	}
+	identity := flagsmithapi.Identity{Identifier: identifier}
+	if err := client.CreateIdentity(environmentKey, &identity); err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// keysRotate creates a server-side environment key, then deactivates(or deletes) the keys that were active before
+func keysRotate(a *app, args []string) error {
+	fs := a.flagSet("keys rotate")
+	environmentKey := fs.String("env", "", "environment API key")
+	name := fs.String("name", "", "name of the new key(defaults to a timestamped name)")
+	deleteOld := fs.Bool("delete-old", false, "delete the previous keys instead of deactivating them")
+	if err := a.parse(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlags(fs, "env"); err != nil {
+		return err
+	}
+	if *name == "" {
+		*name = "rotated " + time.Now().UTC().Format(time.RFC3339)
+	}
+
+	client, err := a.api()
+	if err != nil {
+		return err
+	}
+	previous, err := client.GetServerSideEnvKeys(*environmentKey)
+	if err != nil {
+		return err
+	}
+	key := flagsmithapi.ServerSideEnvKey{Name: *name, Active: true}
+	if err := client.CreateServerSideEnvKey(*environmentKey, &key); err != nil {
+		return err
+	}
+	for _, old := range previous {
+		switch {
+		case *deleteOld:
+			err = client.DeleteServerSideEnvKey(*environmentKey, old.ID)
+		case old.Active:
+			old.Active = false
+			err = client.UpdateServerSideEnvKey(*environmentKey, &old)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return a.print(key, table{
+		headers: []string{"ID", "NAME", "KEY", "ACTIVE"},
+		rows:    [][]string{{strconv.FormatInt(key.ID, 10), key.Name, key.Key, strconv.FormatBool(key.Active)}},
+	})
+}
@@ -0,0 +1,132 @@
+// Command flagsmith-admin performs day-to-day administration tasks against the Flagsmith Admin API.
+//
+// Usage:
+//
+//	flagsmith-admin [--config path] <command> <subcommand> [flags]
+//
+// The master API key, API URL and default project are read from flags, then the FLAGSMITH_MASTER_API_KEY,
+// FLAGSMITH_API_URL and FLAGSMITH_PROJECT environment variables, then the config file(FLAGSMITH_ADMIN_CONFIG or
+// flagsmith-admin/config.yaml in the user config directory). A personal API token(FLAGSMITH_API_TOKEN or api_token)
+// is used instead of the master API key when set:
+//
+//	master_api_key: <key>
+//	api_token: <token>
+//	api_url: https://api.flagsmith.com/api/v1
+//	project: <project uuid>
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+const usage = `Usage: flagsmith-admin [--config path] <command> <subcommand> [flags]
+
+Commands:
+  features list                        List the features of the project
+  features get <uuid>                  Show a feature
+  features create --name <name>        Create a feature
+  features archive <uuid>              Archive a feature
+  flags set --env <key> --feature <name> [--enabled=true|false] [--value <value>]
+                                       Update the environment default state of a feature
+  segments apply -f <file>             Create or update the segment defined in a YAML or JSON file
+  identities override --env <key> --identity <identifier> --feature <name> [--enabled=true|false] [--value <value>] [--delete]
+                                       Set or delete the override of a feature for an identity
+  keys rotate --env <key>              Create a server-side environment key and deactivate the existing ones
+  code-refs scan [--dir <path>] [--pattern <regexp>]... [--string-literals] [--strict]
+                                       Find unreferenced features and unknown flag names in source code
+
+Common flags:
+  --api-url <url>                      Base URL of the Admin API
+  --project <uuid>                     Project used by the features and segments commands
+  --output json|table|yaml             Output format (default table)
+`
+
+type command func(a *app, args []string) error
+
+var commands = map[string]map[string]command{
+	"features": {
+		"list":    featuresList,
+		"get":     featuresGet,
+		"create":  featuresCreate,
+		"archive": featuresArchive,
+	},
+	"flags": {
+		"set": flagsSet,
+	},
+	"segments": {
+		"apply": segmentsApply,
+	},
+	"identities": {
+		"override": identitiesOverride,
+	},
+	"keys": {
+		"rotate": keysRotate,
+	},
+	"code-refs": {
+		"scan": codeRefsScan,
+	},
+}
+
+// errUsage is returned for invalid invocations, which print the usage and exit with status 2
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Getenv, os.Stdout, os.Stderr))
+}
+
+func run(args []string, getenv func(string) string, stdout, stderr io.Writer) int {
+	global := flag.NewFlagSet("flagsmith-admin", flag.ContinueOnError)
+	global.SetOutput(io.Discard)
+	configPath := global.String("config", getenv("FLAGSMITH_ADMIN_CONFIG"), "path of the config file")
+	if err := global.Parse(args); err != nil {
+		return fail(stderr, fmt.Errorf("%w: %s", errUsage, err))
+	}
+
+	args = global.Args()
+	if len(args) < 2 {
+		return fail(stderr, errUsage)
+	}
+	subcommands, ok := commands[args[0]]
+	if !ok {
+		return fail(stderr, fmt.Errorf("%w: unknown command '%s'", errUsage, args[0]))
+	}
+	cmd, ok := subcommands[args[1]]
+	if !ok {
+		return fail(stderr, fmt.Errorf("%w: unknown subcommand '%s %s'(available: %s)", errUsage, args[0], args[1],
+			strings.Join(sortedNames(subcommands), ", ")))
+	}
+
+	settings, err := loadSettings(*configPath, getenv)
+	if err != nil {
+		return fail(stderr, err)
+	}
+	a := &app{settings: settings, output: outputTable, stdout: stdout}
+	if err := cmd(a, args[2:]); err != nil {
+		return fail(stderr, err)
+	}
+	return 0
+}
+
+func fail(stderr io.Writer, err error) int {
+	fmt.Fprintf(stderr, "flagsmith-admin: %s\n", err)
+	if errors.Is(err, errUsage) {
+		fmt.Fprint(stderr, "\n"+usage)
+		return 2
+	}
+	return 1
+}
+
+func sortedNames(subcommands map[string]command) []string {
+	names := make([]string, 0, len(subcommands))
+	for name := range subcommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,316 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+type fixture struct {
+	server      *flagsmithtest.Server
+	client      *flagsmithapi.Client
+	project     *flagsmithapi.Project
+	environment *flagsmithapi.Environment
+	env         map[string]string
+}
+
+func setup(t *testing.T) *fixture {
+	// keep the user's config file out of the tests
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&environment))
+	return &fixture{
+		server:      server,
+		client:      client,
+		project:     &project,
+		environment: &environment,
+		env: map[string]string{
+			"FLAGSMITH_MASTER_API_KEY": flagsmithtest.MasterAPIKey,
+			"FLAGSMITH_API_URL":        server.URL,
+			"FLAGSMITH_PROJECT":        project.UUID,
+		},
+	}
+}
+
+func (f *fixture) run(args ...string) (int, string, string) {
+	stdout, stderr := bytes.Buffer{}, bytes.Buffer{}
+	code := run(args, func(name string) string { return f.env[name] }, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func (f *fixture) createFeature(t *testing.T, name string) *flagsmithapi.Feature {
+	feature := flagsmithapi.Feature{Name: name, ProjectID: &f.project.ID, InitialValue: "1"}
+	require.NoError(t, f.client.CreateFeature(&feature))
+	return &feature
+}
+
+func TestFeatures(t *testing.T) {
+	// Given
+	f := setup(t)
+
+	// When
+	code, stdout, stderr := f.run("features", "create", "--name", "checkout_v2", "--description", "New checkout", "--output", "json")
+
+	// Then
+	require.Equal(t, 0, code, stderr)
+	created := flagsmithapi.Feature{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &created))
+	assert.Equal(t, "checkout_v2", created.Name)
+
+	// When
+	code, stdout, _ = f.run("features", "list")
+
+	// Then
+	assert.Equal(t, 0, code)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	require.Len(t, lines, 2)
+	assert.Regexp(t, `^ID\s+NAME\s+TYPE\s+ARCHIVED\s+DESCRIPTION\s+UUID$`, lines[0])
+	assert.Regexp(t, `checkout_v2\s+STANDARD\s+false\s+New checkout\s+`+created.UUID, lines[1])
+
+	// When
+	code, _, _ = f.run("features", "archive", created.UUID)
+	_, stdout, _ = f.run("features", "get", created.UUID, "--output", "yaml")
+
+	// Then
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "is_archived: true\n")
+	assert.Contains(t, stdout, "name: checkout_v2\n")
+}
+
+func TestFlagsSet(t *testing.T) {
+	// Given
+	f := setup(t)
+	feature := f.createFeature(t, "checkout_v2")
+
+	// When
+	code, stdout, stderr := f.run("flags", "set", "--env", f.environment.APIKey, "--feature", "checkout_v2", "--enabled", "--value", "blue")
+
+	// Then
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `checkout_v2\s+Development\s+true\s+blue`, stdout)
+	featureState, err := f.client.GetEnvironmentFeatureState(f.environment.APIKey, *feature.ID)
+	require.NoError(t, err)
+	assert.True(t, featureState.Enabled)
+	assert.Equal(t, "blue", *featureState.FeatureStateValue.StringValue)
+
+	// When
+	code, _, _ = f.run("flags", "set", "--env", f.environment.APIKey, "--feature", "checkout_v2", "--value", "10", "--type", "string")
+
+	// Then
+	assert.Equal(t, 0, code)
+	featureState, err = f.client.GetEnvironmentFeatureState(f.environment.APIKey, *feature.ID)
+	require.NoError(t, err)
+	assert.True(t, featureState.Enabled)
+	assert.Equal(t, "10", *featureState.FeatureStateValue.StringValue)
+}
+
+func TestSegmentsApply(t *testing.T) {
+	// Given
+	f := setup(t)
+	path := filepath.Join(t.TempDir(), "segment.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+name: pro_users
+rules:
+  - type: ALL
+    rules:
+      - type: ANY
+        conditions:
+          - {property: plan, operator: EQUAL, value: pro}
+`), 0o644))
+
+	// When
+	firstCode, _, stderr := f.run("segments", "apply", "-f", path)
+	secondCode, _, _ := f.run("segments", "apply", "-f", path)
+
+	// Then
+	require.Equal(t, 0, firstCode, stderr)
+	assert.Equal(t, 0, secondCode)
+	segments, err := f.client.ListSegments(f.project.ID)
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+	assert.Equal(t, flagsmithapi.All(flagsmithapi.Any(flagsmithapi.Eq("plan", "pro"))), segments[0].Rules[0])
+}
+
+func TestIdentitiesOverride(t *testing.T) {
+	// Given
+	f := setup(t)
+	feature := f.createFeature(t, "checkout_v2")
+	args := []string{"identities", "override", "--env", f.environment.APIKey, "--identity", "user_1", "--feature", "checkout_v2"}
+
+	// When
+	code, stdout, stderr := f.run(append(args, "--enabled")...)
+
+	// Then
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `checkout_v2\s+Development\s+user_1\s+true\s+1\s+false`, stdout)
+	identities, err := f.client.ListIdentities(f.environment.APIKey, "user_1")
+	require.NoError(t, err)
+	require.Len(t, identities, 1)
+	overrides, err := f.client.ListIdentityOverrides(f.environment.APIKey, *identities[0].ID)
+	require.NoError(t, err)
+	require.Len(t, overrides, 1)
+	assert.Equal(t, *feature.ID, overrides[0].Feature)
+	assert.True(t, overrides[0].Enabled)
+
+	// When
+	code, _, _ = f.run(append(args, "--value", "2")...)
+
+	// Then
+	assert.Equal(t, 0, code)
+	overrides, err = f.client.ListIdentityOverrides(f.environment.APIKey, *identities[0].ID)
+	require.NoError(t, err)
+	require.Len(t, overrides, 1)
+	assert.True(t, overrides[0].Enabled)
+	assert.Equal(t, int64(2), *overrides[0].FeatureStateValue.IntegerValue)
+
+	// When
+	code, _, _ = f.run(append(args, "--delete")...)
+
+	// Then
+	assert.Equal(t, 0, code)
+	overrides, err = f.client.ListIdentityOverrides(f.environment.APIKey, *identities[0].ID)
+	require.NoError(t, err)
+	assert.Empty(t, overrides)
+}
+
+func TestKeysRotate(t *testing.T) {
+	// Given
+	f := setup(t)
+	old := flagsmithapi.ServerSideEnvKey{Name: "old", Active: true}
+	require.NoError(t, f.client.CreateServerSideEnvKey(f.environment.APIKey, &old))
+
+	// When
+	code, stdout, stderr := f.run("keys", "rotate", "--env", f.environment.APIKey, "--name", "new", "--output", "json")
+
+	// Then
+	require.Equal(t, 0, code, stderr)
+	created := flagsmithapi.ServerSideEnvKey{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &created))
+	keys, err := f.client.GetServerSideEnvKeys(f.environment.APIKey)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	for _, key := range keys {
+		assert.Equal(t, key.ID == created.ID, key.Active, key.Name)
+	}
+
+	// When
+	code, _, _ = f.run("keys", "rotate", "--env", f.environment.APIKey, "--delete-old")
+
+	// Then
+	assert.Equal(t, 0, code)
+	keys, err = f.client.GetServerSideEnvKeys(f.environment.APIKey)
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+}
+
+func TestCodeRefsScan(t *testing.T) {
+	// Given
+	f := setup(t)
+	f.createFeature(t, "checkout_v2")
+	f.createFeature(t, "unused_feature")
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(`package main
+
+var checkout, _ = flags.IsFeatureEnabled("checkout_v2")
+var search, _ = flags.IsFeatureEnabled("legacy_search")
+`), 0o644))
+
+	// When
+	code, stdout, stderr := f.run("code-refs", "scan", "--dir", dir)
+
+	// Then
+	require.Equal(t, 0, code, stderr)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	require.Len(t, lines, 4)
+	assert.Regexp(t, `^checkout_v2\s+referenced\s+main.go:3$`, lines[1])
+	assert.Regexp(t, `^unused_feature\s+unreferenced\s*$`, lines[2])
+	assert.Regexp(t, `^legacy_search\s+unknown\s+main.go:4$`, lines[3])
+
+	// When
+	code, _, stderr = f.run("code-refs", "scan", "--dir", dir, "--strict")
+
+	// Then
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "found 1 unreferenced features and 1 references to unknown features")
+}
+
+func TestSettingsFromConfigFile(t *testing.T) {
+	// Given
+	f := setup(t)
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("master_api_key: "+flagsmithtest.MasterAPIKey+"\napi_url: "+f.server.URL+"\n"), 0o600))
+	f.createFeature(t, "checkout_v2")
+	f.env = map[string]string{"FLAGSMITH_PROJECT": f.project.UUID}
+
+	// When
+	code, stdout, stderr := f.run("--config", path, "features", "list")
+
+	// Then
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "checkout_v2")
+
+	// When
+	code, _, stderr = f.run("features", "list")
+
+	// Then
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "no master API key configured")
+}
+
+func TestUserToken(t *testing.T) {
+	// Given
+	f := setup(t)
+	f.createFeature(t, "checkout_v2")
+	delete(f.env, "FLAGSMITH_MASTER_API_KEY")
+	f.env["FLAGSMITH_API_TOKEN"] = flagsmithtest.UserToken
+
+	// When
+	code, stdout, stderr := f.run("features", "list")
+
+	// Then
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "checkout_v2")
+}
+
+func TestUsageErrors(t *testing.T) {
+	f := setup(t)
+	testCases := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{"no command", nil, "invalid usage"},
+		{"unknown command", []string{"flag", "list"}, "unknown command 'flag'"},
+		{"unknown subcommand", []string{"features", "delete"}, "available: archive, create, get, list"},
+		{"missing flag", []string{"flags", "set", "--feature", "a", "--enabled"}, "--env is required"},
+		{"nothing to set", []string{"flags", "set", "--env", "key", "--feature", "a"}, "pass --enabled and/or --value"},
+		{"unsupported output", []string{"features", "list", "--output", "xml"}, "unsupported output format 'xml'"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When
+			code, _, stderr := f.run(tc.args...)
+
+			// Then
+			assert.Equal(t, 2, code)
+			assert.Contains(t, stderr, tc.expectedError)
+			assert.Contains(t, stderr, "Usage: flagsmith-admin")
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/config"
+)
+
+// segmentsApply creates the segment defined in the file, or updates the segment of the project with the same name.
+// The file uses the segment format of config specs.
+func segmentsApply(a *app, args []string) error {
+	fs := a.flagSet("segments apply")
+	path := fs.String("f", "", "YAML or JSON file defining the segment")
+	if err := a.parse(fs, args); err != nil {
+		return err
+	}
+	if err := requireFlags(fs, "f"); err != nil {
+		return err
+	}
+	data, err := os.ReadFile(*path)
+	if err != nil {
+		return err
+	}
+	spec := config.Segment{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&spec); err != nil {
+		return fmt.Errorf("error parsing segment file: %w", err)
+	}
+	if spec.Name == "" {
+		return fmt.Errorf("segment file '%s' has no name", *path)
+	}
+
+	project, err := a.project()
+	if err != nil {
+		return err
+	}
+	segments, err := a.client.ListSegments(project.ID)
+	if err != nil {
+		return err
+	}
+	segment := flagsmithapi.Segment{Name: spec.Name, ProjectID: &project.ID}
+	for _, existing := range segments {
+		if existing.Name == spec.Name {
+			segment = existing
+		}
+	}
+	segment.Rules = spec.Rules
+	if spec.Description != "" {
+		segment.Description = &spec.Description
+	}
+	if segment.ID == nil {
+		err = a.client.CreateSegment(&segment)
+	} else {
+		err = a.client.UpdateSegment(&segment)
+	}
+	if err != nil {
+		return err
+	}
+	return a.print(segment, table{
+		headers: []string{"ID", "NAME", "DESCRIPTION", "UUID"},
+		rows:    [][]string{{strconv.FormatInt(*segment.ID, 10), segment.Name, stringValue(segment.Description), segment.UUID}},
+	})
+}
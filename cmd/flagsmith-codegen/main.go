@@ -0,0 +1,115 @@
+// Command flagsmith-codegen generates a Go file with a typed constant and a value accessor per feature of a project,
+// read from the Admin API or from an export bundle. It is meant to be run by go generate:
+//
+//	//go:generate go run github.com/Flagsmith/flagsmith-go-api-client/cmd/flagsmith-codegen -project <uuid> -o flags_gen.go
+//	//go:generate go run github.com/Flagsmith/flagsmith-go-api-client/cmd/flagsmith-codegen -bundle flags.json -o flags_gen.go
+//
+// The master API key and API URL are read from the FLAGSMITH_MASTER_API_KEY and FLAGSMITH_API_URL environment
+// variables; the project defaults to FLAGSMITH_PROJECT and the package to GOPACKAGE, which go generate sets.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/bundle"
+	"github.com/Flagsmith/flagsmith-go-api-client/codegen"
+)
+
+const defaultAPIURL = "https://api.flagsmith.com/api/v1"
+
+const usage = `Usage: flagsmith-codegen (-project <uuid> | -bundle <file>) [-package <name>] [-o <file>]
+
+Flags:
+  -project <uuid>    Project to read the features from (default $FLAGSMITH_PROJECT)
+  -bundle <file>     Export bundle to read the features from instead of the API
+  -package <name>    Package of the generated file (default $GOPACKAGE)
+  -o <file>          Output file (default stdout)
+  -api-url <url>     Base URL of the Admin API (default $FLAGSMITH_API_URL or ` + defaultAPIURL + `)
+`
+
+// errUsage is returned for invalid invocations, which print the usage and exit with status 2
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Getenv, os.Stdout, os.Stderr))
+}
+
+func run(args []string, getenv func(string) string, stdout, stderr io.Writer) int {
+	if err := generate(args, getenv, stdout); err != nil {
+		fmt.Fprintf(stderr, "flagsmith-codegen: %s\n", err)
+		if errors.Is(err, errUsage) {
+			fmt.Fprint(stderr, "\n"+usage)
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+func generate(args []string, getenv func(string) string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("flagsmith-codegen", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	project := fs.String("project", getenv("FLAGSMITH_PROJECT"), "project UUID")
+	bundlePath := fs.String("bundle", "", "path of an export bundle")
+	packageName := fs.String("package", getenv("GOPACKAGE"), "package of the generated file")
+	output := fs.String("o", "", "output file")
+	apiURL := fs.String("api-url", getenv("FLAGSMITH_API_URL"), "base URL of the Admin API")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s", errUsage, err)
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: unexpected arguments %v", errUsage, fs.Args())
+	}
+	if *packageName == "" {
+		return fmt.Errorf("%w: -package is required outside of go generate", errUsage)
+	}
+
+	config := codegen.Config{Package: *packageName}
+	switch {
+	case *bundlePath != "":
+		file, err := os.Open(*bundlePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		b, err := bundle.Read(file)
+		if err != nil {
+			return err
+		}
+		config.Source = fmt.Sprintf("bundle %s", *bundlePath)
+		config.Features = codegen.FromBundle(b)
+	case *project != "":
+		masterAPIKey := getenv("FLAGSMITH_MASTER_API_KEY")
+		if masterAPIKey == "" {
+			return errors.New("FLAGSMITH_MASTER_API_KEY is not set")
+		}
+		if *apiURL == "" {
+			*apiURL = defaultAPIURL
+		}
+		features, err := codegen.FromAPI(flagsmithapi.NewClient(masterAPIKey, *apiURL), *project)
+		if err != nil {
+			return err
+		}
+		config.Source = fmt.Sprintf("project %s", *project)
+		config.Features = features
+	default:
+		return fmt.Errorf("%w: one of -project or -bundle is required", errUsage)
+	}
+
+	// the output file is only written once the code is generated, to keep the previous file on errors
+	buf := bytes.Buffer{}
+	if err := codegen.Generate(&buf, config); err != nil {
+		return err
+	}
+	if *output == "" || *output == "-" {
+		_, err := stdout.Write(buf.Bytes())
+		return err
+	}
+	return os.WriteFile(*output, buf.Bytes(), 0o644)
+}
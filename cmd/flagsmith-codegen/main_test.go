@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/bundle"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+type fixture struct {
+	client  *flagsmithapi.Client
+	project *flagsmithapi.Project
+	env     map[string]string
+}
+
+func setup(t *testing.T) *fixture {
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", InitialValue: "true", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&feature))
+	return &fixture{
+		client:  client,
+		project: &project,
+		env: map[string]string{
+			"FLAGSMITH_MASTER_API_KEY": flagsmithtest.MasterAPIKey,
+			"FLAGSMITH_API_URL":        server.URL,
+			"FLAGSMITH_PROJECT":        project.UUID,
+			"GOPACKAGE":                "flags",
+		},
+	}
+}
+
+func (f *fixture) run(args ...string) (int, string, string) {
+	stdout, stderr := bytes.Buffer{}, bytes.Buffer{}
+	code := run(args, func(name string) string { return f.env[name] }, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestGenerateFromAPI(t *testing.T) {
+	// Given
+	f := setup(t)
+
+	// When
+	code, stdout, stderr := f.run()
+
+	// Then
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "// Source: project "+f.project.UUID+"\n\npackage flags\n")
+	assert.Contains(t, stdout, "\tCheckoutV2 Feature = \"checkout_v2\"\n")
+	assert.Contains(t, stdout, "func CheckoutV2Value(flags Flags) (bool, error) {\n")
+}
+
+func TestGenerateFromBundle(t *testing.T) {
+	// Given
+	f := setup(t)
+	b, err := bundle.Export(f.client, f.project.UUID)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	bundlePath := filepath.Join(dir, "flags.json")
+	file, err := os.Create(bundlePath)
+	require.NoError(t, err)
+	require.NoError(t, b.Write(file))
+	require.NoError(t, file.Close())
+	output := filepath.Join(dir, "flags_gen.go")
+	// the API is not used
+	delete(f.env, "FLAGSMITH_MASTER_API_KEY")
+
+	// When
+	code, stdout, stderr := f.run("-bundle", bundlePath, "-package", "features", "-o", output)
+
+	// Then
+	require.Equal(t, 0, code, stderr)
+	assert.Empty(t, stdout)
+	generated, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Contains(t, string(generated), "// Source: bundle "+bundlePath+"\n\npackage features\n")
+	assert.Contains(t, string(generated), "\tCheckoutV2 Feature = \"checkout_v2\"\n")
+}
+
+func TestUsageErrors(t *testing.T) {
+	// Given
+	f := setup(t)
+	delete(f.env, "GOPACKAGE")
+
+	// When
+	code, _, stderr := f.run()
+
+	// Then
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "flagsmith-codegen: invalid usage: -package is required outside of go generate\n")
+
+	// Given
+	delete(f.env, "FLAGSMITH_PROJECT")
+
+	// When
+	code, _, stderr = f.run("-package", "flags")
+
+	// Then
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "flagsmith-codegen: invalid usage: one of -project or -bundle is required\n")
+}
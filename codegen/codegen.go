@@ -0,0 +1,381 @@
+// Package codegen generates a Go file with a typed constant per feature of a project, and accessors for their values,
+// so that application code refers to flags by identifiers the compiler checks instead of by name.
+//
+// The generated code does not depend on this module: accessors take a Flags interface that the flags returned by the
+// Flagsmith Go SDK satisfy. cmd/flagsmith-codegen wraps the package for use with go generate:
+//
+//	//go:generate go run github.com/Flagsmith/flagsmith-go-api-client/cmd/flagsmith-codegen -project <uuid> -o flags_gen.go
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/bundle"
+)
+
+// Feature is the subset of a feature used by the generator
+type Feature struct {
+	Name         string
+	Description  string
+	InitialValue string
+	// Options are the values of the multivariate options of the feature
+	Options []*flagsmithapi.FeatureStateValue
+}
+
+type Config struct {
+	// Package is the name of the generated package
+	Package string
+	// Source describes where the features were read from, for the header of the generated file
+	Source   string
+	Features []Feature
+}
+
+// FromAPI reads the features of the project, excluding archived features
+func FromAPI(api flagsmithapi.API, projectUUID string) ([]Feature, error) {
+	project, err := api.GetProject(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	features, err := api.ListFeatures(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := []Feature{}
+	for _, feature := range features {
+		if feature.IsArchived {
+			continue
+		}
+		generated := Feature{Name: feature.Name, InitialValue: feature.InitialValue}
+		if feature.Description != nil {
+			generated.Description = *feature.Description
+		}
+		options, err := api.ListFeatureMVOptions(project.ID, *feature.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, option := range options {
+			generated.Options = append(generated.Options, &flagsmithapi.FeatureStateValue{
+				Type:         option.Type,
+				StringValue:  option.StringValue,
+				IntegerValue: option.IntegerValue,
+				BooleanValue: option.BooleanValue,
+			})
+		}
+		result = append(result, generated)
+	}
+	return result, nil
+}
+
+// FromBundle reads the features of an export bundle, excluding archived features
+func FromBundle(b *bundle.Bundle) []Feature {
+	result := []Feature{}
+	for _, feature := range b.Features {
+		if feature.IsArchived {
+			continue
+		}
+		generated := Feature{Name: feature.Name, Description: feature.Description, InitialValue: feature.InitialValue}
+		for _, option := range feature.MultivariateOptions {
+			generated.Options = append(generated.Options, option.Value)
+		}
+		result = append(result, generated)
+	}
+	return result
+}
+
+// value types of the generated accessors
+const (
+	typeString  = "string"
+	typeInt     = "int64"
+	typeBool    = "bool"
+	typeUnknown = "interface{}"
+)
+
+// identifiers declared by the generated file for every feature set
+var reservedIdentifiers = []string{"Feature", "Flags", "Features"}
+
+type templateFeature struct {
+	Identifier string
+	Name       string
+	// Comment are the lines of the description as Go comments
+	Comment   []string
+	ValueType string
+	// Initial and Options are Go literals of ValueType
+	Initial string
+	Options []string
+}
+
+// Generate writes the Go source for the features, ordered by name. Feature names are converted to exported
+// identifiers(i.e: checkout_v2 to CheckoutV2); names that convert to identifiers already declared are an error.
+func Generate(w io.Writer, config Config) error {
+	if !isIdentifier(config.Package) {
+		return fmt.Errorf("codegen: invalid package name '%s'", config.Package)
+	}
+	features := make([]Feature, len(config.Features))
+	copy(features, config.Features)
+	sort.Slice(features, func(i, j int) bool { return features[i].Name < features[j].Name })
+
+	data := struct {
+		Package  string
+		Source   string
+		Features []templateFeature
+		Uses     map[string]bool
+	}{Package: config.Package, Source: config.Source, Features: []templateFeature{}, Uses: map[string]bool{}}
+	declared := map[string]string{}
+	for _, identifier := range reservedIdentifiers {
+		declared[identifier] = ""
+	}
+	declare := func(identifier, featureName string) error {
+		if other, ok := declared[identifier]; ok {
+			if other == "" {
+				return fmt.Errorf("codegen: feature '%s' maps to the reserved identifier %s", featureName, identifier)
+			}
+			return fmt.Errorf("codegen: features '%s' and '%s' both map to the identifier %s", other, featureName, identifier)
+		}
+		declared[identifier] = featureName
+		return nil
+	}
+
+	for _, feature := range features {
+		generated := templateFeature{
+			Identifier: Identifier(feature.Name),
+			Name:       feature.Name,
+			ValueType:  valueType(feature),
+		}
+		identifiers := []string{generated.Identifier, generated.Identifier + "Value"}
+		if description := strings.TrimSpace(feature.Description); description != "" {
+			for _, line := range strings.Split(description, "\n") {
+				generated.Comment = append(generated.Comment, strings.TrimRight("// "+line, " \t\r"))
+			}
+		}
+		if feature.InitialValue != "" {
+			generated.Initial = literal(inferValue(feature.InitialValue), generated.ValueType)
+			identifiers = append(identifiers, generated.Identifier+"Default")
+		}
+		for _, option := range feature.Options {
+			generated.Options = append(generated.Options, literal(option, generated.ValueType))
+		}
+		if len(generated.Options) > 0 {
+			identifiers = append(identifiers, generated.Identifier+"Options")
+		}
+		for _, identifier := range identifiers {
+			if err := declare(identifier, feature.Name); err != nil {
+				return err
+			}
+		}
+		data.Uses[generated.ValueType] = true
+		data.Features = append(data.Features, generated)
+	}
+
+	buf := bytes.Buffer{}
+	if err := fileTemplate.Execute(&buf, data); err != nil {
+		return err
+	}
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("codegen: Error formatting generated code: %w", err)
+	}
+	_, err = w.Write(source)
+	return err
+}
+
+// Identifier converts a feature name to an exported Go identifier, capitalising the letter after each separator;
+// names that do not start with a letter are prefixed with Feature
+func Identifier(name string) string {
+	b := strings.Builder{}
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	identifier := b.String()
+	if identifier == "" || !unicode.IsLetter([]rune(identifier)[0]) {
+		identifier = "Feature" + identifier
+	}
+	return identifier
+}
+
+func isIdentifier(name string) bool {
+	for i, r := range name {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return name != ""
+}
+
+// valueType returns the Go type of the values of the feature: the type of the initial value and of the options if
+// they agree, else interface{}
+func valueType(feature Feature) string {
+	types := map[string]bool{}
+	if feature.InitialValue != "" {
+		types[goType(inferValue(feature.InitialValue))] = true
+	}
+	for _, option := range feature.Options {
+		types[goType(option)] = true
+	}
+	switch len(types) {
+	case 0:
+		return typeString
+	case 1:
+		for t := range types {
+			return t
+		}
+	}
+	return typeUnknown
+}
+
+// inferValue infers the type of an initial value the way the API does
+func inferValue(value string) *flagsmithapi.FeatureStateValue {
+	if intValue, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return &flagsmithapi.FeatureStateValue{Type: "int", IntegerValue: &intValue}
+	}
+	if value == "true" || value == "false" {
+		boolValue := value == "true"
+		return &flagsmithapi.FeatureStateValue{Type: "bool", BooleanValue: &boolValue}
+	}
+	return &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value}
+}
+
+func goType(value *flagsmithapi.FeatureStateValue) string {
+	switch {
+	case value == nil:
+		return typeUnknown
+	case value.IntegerValue != nil:
+		return typeInt
+	case value.BooleanValue != nil:
+		return typeBool
+	}
+	return typeString
+}
+
+// literal returns the value as a Go literal; integers are converted explicitly when the value type is interface{}
+func literal(value *flagsmithapi.FeatureStateValue, valueType string) string {
+	switch {
+	case value == nil:
+		return "nil"
+	case value.IntegerValue != nil:
+		if valueType == typeUnknown {
+			return fmt.Sprintf("int64(%d)", *value.IntegerValue)
+		}
+		return strconv.FormatInt(*value.IntegerValue, 10)
+	case value.BooleanValue != nil:
+		return strconv.FormatBool(*value.BooleanValue)
+	case value.StringValue != nil:
+		return strconv.Quote(*value.StringValue)
+	}
+	return `""`
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by flagsmith-codegen. DO NOT EDIT.
+{{- if .Source}}
+// Source: {{.Source}}
+{{- end}}
+
+package {{.Package}}
+{{if or .Uses.int64 .Uses.bool .Uses.string}}
+import "fmt"
+{{end}}
+// Feature is the name of a Flagsmith feature
+type Feature string
+
+// Flags is satisfied by the flags returned by the Flagsmith Go SDK
+type Flags interface {
+	IsFeatureEnabled(featureName string) (bool, error)
+	GetFeatureValue(featureName string) (interface{}, error)
+}
+
+// Enabled reports whether the feature is enabled
+func (f Feature) Enabled(flags Flags) (bool, error) {
+	return flags.IsFeatureEnabled(string(f))
+}
+
+const (
+{{- range .Features}}
+	// {{.Identifier}} is the feature '{{.Name}}'
+	{{- if .Comment}}
+	//
+	{{- range .Comment}}
+	{{.}}
+	{{- end}}
+	{{- end}}
+	{{.Identifier}} Feature = {{printf "%q" .Name}}
+{{- end}}
+)
+
+// Features lists every feature
+var Features = []Feature{
+{{- range .Features}}
+	{{.Identifier}},
+{{- end}}
+}
+{{range .Features}}
+{{- if .Initial}}
+// {{.Identifier}}Default is the initial value of {{.Name}}
+{{if eq .ValueType "interface{}"}}var {{.Identifier}}Default interface{}{{else}}const {{.Identifier}}Default {{.ValueType}}{{end}} = {{.Initial}}
+{{end}}
+{{- if .Options}}
+// {{.Identifier}}Options are the values of the multivariate options of {{.Name}}
+var {{.Identifier}}Options = []{{.ValueType}}{ {{- range .Options}}{{.}}, {{end -}} }
+{{end}}
+// {{.Identifier}}Value returns the value of {{.Name}}
+func {{.Identifier}}Value(flags Flags) ({{.ValueType}}, error) {
+{{- if eq .ValueType "interface{}"}}
+	return flags.GetFeatureValue(string({{.Identifier}}))
+{{- else}}
+	value, err := flags.GetFeatureValue(string({{.Identifier}}))
+	if err != nil {
+		return {{if eq .ValueType "int64"}}0{{else if eq .ValueType "bool"}}false{{else}}""{{end}}, err
+	}
+	return {{.ValueType}}Value({{.Identifier}}, value)
+{{- end}}
+}
+{{end}}
+{{- if .Uses.int64}}
+func int64Value(feature Feature, value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v), nil
+		}
+	case interface{ Int64() (int64, error) }:
+		return v.Int64()
+	}
+	return 0, fmt.Errorf("%s: expected an integer value, got %T", feature, value)
+}
+{{end}}
+{{- if .Uses.bool}}
+func boolValue(feature Feature, value interface{}) (bool, error) {
+	if v, ok := value.(bool); ok {
+		return v, nil
+	}
+	return false, fmt.Errorf("%s: expected a boolean value, got %T", feature, value)
+}
+{{end}}
+{{- if .Uses.string}}
+func stringValue(feature Feature, value interface{}) (string, error) {
+	if v, ok := value.(string); ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("%s: expected a string value, got %T", feature, value)
+}
+{{end}}`))
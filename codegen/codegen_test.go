@@ -0,0 +1,190 @@
+package codegen_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/bundle"
+	"github.com/Flagsmith/flagsmith-go-api-client/codegen"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+func stringValue(value string) *flagsmithapi.FeatureStateValue {
+	return &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value}
+}
+
+func intValue(value int64) *flagsmithapi.FeatureStateValue {
+	return &flagsmithapi.FeatureStateValue{Type: "int", IntegerValue: &value}
+}
+
+// typeCheck parses and type checks the generated file, and returns its package scope
+func typeCheck(t *testing.T, source []byte) *types.Scope {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "flags_gen.go", source, parser.ParseComments)
+	require.NoError(t, err)
+	config := types.Config{Importer: importer.Default()}
+	pkg, err := config.Check("flags", fset, []*ast.File{file}, nil)
+	require.NoError(t, err)
+	return pkg.Scope()
+}
+
+func TestGenerate(t *testing.T) {
+	// Given
+	config := codegen.Config{
+		Package: "flags",
+		Source:  "bundle flags.json",
+		Features: []codegen.Feature{
+			{Name: "checkout_v2", Description: "New checkout flow.\n\nRemove after Q3.", InitialValue: "true"},
+			{Name: "max-items", InitialValue: "10"},
+			{Name: "button_colour", InitialValue: "blue", Options: []*flagsmithapi.FeatureStateValue{stringValue("green"), stringValue("red")}},
+			{Name: "mixed", InitialValue: "a", Options: []*flagsmithapi.FeatureStateValue{intValue(3)}},
+			{Name: "banner"},
+		},
+	}
+	buf := bytes.Buffer{}
+
+	// When
+	err := codegen.Generate(&buf, config)
+
+	// Then
+	require.NoError(t, err)
+	source := buf.String()
+	assert.Contains(t, source, "// Code generated by flagsmith-codegen. DO NOT EDIT.\n// Source: bundle flags.json\n\npackage flags\n")
+	assert.Contains(t, source, "\t// CheckoutV2 is the feature 'checkout_v2'\n"+
+		"\t//\n"+
+		"\t// New checkout flow.\n"+
+		"\t//\n"+
+		"\t// Remove after Q3.\n"+
+		"\tCheckoutV2 Feature = \"checkout_v2\"\n")
+	assert.Contains(t, source, "var Features = []Feature{\n\tBanner,\n\tButtonColour,\n\tCheckoutV2,\n\tMaxItems,\n\tMixed,\n}\n")
+	assert.Contains(t, source, "const CheckoutV2Default bool = true\n")
+	assert.Contains(t, source, "const MaxItemsDefault int64 = 10\n")
+	assert.Contains(t, source, "var ButtonColourOptions = []string{\"green\", \"red\"}\n")
+	assert.Contains(t, source, "var MixedOptions = []interface{}{int64(3)}\n")
+
+	scope := typeCheck(t, buf.Bytes())
+	for name, signature := range map[string]string{
+		"BannerValue":       "func(flags flags.Flags) (string, error)",
+		"ButtonColourValue": "func(flags flags.Flags) (string, error)",
+		"CheckoutV2Value":   "func(flags flags.Flags) (bool, error)",
+		"MaxItemsValue":     "func(flags flags.Flags) (int64, error)",
+		"MixedValue":        "func(flags flags.Flags) (interface{}, error)",
+	} {
+		object := scope.Lookup(name)
+		require.NotNil(t, object, name)
+		assert.Equal(t, signature, object.Type().String(), name)
+	}
+	assert.Nil(t, scope.Lookup("BannerDefault"))
+}
+
+func TestGenerateWithoutFeatures(t *testing.T) {
+	// Given
+	buf := bytes.Buffer{}
+
+	// When
+	err := codegen.Generate(&buf, codegen.Config{Package: "flags"})
+
+	// Then
+	require.NoError(t, err)
+	assert.NotContains(t, buf.String(), "import")
+	typeCheck(t, buf.Bytes())
+}
+
+func TestGenerateRejectsInvalidInput(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		config codegen.Config
+		err    string
+	}{
+		{
+			name:   "invalid package",
+			config: codegen.Config{Package: "my-flags"},
+			err:    "codegen: invalid package name 'my-flags'",
+		},
+		{
+			name:   "same identifier",
+			config: codegen.Config{Package: "flags", Features: []codegen.Feature{{Name: "new_banner"}, {Name: "new-banner"}}},
+			err:    "codegen: features 'new-banner' and 'new_banner' both map to the identifier NewBanner",
+		},
+		{
+			name:   "accessor identifier",
+			config: codegen.Config{Package: "flags", Features: []codegen.Feature{{Name: "banner"}, {Name: "banner_value"}}},
+			err:    "codegen: features 'banner' and 'banner_value' both map to the identifier BannerValue",
+		},
+		{
+			name:   "reserved identifier",
+			config: codegen.Config{Package: "flags", Features: []codegen.Feature{{Name: "features"}}},
+			err:    "codegen: feature 'features' maps to the reserved identifier Features",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			// When
+			err := codegen.Generate(&bytes.Buffer{}, test.config)
+
+			// Then
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	for name, expected := range map[string]string{
+		"checkout_v2":     "CheckoutV2",
+		"max-items":       "MaxItems",
+		"Search.Ranking":  "SearchRanking",
+		"new banner text": "NewBannerText",
+		"2fa":             "Feature2fa",
+		"__":              "Feature",
+	} {
+		assert.Equal(t, expected, codegen.Identifier(name), name)
+	}
+}
+
+func TestFromAPIAndBundle(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	description := "Colour of the buy button"
+	colour := flagsmithapi.Feature{Name: "button_colour", Description: &description, InitialValue: "blue", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&colour))
+	green := "green"
+	option := flagsmithapi.FeatureMultivariateOption{
+		Type: "unicode", StringValue: &green, DefaultPercentageAllocation: 50, FeatureID: colour.ID, ProjectID: &project.ID,
+	}
+	require.NoError(t, client.CreateFeatureMVOption(&option))
+	archived := flagsmithapi.Feature{Name: "archived", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&archived))
+	archived.IsArchived = true
+	require.NoError(t, client.UpdateFeature(&archived))
+
+	// When
+	fromAPI, err := codegen.FromAPI(client, project.UUID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []codegen.Feature{{
+		Name:         "button_colour",
+		Description:  "Colour of the buy button",
+		InitialValue: "blue",
+		Options:      []*flagsmithapi.FeatureStateValue{stringValue("green")},
+	}}, fromAPI)
+
+	// When
+	b, err := bundle.Export(client, project.UUID)
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, fromAPI, codegen.FromBundle(b))
+}
@@ -0,0 +1,192 @@
+// Package coderefs scans source code for references to feature flags, to find the features that are no longer used
+// and the flag names used in code that do not exist in the project.
+//
+//	features, err := client.ListFeatures(projectID)
+//	report, err := coderefs.Scan("./src", features, coderefs.Options{})
+//
+// References are found with regular expressions matched line by line; the first capture group of a pattern is the
+// feature name. DefaultPatterns matches the flag lookups of the Flagsmith Go, JavaScript/TypeScript and Python SDKs.
+package coderefs
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// DefaultPatterns match the feature name passed as a string literal to the flag lookup functions of the Flagsmith SDKs
+var DefaultPatterns = []*regexp.Regexp{
+	// Go: flags.IsFeatureEnabled("name"), flags.GetFeatureValue("name"), flags.GetFlag("name")
+	regexp.MustCompile("\\b(?:IsFeatureEnabled|GetFeatureValue|GetFlag)\\(\\s*[\"`]([^\"`]+)[\"`]"),
+	// JavaScript/TypeScript: flagsmith.hasFeature('name'), flagsmith.getValue('name'), flags.isFeatureEnabled('name');
+	// the receiver must be flagsmith or flags, as the method names are common outside of the SDK
+	regexp.MustCompile("\\b(?:flagsmith|flags)\\??\\.(?:hasFeature|getValue|isFeatureEnabled|getFeatureValue|getFlag)" +
+		"\\(\\s*[\"'`]([^\"'`]+)[\"'`]"),
+	// Python: flags.is_feature_enabled("name"), flags.get_feature_value("name"), flags.get_flag("name")
+	regexp.MustCompile(`\b(?:is_feature_enabled|get_feature_value|get_flag)\(\s*["']([^"']+)["']`),
+}
+
+// DefaultExtensions are the extensions of the files scanned when Options.Extensions is empty
+var DefaultExtensions = []string{".go", ".js", ".jsx", ".ts", ".tsx", ".py"}
+
+// DefaultExcludedDirs are the directories skipped when Options.ExcludedDirs is empty
+var DefaultExcludedDirs = []string{".git", "node_modules", "vendor", "dist", "build", "__pycache__", ".venv"}
+
+var stringLiteralPattern = regexp.MustCompile("[\"'`]([^\"'`\\s]+)[\"'`]")
+
+type Options struct {
+	// Patterns find references to features; each pattern must have a capture group for the feature name. Defaults to
+	// DefaultPatterns.
+	Patterns []*regexp.Regexp
+	// Extensions are the extensions(including the dot) of the files to scan. Defaults to DefaultExtensions.
+	Extensions []string
+	// ExcludedDirs are directory names that are not scanned. Defaults to DefaultExcludedDirs.
+	ExcludedDirs []string
+	// StringLiterals also counts any string literal equal to the name of a known feature as a reference, to find
+	// features whose names are kept in constants
+	StringLiterals bool
+}
+
+type Reference struct {
+	Feature string `json:"feature"`
+	// Path is relative to the scanned directory, with forward slashes
+	Path string `json:"path"`
+	Line int    `json:"line"`
+}
+
+type Report struct {
+	// References are the references to known features, by feature name
+	References map[string][]Reference `json:"references"`
+	// Unreferenced are the names of the known features that are not referenced, sorted
+	Unreferenced []string `json:"unreferenced"`
+	// Unknown are the references to names that are not known features
+	Unknown []Reference `json:"unknown"`
+}
+
+// Scan walks the directory and reports the references to the features
+func Scan(root string, features []flagsmithapi.Feature, opts Options) (*Report, error) {
+	patterns := opts.Patterns
+	if len(patterns) == 0 {
+		patterns = DefaultPatterns
+	}
+	for _, pattern := range patterns {
+		if pattern.NumSubexp() < 1 {
+			return nil, fmt.Errorf("coderefs: pattern '%s' has no capture group for the feature name", pattern)
+		}
+	}
+	extensions := opts.Extensions
+	if len(extensions) == 0 {
+		extensions = DefaultExtensions
+	}
+	excludedDirs := opts.ExcludedDirs
+	if len(excludedDirs) == 0 {
+		excludedDirs = DefaultExcludedDirs
+	}
+
+	known := map[string]bool{}
+	for _, feature := range features {
+		known[feature.Name] = true
+	}
+	s := &scanner{patterns: patterns, known: known, stringLiterals: opts.StringLiterals, seen: map[Reference]bool{}}
+	report := &Report{References: map[string][]Reference{}, Unreferenced: []string{}, Unknown: []Reference{}}
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path != root && contains(excludedDirs, entry.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !contains(extensions, filepath.Ext(path)) {
+			return nil
+		}
+		relative, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		references, err := s.scanFile(path, filepath.ToSlash(relative))
+		if err != nil {
+			return err
+		}
+		for _, reference := range references {
+			if known[reference.Feature] {
+				report.References[reference.Feature] = append(report.References[reference.Feature], reference)
+			} else {
+				report.Unknown = append(report.Unknown, reference)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("coderefs: Error scanning '%s': %w", root, err)
+	}
+
+	for name := range known {
+		if len(report.References[name]) == 0 {
+			report.Unreferenced = append(report.Unreferenced, name)
+		}
+	}
+	sort.Strings(report.Unreferenced)
+	return report, nil
+}
+
+type scanner struct {
+	patterns       []*regexp.Regexp
+	known          map[string]bool
+	stringLiterals bool
+	seen           map[Reference]bool
+}
+
+func (s *scanner) scanFile(path, relative string) ([]Reference, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	references := []Reference{}
+	add := func(name string, line int) {
+		reference := Reference{Feature: name, Path: relative, Line: line}
+		if !s.seen[reference] {
+			s.seen[reference] = true
+			references = append(references, reference)
+		}
+	}
+	lines := bufio.NewScanner(file)
+	lines.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; lines.Scan(); line++ {
+		text := lines.Text()
+		for _, pattern := range s.patterns {
+			for _, match := range pattern.FindAllStringSubmatch(text, -1) {
+				add(strings.TrimSpace(match[1]), line)
+			}
+		}
+		if s.stringLiterals {
+			for _, match := range stringLiteralPattern.FindAllStringSubmatch(text, -1) {
+				if s.known[match[1]] {
+					add(match[1], line)
+				}
+			}
+		}
+	}
+	return references, lines.Err()
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package coderefs_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/coderefs"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	root := t.TempDir()
+	for path, content := range files {
+		path = filepath.Join(root, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	return root
+}
+
+func features(names ...string) []flagsmithapi.Feature {
+	result := []flagsmithapi.Feature{}
+	for _, name := range names {
+		result = append(result, flagsmithapi.Feature{Name: name})
+	}
+	return result
+}
+
+func TestScan(t *testing.T) {
+	// Given
+	root := writeFiles(t, map[string]string{
+		"main.go": `package main
+
+func handler(flags Flags) {
+	if enabled, _ := flags.IsFeatureEnabled("checkout_v2"); enabled {
+		value, _ := flags.GetFeatureValue("banner_text")
+	}
+}
+`,
+		"web/app.ts": `const enabled = flagsmith.hasFeature('checkout_v2');
+const colour = flagsmith.getValue("button_colour");
+const size = flags?.getValue("button_size");
+const cached = cache.getValue("session_id") ?? settings.getFlag("dark_mode");
+`,
+		"service/flags.py": `if flags.is_feature_enabled("legacy_search"):
+    pass
+`,
+		"web/node_modules/lib/index.js": `flagsmith.hasFeature("unused_feature")`,
+		"README.md":                     `flags.IsFeatureEnabled("unused_feature")`,
+	})
+
+	// When
+	report, err := coderefs.Scan(root, features("checkout_v2", "banner_text", "unused_feature", "button_colour", "button_size"),
+		coderefs.Options{})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]coderefs.Reference{
+		"checkout_v2": {
+			{Feature: "checkout_v2", Path: "main.go", Line: 4},
+			{Feature: "checkout_v2", Path: "web/app.ts", Line: 1},
+		},
+		"banner_text":   {{Feature: "banner_text", Path: "main.go", Line: 5}},
+		"button_colour": {{Feature: "button_colour", Path: "web/app.ts", Line: 2}},
+		"button_size":   {{Feature: "button_size", Path: "web/app.ts", Line: 3}},
+	}, report.References)
+	assert.Equal(t, []string{"unused_feature"}, report.Unreferenced)
+	assert.Equal(t, []coderefs.Reference{{Feature: "legacy_search", Path: "service/flags.py", Line: 1}}, report.Unknown)
+}
+
+func TestScanWithCustomPatternsAndStringLiterals(t *testing.T) {
+	// Given
+	root := writeFiles(t, map[string]string{
+		"flags.go": `package flags
+
+const CheckoutV2 = "checkout_v2"
+
+var enabled = featureOn("new_banner")
+`,
+	})
+	opts := coderefs.Options{
+		Patterns:       []*regexp.Regexp{regexp.MustCompile(`featureOn\("([^"]+)"\)`)},
+		StringLiterals: true,
+	}
+
+	// When
+	report, err := coderefs.Scan(root, features("checkout_v2", "new_banner"), opts)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []coderefs.Reference{{Feature: "checkout_v2", Path: "flags.go", Line: 3}}, report.References["checkout_v2"])
+	// the pattern and the string literal match the same reference, which is reported once
+	assert.Equal(t, []coderefs.Reference{{Feature: "new_banner", Path: "flags.go", Line: 5}}, report.References["new_banner"])
+	assert.Empty(t, report.Unreferenced)
+	assert.Empty(t, report.Unknown)
+}
+
+func TestScanRejectsPatternsWithoutCaptureGroup(t *testing.T) {
+	// When
+	_, err := coderefs.Scan(t.TempDir(), nil, coderefs.Options{Patterns: []*regexp.Regexp{regexp.MustCompile(`isOn`)}})
+
+	// Then
+	assert.EqualError(t, err, "coderefs: pattern 'isOn' has no capture group for the feature name")
+}
@@ -0,0 +1,285 @@
+package flagsmithapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// EnvironmentComparison is the result of CompareEnvironments
+type EnvironmentComparison struct {
+	Environments []ComparedEnvironment `json:"environments"`
+	// Features lists the features whose states differ between the environments, ordered by name
+	Features []FeatureComparison `json:"features"`
+}
+
+type ComparedEnvironment struct {
+	Name   string `json:"name"`
+	APIKey string `json:"api_key"`
+}
+
+// FeatureComparison holds the states of a feature in each of the compared environments
+type FeatureComparison struct {
+	Feature string `json:"feature"`
+	// Differences lists what differs between the environments: "missing"(the feature does not exist in some of the
+	// environments), "enabled", "value", "segment_overrides" and "segment_priority"
+	Differences []string `json:"differences"`
+	// States are in the order of EnvironmentComparison.Environments; a state is nil if the feature does not exist in
+	// the project of the environment
+	States []*FeatureStateSummary `json:"states"`
+}
+
+type FeatureStateSummary struct {
+	Enabled bool        `json:"enabled"`
+	Value   interface{} `json:"value"`
+	// SegmentOverrides are ordered by priority
+	SegmentOverrides []SegmentOverrideSummary `json:"segment_overrides"`
+}
+
+type SegmentOverrideSummary struct {
+	Segment  string      `json:"segment"`
+	Priority int64       `json:"priority"`
+	Enabled  bool        `json:"enabled"`
+	Value    interface{} `json:"value"`
+}
+
+// CompareEnvironments compares the feature states and segment overrides of two or more environments. Features and
+// segments are matched by name, so environments of different projects can be compared too.
+func (c *Client) CompareEnvironments(environmentKeys ...string) (*EnvironmentComparison, error) {
+	c, op := c.startOperation("CompareEnvironments", "environment", "compare",
+		attribute.StringSlice("flagsmith.environment.keys", environmentKeys))
+	defer op.end()
+
+	if len(environmentKeys) < 2 {
+		return nil, fmt.Errorf("flagsmithapi: at least two environments are required for a comparison")
+	}
+	comparison := &EnvironmentComparison{Environments: []ComparedEnvironment{}, Features: []FeatureComparison{}}
+	summaries := []map[string]*FeatureStateSummary{}
+	featureNames := map[string]bool{}
+	for _, environmentKey := range environmentKeys {
+		environment, err := c.GetEnvironment(environmentKey)
+		if err != nil {
+			return nil, err
+		}
+		comparison.Environments = append(comparison.Environments, ComparedEnvironment{
+			Name: environment.Name, APIKey: environment.APIKey,
+		})
+		summary, err := c.summariseEnvironment(environment)
+		if err != nil {
+			return nil, err
+		}
+		for name := range summary {
+			featureNames[name] = true
+		}
+		summaries = append(summaries, summary)
+	}
+
+	names := make([]string, 0, len(featureNames))
+	for name := range featureNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		feature := FeatureComparison{Feature: name, States: []*FeatureStateSummary{}}
+		for _, summary := range summaries {
+			feature.States = append(feature.States, summary[name])
+		}
+		feature.Differences = compareStates(feature.States)
+		if len(feature.Differences) > 0 {
+			comparison.Features = append(comparison.Features, feature)
+		}
+	}
+	return comparison, nil
+}
+
+// summariseEnvironment returns the state of every feature of the environment by feature name
+func (c *Client) summariseEnvironment(environment *Environment) (map[string]*FeatureStateSummary, error) {
+	features, err := c.ListFeatures(environment.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	segments, err := c.ListSegments(environment.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	segmentNames := map[int64]string{}
+	for _, segment := range segments {
+		segmentNames[*segment.ID] = segment.Name
+	}
+	featureStates, err := c.environmentFeatureStatesByFeature(environment.APIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := map[string]*FeatureStateSummary{}
+	for _, feature := range features {
+		featureState, ok := featureStates[*feature.ID]
+		if !ok {
+			continue
+		}
+		summary := &FeatureStateSummary{
+			Enabled:          featureState.Enabled,
+			Value:            featureState.FeatureStateValue.value(),
+			SegmentOverrides: []SegmentOverrideSummary{},
+		}
+		overrides, err := c.ListSegmentOverrides(environment.APIKey, *feature.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, override := range overrides {
+			summary.SegmentOverrides = append(summary.SegmentOverrides, SegmentOverrideSummary{
+				Segment:  segmentNames[*override.Segment],
+				Priority: *override.SegmentPriority,
+				Enabled:  override.Enabled,
+				Value:    override.FeatureStateValue.value(),
+			})
+		}
+		summaries[feature.Name] = summary
+	}
+	return summaries, nil
+}
+
+// compareStates lists the differences between the states of a feature; the states of the environments that have the
+// feature are compared with each other even when it is missing from some of them
+func compareStates(states []*FeatureStateSummary) []string {
+	differences := []string{}
+	present := []*FeatureStateSummary{}
+	for _, state := range states {
+		if state != nil {
+			present = append(present, state)
+		}
+	}
+	if len(present) < len(states) {
+		differences = append(differences, "missing")
+	}
+	if len(present) < 2 {
+		return differences
+	}
+	first := present[0]
+	differs := map[string]bool{}
+	for _, state := range present[1:] {
+		differs["enabled"] = differs["enabled"] || state.Enabled != first.Enabled
+		differs["value"] = differs["value"] || state.Value != first.Value
+		sameOverrides, sameOrder := compareSegmentOverrides(first.SegmentOverrides, state.SegmentOverrides)
+		differs["segment_overrides"] = differs["segment_overrides"] || !sameOverrides
+		differs["segment_priority"] = differs["segment_priority"] || (sameOverrides && !sameOrder)
+	}
+	for _, field := range []string{"enabled", "value", "segment_overrides", "segment_priority"} {
+		if differs[field] {
+			differences = append(differences, field)
+		}
+	}
+	return differences
+}
+
+// compareSegmentOverrides reports whether both lists override the same segments with the same states and whether the
+// overrides are in the same order
+func compareSegmentOverrides(a, b []SegmentOverrideSummary) (sameOverrides bool, sameOrder bool) {
+	if len(a) != len(b) {
+		return false, false
+	}
+	bySegment := map[string]SegmentOverrideSummary{}
+	for _, override := range b {
+		bySegment[override.Segment] = override
+	}
+	sameOrder = true
+	for i, override := range a {
+		other, ok := bySegment[override.Segment]
+		if !ok || other.Enabled != override.Enabled || other.Value != override.Value {
+			return false, false
+		}
+		sameOrder = sameOrder && b[i].Segment == override.Segment
+	}
+	return true, sameOrder
+}
+
+// WriteJSON writes the comparison as indented JSON
+func (c *EnvironmentComparison) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(c)
+}
+
+// WriteText writes the comparison as plain text, with a line per environment under each feature that differs
+func (c *EnvironmentComparison) WriteText(w io.Writer) error {
+	if len(c.Features) == 0 {
+		_, err := fmt.Fprintf(w, "No differences between %s.\n", c.environmentNames())
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, feature := range c.Features {
+		fmt.Fprintf(tw, "%s (%s)\n", feature.Feature, strings.Join(feature.Differences, ", "))
+		for i, state := range feature.States {
+			fmt.Fprintf(tw, "  %s:\t%s\n", c.Environments[i].Name, state.describe(", "))
+		}
+	}
+	return tw.Flush()
+}
+
+// WriteMarkdown writes the comparison as a Markdown table, for posting to chat or pull request comments
+func (c *EnvironmentComparison) WriteMarkdown(w io.Writer) error {
+	if len(c.Features) == 0 {
+		_, err := fmt.Fprintf(w, "No differences between %s.\n", c.environmentNames())
+		return err
+	}
+	header := []string{"Feature"}
+	separator := []string{"---"}
+	for _, environment := range c.Environments {
+		header = append(header, markdownEscape(environment.Name))
+		separator = append(separator, "---")
+	}
+	fmt.Fprintf(w, "| %s |\n| %s |\n", strings.Join(header, " | "), strings.Join(separator, " | "))
+	for _, feature := range c.Features {
+		row := []string{fmt.Sprintf("`%s`", feature.Feature)}
+		for _, state := range feature.States {
+			row = append(row, markdownEscape(state.describe("<br>")))
+		}
+		if _, err := fmt.Fprintf(w, "| %s |\n", strings.Join(row, " | ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *EnvironmentComparison) environmentNames() string {
+	names := []string{}
+	for _, environment := range c.Environments {
+		names = append(names, environment.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// describe summarises the state in a single line, with the segment overrides separated by separator
+func (s *FeatureStateSummary) describe(separator string) string {
+	if s == nil {
+		return "missing"
+	}
+	parts := []string{describeState(s.Enabled, s.Value)}
+	for _, override := range s.SegmentOverrides {
+		parts = append(parts, fmt.Sprintf("%s: %s", override.Segment, describeState(override.Enabled, override.Value)))
+	}
+	return strings.Join(parts, separator)
+}
+
+func describeState(enabled bool, value interface{}) string {
+	state := "off"
+	if enabled {
+		state = "on"
+	}
+	if value == nil {
+		return state
+	}
+	if s, ok := value.(string); ok {
+		return fmt.Sprintf("%s %q", state, s)
+	}
+	return fmt.Sprintf("%s %v", state, value)
+}
+
+func markdownEscape(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
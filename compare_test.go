@@ -0,0 +1,193 @@
+package flagsmithapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+func TestCompareEnvironments(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	staging := flagsmithapi.Environment{Name: "Staging", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&staging))
+	development := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&development))
+	production := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&production))
+	checkout := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&checkout))
+	beta := flagsmithapi.Segment{Name: "beta", ProjectID: &project.ID, Rules: []flagsmithapi.Rule{flagsmithapi.All()}}
+	require.NoError(t, client.CreateSegment(&beta))
+	internalUsers := flagsmithapi.Segment{
+		Name: "internal_users", ProjectID: &project.ID, Rules: []flagsmithapi.Rule{flagsmithapi.All()},
+	}
+	require.NoError(t, client.CreateSegment(&internalUsers))
+
+	for _, state := range []struct {
+		environmentKey string
+		enabled        bool
+		value          string
+	}{{staging.APIKey, true, "c"}, {development.APIKey, true, "c"}, {production.APIKey, false, "a"}} {
+		featureState, err := client.GetEnvironmentFeatureState(state.environmentKey, *checkout.ID)
+		require.NoError(t, err)
+		value := state.value
+		featureState.Enabled = state.enabled
+		featureState.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value}
+		require.NoError(t, client.UpdateFeatureState(featureState, false))
+	}
+	for _, override := range []struct {
+		environmentKey string
+		segmentID      int64
+		priority       int64
+		enabled        bool
+	}{
+		{staging.APIKey, *beta.ID, 0, true},
+		{staging.APIKey, *internalUsers.ID, 1, false},
+		{development.APIKey, *internalUsers.ID, 0, false},
+		{development.APIKey, *beta.ID, 1, true},
+	} {
+		featureState := flagsmithapi.FeatureState{
+			Feature:         *checkout.ID,
+			Enabled:         override.enabled,
+			EnvironmentKey:  override.environmentKey,
+			Segment:         &override.segmentID,
+			SegmentPriority: &override.priority,
+		}
+		require.NoError(t, client.CreateSegmentOverride(&featureState))
+	}
+
+	// When
+	comparison, err := client.CompareEnvironments(staging.APIKey, development.APIKey)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, comparison.Features, 1)
+	assert.Equal(t, "checkout_v2", comparison.Features[0].Feature)
+	assert.Equal(t, []string{"segment_priority"}, comparison.Features[0].Differences)
+
+	// When
+	comparison, err = client.CompareEnvironments(staging.APIKey, development.APIKey, production.APIKey)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, comparison.Features, 1)
+	feature := comparison.Features[0]
+	assert.Equal(t, []string{"enabled", "value", "segment_overrides", "segment_priority"}, feature.Differences)
+	require.Len(t, feature.States, 3)
+	assert.Equal(t, &flagsmithapi.FeatureStateSummary{
+		Enabled: true,
+		Value:   "c",
+		SegmentOverrides: []flagsmithapi.SegmentOverrideSummary{
+			{Segment: "beta", Priority: 0, Enabled: true},
+			{Segment: "internal_users", Priority: 1, Enabled: false},
+		},
+	}, feature.States[0])
+	assert.Equal(t, "a", feature.States[2].Value)
+	assert.Empty(t, feature.States[2].SegmentOverrides)
+
+	text := bytes.Buffer{}
+	require.NoError(t, comparison.WriteText(&text))
+	assert.Equal(t, `checkout_v2 (enabled, value, segment_overrides, segment_priority)
+  Staging:      on "c", beta: on, internal_users: off
+  Development:  on "c", internal_users: off, beta: on
+  Production:   off "a"
+`, text.String())
+
+	markdown := bytes.Buffer{}
+	require.NoError(t, comparison.WriteMarkdown(&markdown))
+	assert.Equal(t, "| Feature | Staging | Development | Production |\n"+
+		"| --- | --- | --- | --- |\n"+
+		"| `checkout_v2` | on \"c\"<br>beta: on<br>internal_users: off "+
+		"| on \"c\"<br>internal_users: off<br>beta: on | off \"a\" |\n", markdown.String())
+
+	encoded := bytes.Buffer{}
+	require.NoError(t, comparison.WriteJSON(&encoded))
+	decoded := flagsmithapi.EnvironmentComparison{}
+	require.NoError(t, json.Unmarshal(encoded.Bytes(), &decoded))
+	assert.Equal(t, comparison.Environments, decoded.Environments)
+	assert.Equal(t, feature.Differences, decoded.Features[0].Differences)
+}
+
+func TestCompareEnvironmentsWithoutDifferences(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	staging := flagsmithapi.Environment{Name: "Staging", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&staging))
+	production := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&production))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&feature))
+
+	// When
+	comparison, err := client.CompareEnvironments(staging.APIKey, production.APIKey)
+
+	// Then
+	require.NoError(t, err)
+	assert.Empty(t, comparison.Features)
+	text := bytes.Buffer{}
+	require.NoError(t, comparison.WriteText(&text))
+	assert.Equal(t, "No differences between Staging, Production.\n", text.String())
+}
+
+func TestCompareEnvironmentsComparesStatesOfFeaturesMissingSomewhere(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	staging := flagsmithapi.Environment{Name: "Staging", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&staging))
+	production := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&production))
+	checkout := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&checkout))
+	banner := flagsmithapi.Feature{Name: "banner", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&banner))
+	featureState, err := client.GetEnvironmentFeatureState(staging.APIKey, *checkout.ID)
+	require.NoError(t, err)
+	featureState.Enabled = true
+	require.NoError(t, client.UpdateFeatureState(featureState, false))
+	other := flagsmithapi.Project{Name: "project-2", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&other))
+	otherEnvironment := flagsmithapi.Environment{Name: "Other", ProjectID: other.ID}
+	require.NoError(t, client.CreateEnvironment(&otherEnvironment))
+
+	// When
+	comparison, err := client.CompareEnvironments(staging.APIKey, production.APIKey, otherEnvironment.APIKey)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, comparison.Features, 2)
+	assert.Equal(t, "banner", comparison.Features[0].Feature)
+	assert.Equal(t, []string{"missing"}, comparison.Features[0].Differences)
+	assert.Equal(t, "checkout_v2", comparison.Features[1].Feature)
+	assert.Equal(t, []string{"missing", "enabled"}, comparison.Features[1].Differences)
+	assert.Nil(t, comparison.Features[1].States[2])
+}
+
+func TestCompareEnvironmentsRequiresTwoEnvironments(t *testing.T) {
+	// Given
+	client := flagsmithapi.NewClient(MasterAPIKey, "http://localhost")
+
+	// When
+	_, err := client.CompareEnvironments(EnvironmentAPIKey)
+
+	// Then
+	assert.EqualError(t, err, "flagsmithapi: at least two environments are required for a comparison")
+}
@@ -0,0 +1,296 @@
+package config_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/config"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+const specTemplate = `
+project: %s
+tags:
+  - name: backend
+    colour: "#3d4db6"
+segments:
+  - name: pro_users
+    rules:
+      - type: ALL
+        rules:
+          - type: ANY
+            conditions:
+              - property: plan
+                operator: EQUAL
+                value: pro
+features:
+  - name: checkout_v2
+    description: New checkout flow
+    initial_value: "10"
+    tags: [backend]
+environments:
+  - name: Development
+    features:
+      checkout_v2:
+        enabled: true
+        value: 20
+        segment_overrides:
+          - segment: pro_users
+            enabled: true
+            value: "pro"
+  - name: Production
+    features:
+      checkout_v2:
+        enabled: false
+`
+
+func setupProject(t *testing.T, server *flagsmithtest.Server) (*flagsmithapi.Client, *flagsmithapi.Project, *config.Spec) {
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&environment))
+	spec, err := config.Parse([]byte(fmt.Sprintf(specTemplate, project.UUID)))
+	require.NoError(t, err)
+	return client, &project, spec
+}
+
+func applySpec(t *testing.T, client *flagsmithapi.Client, spec *config.Spec, opts config.PlanOptions) {
+	plan, err := config.NewPlan(client, spec, opts)
+	require.NoError(t, err)
+	require.NoError(t, plan.Apply())
+}
+
+func environmentByName(t *testing.T, client *flagsmithapi.Client, projectID int64, name string) *flagsmithapi.Environment {
+	environments, err := client.ListEnvironments(projectID)
+	require.NoError(t, err)
+	for _, environment := range environments {
+		if environment.Name == name {
+			return &environment
+		}
+	}
+	t.Fatalf("environment %s not found", name)
+	return nil
+}
+
+func TestLoad(t *testing.T) {
+	// Given
+	path := filepath.Join(t.TempDir(), "flagsmith.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf(specTemplate, "project-uuid")), 0o644))
+
+	// When
+	spec, err := config.Load(path)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "project-uuid", spec.Project)
+	assert.Equal(t, []string{"backend"}, spec.Features[0].Tags)
+	assert.Equal(t, flagsmithapi.All(flagsmithapi.Any(flagsmithapi.Eq("plan", "pro"))), spec.Segments[0].Rules[0])
+	assert.Equal(t, 20, spec.Environments[0].Features["checkout_v2"].Value)
+	assert.Equal(t, "pro", spec.Environments[0].Features["checkout_v2"].SegmentOverrides[0].Value)
+}
+
+func TestParseRejectsInvalidSpecs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		spec          string
+		expectedError string
+	}{
+		{"missing project", "features: []", "config: project is required"},
+		{"unknown field", "project: p\nflags: []", "field flags not found"},
+		{"duplicate feature", "project: p\nfeatures: [{name: a}, {name: a}]", "config: duplicate feature 'a'"},
+		{"undeclared tag", "project: p\nfeatures: [{name: a, tags: [missing]}]", "undeclared tag 'missing'"},
+		{"undeclared feature", "project: p\nenvironments: [{name: Production, features: {a: {enabled: true}}}]", "undeclared feature 'a'"},
+		{
+			"undeclared segment",
+			"project: p\nfeatures: [{name: a}]\nenvironments: [{name: Production, features: {a: {segment_overrides: [{segment: s}]}}}]",
+			"undeclared segment 's'",
+		},
+		{"unsupported value", "project: p\nfeatures: [{name: a}]\nenvironments: [{name: Production, features: {a: {value: 1.5}}}]", "unsupported value 1.5"},
+		{"invalid rule", "project: p\nsegments: [{name: s, rules: [{type: SOME}]}]", "invalid segment rule"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// When
+			_, err := config.Parse([]byte(tc.spec))
+
+			// Then
+			assert.ErrorContains(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestPlanAndApply(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client, project, spec := setupProject(t, server)
+
+	// When
+	plan, err := config.NewPlan(client, spec, config.PlanOptions{})
+	require.NoError(t, err)
+
+	// Then
+	changes := []string{}
+	for _, change := range plan.Changes {
+		changes = append(changes, fmt.Sprintf("%s %s %s", change.Action, change.Resource, change.Name))
+	}
+	assert.Equal(t, []string{
+		"create tag backend",
+		"create segment pro_users",
+		"create feature checkout_v2",
+		"create environment Production",
+		"update feature_state Development/checkout_v2",
+		"create segment_override Development/checkout_v2/pro_users",
+	}, changes)
+	assert.Contains(t, plan.String(), "~ feature_state \"Development/checkout_v2\"\n    enabled: false => true\n    value: 10 => 20\n")
+	assert.Contains(t, plan.String(), "Plan: 5 to create, 1 to update, 0 to delete.")
+
+	// When
+	require.NoError(t, plan.Apply())
+
+	// Then
+	development := environmentByName(t, client, project.ID, "Development")
+	production := environmentByName(t, client, project.ID, "Production")
+	features, err := client.ListFeatures(project.ID)
+	require.NoError(t, err)
+	require.Len(t, features, 1)
+	assert.Equal(t, "New checkout flow", *features[0].Description)
+	assert.Len(t, features[0].Tags, 1)
+
+	featureState, err := client.GetEnvironmentFeatureState(development.APIKey, *features[0].ID)
+	require.NoError(t, err)
+	assert.True(t, featureState.Enabled)
+	assert.Equal(t, int64(20), *featureState.FeatureStateValue.IntegerValue)
+
+	overrides, err := client.ListSegmentOverrides(development.APIKey, *features[0].ID)
+	require.NoError(t, err)
+	require.Len(t, overrides, 1)
+	assert.True(t, overrides[0].Enabled)
+	assert.Equal(t, "pro", *overrides[0].FeatureStateValue.StringValue)
+
+	featureState, err = client.GetEnvironmentFeatureState(production.APIKey, *features[0].ID)
+	require.NoError(t, err)
+	assert.False(t, featureState.Enabled)
+
+	// When
+	plan, err = config.NewPlan(client, spec, config.PlanOptions{})
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+	assert.Equal(t, "No changes.\n", plan.String())
+}
+
+func TestPlanDetectsDrift(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client, project, spec := setupProject(t, server)
+	applySpec(t, client, spec, config.PlanOptions{})
+
+	development := environmentByName(t, client, project.ID, "Development")
+	features, err := client.ListFeatures(project.ID)
+	require.NoError(t, err)
+	overrides, err := client.ListSegmentOverrides(development.APIKey, *features[0].ID)
+	require.NoError(t, err)
+	overrides[0].Enabled = false
+	require.NoError(t, client.UpdateFeatureState(&overrides[0], false))
+	spec.Segments[0].Description = "Paying users"
+
+	// When
+	plan, err := config.NewPlan(client, spec, config.PlanOptions{})
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, plan.Changes, 2)
+	assert.Equal(t, config.ResourceSegment, plan.Changes[0].Resource)
+	assert.Equal(t, []config.FieldDiff{{Field: "description", Old: `""`, New: `"Paying users"`}}, plan.Changes[0].Diffs)
+	assert.Equal(t, config.ResourceSegmentOverride, plan.Changes[1].Resource)
+	assert.Equal(t, []config.FieldDiff{{Field: "enabled", Old: "false", New: "true"}}, plan.Changes[1].Diffs)
+
+	// When
+	require.NoError(t, plan.Apply())
+	plan, err = config.NewPlan(client, spec, config.PlanOptions{})
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+}
+
+func TestPrune(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client, project, spec := setupProject(t, server)
+	applySpec(t, client, spec, config.PlanOptions{})
+	legacy := flagsmithapi.Feature{Name: "legacy", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&legacy))
+	spec.Tags = nil
+	spec.Features[0].Tags = nil
+	spec.Environments[0].Features["checkout_v2"] = config.FeatureState{}
+
+	// When
+	plan, err := config.NewPlan(client, spec, config.PlanOptions{})
+	require.NoError(t, err)
+
+	// Then
+	for _, change := range plan.Changes {
+		assert.NotEqual(t, config.ActionDelete, change.Action)
+	}
+
+	// When
+	applySpec(t, client, spec, config.PlanOptions{Prune: true})
+
+	// Then
+	features, err := client.ListFeatures(project.ID)
+	require.NoError(t, err)
+	require.Len(t, features, 1)
+	assert.Equal(t, "checkout_v2", features[0].Name)
+	tags, err := client.ListTags(project.ID)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+	development := environmentByName(t, client, project.ID, "Development")
+	overrides, err := client.ListSegmentOverrides(development.APIKey, *features[0].ID)
+	require.NoError(t, err)
+	assert.Empty(t, overrides)
+
+	plan, err = config.NewPlan(client, spec, config.PlanOptions{Prune: true})
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+}
+
+// overrideWithoutSegmentAPI adds a segment override without a segment to the live overrides, which the API may return
+type overrideWithoutSegmentAPI struct {
+	flagsmithapi.API
+}
+
+func (a overrideWithoutSegmentAPI) ListSegmentOverrides(environmentKey string,
+	featureID int64) ([]flagsmithapi.FeatureState, error) {
+	overrides, err := a.API.ListSegmentOverrides(environmentKey, featureID)
+	if err != nil {
+		return nil, err
+	}
+	return append(overrides, flagsmithapi.FeatureState{Feature: featureID, Enabled: true, EnvironmentKey: environmentKey}), nil
+}
+
+func TestPlanSkipsSegmentOverridesWithoutSegment(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client, _, spec := setupProject(t, server)
+	applySpec(t, client, spec, config.PlanOptions{})
+
+	// When
+	plan, err := config.NewPlan(overrideWithoutSegmentAPI{API: client}, spec, config.PlanOptions{Prune: true})
+
+	// Then
+	require.NoError(t, err)
+	assert.True(t, plan.Empty(), plan.String())
+}
@@ -0,0 +1,649 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+type Resource string
+
+const (
+	ResourceTag             Resource = "tag"
+	ResourceSegment         Resource = "segment"
+	ResourceFeature         Resource = "feature"
+	ResourceEnvironment     Resource = "environment"
+	ResourceFeatureState    Resource = "feature_state"
+	ResourceSegmentOverride Resource = "segment_override"
+)
+
+// FieldDiff is a changed field, with the values formatted for display
+type FieldDiff struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// Change is a single operation of a plan. Name identifies the resource, i.e: `Production/checkout_v2/pro_users` for
+// a segment override.
+type Change struct {
+	Action   Action
+	Resource Resource
+	Name     string
+	Diffs    []FieldDiff
+
+	apply func() error
+}
+
+type PlanOptions struct {
+	// Prune deletes the tags, segments, features and segment overrides that are not in the spec. Environments are
+	// never deleted.
+	Prune bool
+}
+
+// Plan is the ordered list of changes required to bring a project in line with a spec
+type Plan struct {
+	Changes []Change
+
+	state *state
+}
+
+// state resolves names to live resources; it is populated from the live project when planning and updated as
+// resources are created while applying
+type state struct {
+	api          flagsmithapi.API
+	project      *flagsmithapi.Project
+	tags         map[string]*flagsmithapi.Tag
+	segments     map[string]*flagsmithapi.Segment
+	features     map[string]*flagsmithapi.Feature
+	environments map[string]*flagsmithapi.Environment
+}
+
+// NewPlan compares the spec with the live state of its project and returns the changes required to apply it
+func NewPlan(api flagsmithapi.API, spec *Spec, opts PlanOptions) (*Plan, error) {
+	project, err := api.GetProject(spec.Project)
+	if err != nil {
+		return nil, err
+	}
+	st := &state{
+		api:          api,
+		project:      project,
+		tags:         map[string]*flagsmithapi.Tag{},
+		segments:     map[string]*flagsmithapi.Segment{},
+		features:     map[string]*flagsmithapi.Feature{},
+		environments: map[string]*flagsmithapi.Environment{},
+	}
+	tags, err := api.ListTags(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range tags {
+		st.tags[tags[i].Name] = &tags[i]
+	}
+	segments, err := api.ListSegments(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range segments {
+		st.segments[segments[i].Name] = &segments[i]
+	}
+	features, err := api.ListFeatures(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range features {
+		st.features[features[i].Name] = &features[i]
+	}
+	environments, err := api.ListEnvironments(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range environments {
+		st.environments[environments[i].Name] = &environments[i]
+	}
+
+	p := &Plan{state: st}
+	p.planTags(spec)
+	p.planSegments(spec)
+	p.planFeatures(spec)
+	p.planEnvironments(spec)
+	for _, environment := range spec.Environments {
+		if err := p.planFeatureStates(spec, environment, opts); err != nil {
+			return nil, err
+		}
+	}
+	if opts.Prune {
+		p.planPrune(spec)
+	}
+	return p, nil
+}
+
+// Empty reports whether the live state already matches the spec
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Apply applies the changes in order and stops at the first error. A plan must only be applied once; compute a new
+// plan to apply the spec again.
+func (p *Plan) Apply() error {
+	for _, change := range p.Changes {
+		if err := change.apply(); err != nil {
+			return fmt.Errorf("config: Error applying %s of %s '%s': %w", change.Action, change.Resource, change.Name, err)
+		}
+	}
+	return nil
+}
+
+// String renders the plan as a diff
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "No changes.\n"
+	}
+	counts := map[Action]int{}
+	b := strings.Builder{}
+	for _, change := range p.Changes {
+		counts[change.Action]++
+		symbol := map[Action]string{ActionCreate: "+", ActionUpdate: "~", ActionDelete: "-"}[change.Action]
+		fmt.Fprintf(&b, "%s %s %s\n", symbol, change.Resource, strconv.Quote(change.Name))
+		for _, diff := range change.Diffs {
+			if change.Action == ActionCreate {
+				fmt.Fprintf(&b, "    %s: %s\n", diff.Field, diff.New)
+			} else {
+				fmt.Fprintf(&b, "    %s: %s => %s\n", diff.Field, diff.Old, diff.New)
+			}
+		}
+	}
+	fmt.Fprintf(&b, "\nPlan: %d to create, %d to update, %d to delete.\n",
+		counts[ActionCreate], counts[ActionUpdate], counts[ActionDelete])
+	return b.String()
+}
+
+func (p *Plan) add(action Action, resource Resource, name string, diffs []FieldDiff, apply func() error) {
+	p.Changes = append(p.Changes, Change{Action: action, Resource: resource, Name: name, Diffs: diffs, apply: apply})
+}
+
+func (p *Plan) planTags(spec *Spec) {
+	st := p.state
+	for _, tag := range spec.Tags {
+		tag := tag
+		live, ok := st.tags[tag.Name]
+		if !ok {
+			p.add(ActionCreate, ResourceTag, tag.Name, nil, func() error {
+				created := flagsmithapi.Tag{Name: tag.Name, Colour: tag.Colour, Description: &tag.Description, ProjectID: &st.project.ID}
+				if err := st.api.CreateTag(&created); err != nil {
+					return err
+				}
+				st.tags[tag.Name] = &created
+				return nil
+			})
+			continue
+		}
+		diffs := []FieldDiff{}
+		diffs = diffString(diffs, "colour", live.Colour, tag.Colour)
+		diffs = diffString(diffs, "description", stringValue(live.Description), tag.Description)
+		if len(diffs) == 0 {
+			continue
+		}
+		p.add(ActionUpdate, ResourceTag, tag.Name, diffs, func() error {
+			updated := *live
+			updated.Colour = tag.Colour
+			updated.Description = &tag.Description
+			updated.ProjectID = &st.project.ID
+			return st.api.UpdateTag(&updated)
+		})
+	}
+}
+
+func (p *Plan) planSegments(spec *Spec) {
+	st := p.state
+	for _, segment := range spec.Segments {
+		segment := segment
+		live, ok := st.segments[segment.Name]
+		if !ok {
+			p.add(ActionCreate, ResourceSegment, segment.Name, nil, func() error {
+				created := flagsmithapi.Segment{Name: segment.Name, Description: &segment.Description, ProjectID: &st.project.ID, Rules: segment.Rules}
+				if err := st.api.CreateSegment(&created); err != nil {
+					return err
+				}
+				st.segments[segment.Name] = &created
+				return nil
+			})
+			continue
+		}
+		diffs := []FieldDiff{}
+		diffs = diffString(diffs, "description", stringValue(live.Description), segment.Description)
+		if oldRules, newRules := formatRules(live.Rules), formatRules(segment.Rules); oldRules != newRules {
+			diffs = append(diffs, FieldDiff{Field: "rules", Old: oldRules, New: newRules})
+		}
+		if len(diffs) == 0 {
+			continue
+		}
+		p.add(ActionUpdate, ResourceSegment, segment.Name, diffs, func() error {
+			updated := *live
+			updated.Description = &segment.Description
+			updated.Rules = segment.Rules
+			return st.api.UpdateSegment(&updated)
+		})
+	}
+}
+
+func (p *Plan) planFeatures(spec *Spec) {
+	st := p.state
+	for _, feature := range spec.Features {
+		feature := feature
+		live, ok := st.features[feature.Name]
+		if !ok {
+			p.add(ActionCreate, ResourceFeature, feature.Name, nil, func() error {
+				created := flagsmithapi.Feature{
+					Name:           feature.Name,
+					Description:    &feature.Description,
+					InitialValue:   feature.InitialValue,
+					DefaultEnabled: feature.DefaultEnabled,
+					IsArchived:     feature.Archived,
+					Tags:           st.tagIDs(feature.Tags),
+					ProjectID:      &st.project.ID,
+				}
+				if err := st.api.CreateFeature(&created); err != nil {
+					return err
+				}
+				st.features[feature.Name] = &created
+				return nil
+			})
+			continue
+		}
+		diffs := []FieldDiff{}
+		diffs = diffString(diffs, "description", stringValue(live.Description), feature.Description)
+		if live.IsArchived != feature.Archived {
+			diffs = append(diffs, FieldDiff{Field: "archived", Old: strconv.FormatBool(live.IsArchived), New: strconv.FormatBool(feature.Archived)})
+		}
+		if oldTags, newTags := formatNames(st.tagNames(live.Tags)), formatNames(feature.Tags); oldTags != newTags {
+			diffs = append(diffs, FieldDiff{Field: "tags", Old: oldTags, New: newTags})
+		}
+		if len(diffs) == 0 {
+			continue
+		}
+		p.add(ActionUpdate, ResourceFeature, feature.Name, diffs, func() error {
+			updated := *live
+			updated.Description = &feature.Description
+			updated.IsArchived = feature.Archived
+			updated.Tags = st.tagIDs(feature.Tags)
+			return st.api.UpdateFeature(&updated)
+		})
+	}
+}
+
+func (p *Plan) planEnvironments(spec *Spec) {
+	st := p.state
+	for _, environment := range spec.Environments {
+		environment := environment
+		live, ok := st.environments[environment.Name]
+		if !ok {
+			p.add(ActionCreate, ResourceEnvironment, environment.Name, nil, func() error {
+				created := flagsmithapi.Environment{Name: environment.Name, Description: environment.Description, ProjectID: st.project.ID}
+				if err := st.api.CreateEnvironment(&created); err != nil {
+					return err
+				}
+				st.environments[environment.Name] = &created
+				return nil
+			})
+			continue
+		}
+		diffs := diffString([]FieldDiff{}, "description", live.Description, environment.Description)
+		if len(diffs) == 0 {
+			continue
+		}
+		p.add(ActionUpdate, ResourceEnvironment, environment.Name, diffs, func() error {
+			updated := *live
+			updated.Description = environment.Description
+			return st.api.UpdateEnvironment(&updated)
+		})
+	}
+}
+
+// planFeatureStates plans the environment default feature states and segment overrides of the features listed in
+// the environment. Resources that do not exist yet are compared with the defaults they will be created with.
+func (p *Plan) planFeatureStates(spec *Spec, environment Environment, opts PlanOptions) error {
+	st := p.state
+	liveEnvironment := st.environments[environment.Name]
+	liveStates := map[int64]flagsmithapi.FeatureState{}
+	if liveEnvironment != nil {
+		featureStates, err := st.api.ListEnvironmentFeatureStates(liveEnvironment.APIKey)
+		if err != nil {
+			return err
+		}
+		for _, featureState := range featureStates {
+			liveStates[featureState.Feature] = featureState
+		}
+	}
+
+	featureNames := make([]string, 0, len(environment.Features))
+	for featureName := range environment.Features {
+		featureNames = append(featureNames, featureName)
+	}
+	sort.Strings(featureNames)
+
+	for _, featureName := range featureNames {
+		desired := environment.Features[featureName]
+		name := fmt.Sprintf("%s/%s", environment.Name, featureName)
+
+		var liveEnabled bool
+		var liveValue *flagsmithapi.FeatureStateValue
+		liveOverrides := []flagsmithapi.FeatureState{}
+		liveFeature := st.features[featureName]
+		switch {
+		case liveFeature == nil:
+			specFeature := spec.feature(featureName)
+			liveEnabled, liveValue = specFeature.DefaultEnabled, inferValue(specFeature.InitialValue)
+		case liveEnvironment == nil:
+			liveEnabled, liveValue = liveFeature.DefaultEnabled, inferValue(liveFeature.InitialValue)
+		default:
+			featureState, ok := liveStates[*liveFeature.ID]
+			if !ok {
+				return fmt.Errorf("config: feature state of '%s' not found", name)
+			}
+			liveEnabled, liveValue = featureState.Enabled, featureState.FeatureStateValue
+			overrides, err := st.api.ListSegmentOverrides(liveEnvironment.APIKey, *liveFeature.ID)
+			if err != nil {
+				return err
+			}
+			liveOverrides = overrides
+		}
+
+		diffs := []FieldDiff{}
+		if desired.Enabled != nil && *desired.Enabled != liveEnabled {
+			diffs = append(diffs, FieldDiff{Field: "enabled", Old: strconv.FormatBool(liveEnabled), New: strconv.FormatBool(*desired.Enabled)})
+		}
+		if desired.Value != nil {
+			diffs = diffFormatted(diffs, "value", formatValue(liveValue), formatValue(featureStateValue(desired.Value)))
+		}
+		if len(diffs) > 0 {
+			p.add(ActionUpdate, ResourceFeatureState, name, diffs, st.updateFeatureState(environment.Name, featureName, desired))
+		}
+
+		p.planSegmentOverrides(name, environment.Name, featureName, desired.SegmentOverrides, liveOverrides, opts)
+	}
+	return nil
+}
+
+func (p *Plan) planSegmentOverrides(name, environmentName, featureName string, desired []SegmentOverride,
+	live []flagsmithapi.FeatureState, opts PlanOptions) {
+	st := p.state
+	liveBySegment := map[string]flagsmithapi.FeatureState{}
+	segmentNames := map[int64]string{}
+	for segmentName, segment := range st.segments {
+		segmentNames[*segment.ID] = segmentName
+	}
+	// overrides without a segment cannot be matched to the spec, so they are left as they are
+	for _, override := range live {
+		if override.Segment == nil {
+			continue
+		}
+		liveBySegment[segmentNames[*override.Segment]] = override
+	}
+
+	for i, override := range desired {
+		override := override
+		priority := int64(i)
+		overrideName := name + "/" + override.Segment
+		liveOverride, ok := liveBySegment[override.Segment]
+		if !ok {
+			diffs := []FieldDiff{
+				{Field: "enabled", New: strconv.FormatBool(override.Enabled)},
+				{Field: "priority", New: strconv.FormatInt(priority, 10)},
+			}
+			if override.Value != nil {
+				diffs = append(diffs, FieldDiff{Field: "value", New: formatValue(featureStateValue(override.Value))})
+			}
+			p.add(ActionCreate, ResourceSegmentOverride, overrideName, diffs, func() error {
+				feature := st.features[featureName]
+				segment := st.segments[override.Segment]
+				featureState := flagsmithapi.FeatureState{
+					Feature:           *feature.ID,
+					Enabled:           override.Enabled,
+					EnvironmentKey:    st.environments[environmentName].APIKey,
+					Segment:           segment.ID,
+					SegmentPriority:   &priority,
+					FeatureStateValue: featureStateValue(override.Value),
+				}
+				return st.api.CreateSegmentOverride(&featureState)
+			})
+			continue
+		}
+
+		diffs := []FieldDiff{}
+		if liveOverride.Enabled != override.Enabled {
+			diffs = append(diffs, FieldDiff{Field: "enabled", Old: strconv.FormatBool(liveOverride.Enabled), New: strconv.FormatBool(override.Enabled)})
+		}
+		if override.Value != nil {
+			diffs = diffFormatted(diffs, "value", formatValue(liveOverride.FeatureStateValue), formatValue(featureStateValue(override.Value)))
+		}
+		priorityChanged := liveOverride.SegmentPriority == nil || *liveOverride.SegmentPriority != priority
+		if priorityChanged {
+			diffs = append(diffs, FieldDiff{Field: "priority", Old: formatPriority(liveOverride.SegmentPriority), New: strconv.FormatInt(priority, 10)})
+		}
+		if len(diffs) == 0 {
+			continue
+		}
+		p.add(ActionUpdate, ResourceSegmentOverride, overrideName, diffs, func() error {
+			updated := liveOverride
+			updated.Enabled = override.Enabled
+			if override.Value != nil {
+				updated.FeatureStateValue = featureStateValue(override.Value)
+			}
+			updated.SegmentPriority = &priority
+			return st.api.UpdateFeatureState(&updated, priorityChanged)
+		})
+	}
+
+	if !opts.Prune {
+		return
+	}
+	desiredSegments := map[string]bool{}
+	for _, override := range desired {
+		desiredSegments[override.Segment] = true
+	}
+	for _, override := range live {
+		if override.Segment == nil || override.FeatureSegment == nil {
+			continue
+		}
+		segmentName := segmentNames[*override.Segment]
+		if desiredSegments[segmentName] {
+			continue
+		}
+		featureSegmentID := *override.FeatureSegment
+		p.add(ActionDelete, ResourceSegmentOverride, name+"/"+segmentName, nil, func() error {
+			return st.api.DeleteFeatureSegment(featureSegmentID)
+		})
+	}
+}
+
+// planPrune deletes the features, segments and tags that are not in the spec, after every other change
+func (p *Plan) planPrune(spec *Spec) {
+	st := p.state
+	declared := map[string]bool{}
+	for _, feature := range spec.Features {
+		declared[feature.Name] = true
+	}
+	for _, name := range sortedKeys(st.features) {
+		if declared[name] {
+			continue
+		}
+		featureID := *st.features[name].ID
+		p.add(ActionDelete, ResourceFeature, name, nil, func() error {
+			return st.api.DeleteFeature(st.project.ID, featureID)
+		})
+	}
+
+	declared = map[string]bool{}
+	for _, segment := range spec.Segments {
+		declared[segment.Name] = true
+	}
+	for _, name := range sortedKeys(st.segments) {
+		if declared[name] {
+			continue
+		}
+		segmentID := *st.segments[name].ID
+		p.add(ActionDelete, ResourceSegment, name, nil, func() error {
+			return st.api.DeleteSegment(st.project.ID, segmentID)
+		})
+	}
+
+	declared = map[string]bool{}
+	for _, tag := range spec.Tags {
+		declared[tag.Name] = true
+	}
+	for _, name := range sortedKeys(st.tags) {
+		if declared[name] {
+			continue
+		}
+		tagID := *st.tags[name].ID
+		p.add(ActionDelete, ResourceTag, name, nil, func() error {
+			return st.api.DeleteTag(st.project.ID, tagID)
+		})
+	}
+}
+
+func (st *state) updateFeatureState(environmentName, featureName string, desired FeatureState) func() error {
+	return func() error {
+		environment := st.environments[environmentName]
+		feature := st.features[featureName]
+		featureState, err := st.api.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+		if err != nil {
+			return err
+		}
+		if desired.Enabled != nil {
+			featureState.Enabled = *desired.Enabled
+		}
+		if desired.Value != nil {
+			featureState.FeatureStateValue = featureStateValue(desired.Value)
+		}
+		return st.api.UpdateFeatureState(featureState, false)
+	}
+}
+
+func (st *state) tagIDs(names []string) []int64 {
+	ids := []int64{}
+	for _, name := range names {
+		ids = append(ids, *st.tags[name].ID)
+	}
+	return ids
+}
+
+func (st *state) tagNames(ids []int64) []string {
+	names := []string{}
+	for name, tag := range st.tags {
+		for _, id := range ids {
+			if *tag.ID == id {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+func (s *Spec) feature(name string) Feature {
+	for _, feature := range s.Features {
+		if feature.Name == name {
+			return feature
+		}
+	}
+	return Feature{}
+}
+
+// featureStateValue converts a spec value(validated by Spec.Validate) to a feature state value
+func featureStateValue(value interface{}) *flagsmithapi.FeatureStateValue {
+	switch v := value.(type) {
+	case string:
+		return &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &v}
+	case int:
+		intValue := int64(v)
+		return &flagsmithapi.FeatureStateValue{Type: "int", IntegerValue: &intValue}
+	case bool:
+		return &flagsmithapi.FeatureStateValue{Type: "bool", BooleanValue: &v}
+	}
+	return nil
+}
+
+// inferValue infers the type of a feature initial value the same way the API does
+func inferValue(value string) *flagsmithapi.FeatureStateValue {
+	if intValue, err := strconv.Atoi(value); err == nil {
+		return featureStateValue(intValue)
+	}
+	if value == "true" || value == "false" {
+		return featureStateValue(value == "true")
+	}
+	return featureStateValue(value)
+}
+
+func formatValue(value *flagsmithapi.FeatureStateValue) string {
+	switch {
+	case value == nil:
+		return "null"
+	case value.IntegerValue != nil:
+		return strconv.FormatInt(*value.IntegerValue, 10)
+	case value.BooleanValue != nil:
+		return strconv.FormatBool(*value.BooleanValue)
+	}
+	return strconv.Quote(stringValue(value.StringValue))
+}
+
+func formatRules(rules []flagsmithapi.Rule) string {
+	if rules == nil {
+		rules = []flagsmithapi.Rule{}
+	}
+	data, _ := json.Marshal(rules)
+	return string(data)
+}
+
+func formatNames(names []string) string {
+	sorted := append([]string{}, names...)
+	sort.Strings(sorted)
+	return "[" + strings.Join(sorted, ", ") + "]"
+}
+
+func formatPriority(priority *int64) string {
+	if priority == nil {
+		return "null"
+	}
+	return strconv.FormatInt(*priority, 10)
+}
+
+func diffString(diffs []FieldDiff, field, oldValue, newValue string) []FieldDiff {
+	return diffFormatted(diffs, field, strconv.Quote(oldValue), strconv.Quote(newValue))
+}
+
+// diffFormatted appends a diff if the values, already formatted for display, differ
+func diffFormatted(diffs []FieldDiff, field, oldValue, newValue string) []FieldDiff {
+	if oldValue == newValue {
+		return diffs
+	}
+	return append(diffs, FieldDiff{Field: field, Old: oldValue, New: newValue})
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func sortedKeys[T any](items map[string]T) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,210 @@
+// Package config manages the tags, segments, features and per-environment feature states of a Flagsmith project
+// from a declarative YAML spec that can be kept in version control.
+//
+//	spec, err := config.Load("flagsmith.yaml")
+//	plan, err := config.NewPlan(client, spec, config.PlanOptions{})
+//	fmt.Print(plan)
+//	err = plan.Apply()
+//
+// A spec looks like:
+//
+//	project: 6f1e1c8a-5f3b-4a47-a0a5-3d1c3c6a9b1e
+//	tags:
+//	  - name: backend
+//	    colour: "#3d4db6"
+//	segments:
+//	  - name: pro_users
+//	    rules:
+//	      - type: ALL
+//	        rules:
+//	          - type: ANY
+//	            conditions:
+//	              - property: plan
+//	                operator: EQUAL
+//	                value: pro
+//	features:
+//	  - name: checkout_v2
+//	    description: New checkout flow
+//	    tags: [backend]
+//	environments:
+//	  - name: Production
+//	    features:
+//	      checkout_v2:
+//	        enabled: false
+//	        value: 10
+//	        segment_overrides:
+//	          - segment: pro_users
+//	            enabled: true
+//	            value: 20
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// Spec is the desired state of a project
+type Spec struct {
+	// Project is the UUID of the existing project the spec is applied to
+	Project      string        `yaml:"project"`
+	Tags         []Tag         `yaml:"tags"`
+	Segments     []Segment     `yaml:"segments"`
+	Features     []Feature     `yaml:"features"`
+	Environments []Environment `yaml:"environments"`
+}
+
+type Tag struct {
+	Name        string `yaml:"name"`
+	Colour      string `yaml:"colour"`
+	Description string `yaml:"description"`
+}
+
+type Segment struct {
+	Name        string              `yaml:"name"`
+	Description string              `yaml:"description"`
+	Rules       []flagsmithapi.Rule `yaml:"rules"`
+}
+
+type Feature struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// InitialValue and DefaultEnabled are only used when the feature is created
+	InitialValue   string   `yaml:"initial_value"`
+	DefaultEnabled bool     `yaml:"default_enabled"`
+	Archived       bool     `yaml:"archived"`
+	Tags           []string `yaml:"tags"`
+}
+
+// Environment is matched by name; environments missing from the project are created
+type Environment struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// Features maps feature names to their state in the environment; features that are not listed are left as is
+	Features map[string]FeatureState `yaml:"features"`
+}
+
+type FeatureState struct {
+	// Enabled and Value are left as is when omitted. Value must be a string, an integer or a boolean.
+	Enabled *bool       `yaml:"enabled"`
+	Value   interface{} `yaml:"value"`
+	// SegmentOverrides are listed in priority order, highest priority first
+	SegmentOverrides []SegmentOverride `yaml:"segment_overrides"`
+}
+
+type SegmentOverride struct {
+	Segment string      `yaml:"segment"`
+	Enabled bool        `yaml:"enabled"`
+	Value   interface{} `yaml:"value"`
+}
+
+// Load reads and validates the spec at path
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: Error reading spec: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes and validates a YAML spec; unknown fields are rejected
+func Parse(data []byte) (*Spec, error) {
+	spec := Spec{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&spec); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("config: Error parsing spec: %w", err)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
+
+// Validate checks that names are unique and that every reference to a tag, segment or feature is declared in the spec
+func (s *Spec) Validate() error {
+	if s.Project == "" {
+		return errors.New("config: project is required")
+	}
+	tags := map[string]bool{}
+	for _, tag := range s.Tags {
+		if err := addName(tags, "tag", tag.Name); err != nil {
+			return err
+		}
+	}
+	segments := map[string]bool{}
+	for _, segment := range s.Segments {
+		if err := addName(segments, "segment", segment.Name); err != nil {
+			return err
+		}
+		sdkSegment := flagsmithapi.Segment{Name: segment.Name, Rules: segment.Rules}
+		if err := sdkSegment.ValidateRules(); err != nil {
+			return fmt.Errorf("config: segment '%s': %w", segment.Name, err)
+		}
+	}
+	features := map[string]bool{}
+	for _, feature := range s.Features {
+		if err := addName(features, "feature", feature.Name); err != nil {
+			return err
+		}
+		for _, tag := range feature.Tags {
+			if !tags[tag] {
+				return fmt.Errorf("config: feature '%s' references undeclared tag '%s'", feature.Name, tag)
+			}
+		}
+	}
+	environments := map[string]bool{}
+	for _, environment := range s.Environments {
+		if err := addName(environments, "environment", environment.Name); err != nil {
+			return err
+		}
+		for featureName, state := range environment.Features {
+			where := fmt.Sprintf("%s/%s", environment.Name, featureName)
+			if !features[featureName] {
+				return fmt.Errorf("config: environment '%s' references undeclared feature '%s'", environment.Name, featureName)
+			}
+			if err := validateValue(where, state.Value); err != nil {
+				return err
+			}
+			overridden := map[string]bool{}
+			for _, override := range state.SegmentOverrides {
+				if !segments[override.Segment] {
+					return fmt.Errorf("config: '%s' overrides undeclared segment '%s'", where, override.Segment)
+				}
+				if overridden[override.Segment] {
+					return fmt.Errorf("config: '%s' overrides segment '%s' more than once", where, override.Segment)
+				}
+				overridden[override.Segment] = true
+				if err := validateValue(where+"/"+override.Segment, override.Value); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func addName(names map[string]bool, kind, name string) error {
+	if name == "" {
+		return fmt.Errorf("config: %s name is required", kind)
+	}
+	if names[name] {
+		return fmt.Errorf("config: duplicate %s '%s'", kind, name)
+	}
+	names[name] = true
+	return nil
+}
+
+func validateValue(where string, value interface{}) error {
+	switch value.(type) {
+	case nil, string, int, bool:
+		return nil
+	}
+	return fmt.Errorf("config: '%s' has unsupported value %v(must be a string, an integer or a boolean)", where, value)
+}
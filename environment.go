@@ -2,12 +2,16 @@ package flagsmithapi
 
 import (
 	"fmt"
+	"strconv"
 )
 
 func (c *Client) GetEnvironment(apiKey string) (*Environment, error) {
+	c, op := c.startOperation("GetEnvironment", "environment", "get", environmentKeyAttribute(apiKey))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/", c.baseURL, apiKey)
 	environment := Environment{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&environment).Get(url)
 
 	if err != nil {
@@ -17,13 +21,16 @@ func (c *Client) GetEnvironment(apiKey string) (*Environment, error) {
 	if !resp.IsSuccess() {
 		return nil, fmt.Errorf("flagsmithapi: Error getting environment: %s", resp)
 	}
-
+	c.ids.setEnvironment(&environment)
 	return &environment, nil
 }
 func (c *Client) GetEnvironmentByUUID(uuid string) (*Environment, error) {
+	c, op := c.startOperation("GetEnvironmentByUUID", "environment", "get", uuidAttribute("environment", uuid))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/get-by-uuid/%s/", c.baseURL, uuid)
 	environment := Environment{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&environment).Get(url)
 
 	if err != nil {
@@ -33,12 +40,16 @@ func (c *Client) GetEnvironmentByUUID(uuid string) (*Environment, error) {
 	if !resp.IsSuccess() {
 		return nil, fmt.Errorf("flagsmithapi: Error getting environment: %s", resp)
 	}
-
+	c.ids.setEnvironment(&environment)
 	return &environment, nil
 }
 func (c *Client) CreateEnvironment(environment *Environment) error {
+	c, op := c.startOperation("CreateEnvironment", "environment", "create",
+		idAttribute("project", environment.ProjectID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/", c.baseURL)
-	resp, err := c.client.R().SetBody(environment).SetResult(environment).Post(url)
+	resp, err := c.request().SetBody(environment).SetResult(environment).Post(url)
 
 	if err != nil {
 		return err
@@ -47,12 +58,41 @@ func (c *Client) CreateEnvironment(environment *Environment) error {
 	if !resp.IsSuccess() {
 		return fmt.Errorf("flagsmithapi: Error creating environment: %s", resp)
 	}
-
+	c.ids.setEnvironment(environment)
 	return nil
 }
+
+// CloneEnvironment creates a copy of the source environment, including its feature states and segment overrides,
+// and creates the given server-side keys in the new environment, setting their IDs and keys. If creating a key fails
+// the cloned environment is returned along with the error.
+func (c *Client) CloneEnvironment(sourceKey, name string, serverSideKeys ...*ServerSideEnvKey) (*Environment, error) {
+	c, op := c.startOperation("CloneEnvironment", "environment", "clone", environmentKeyAttribute(sourceKey))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/environments/%s/clone/", c.baseURL, sourceKey)
+	environment := Environment{}
+	resp, err := c.request().SetBody(map[string]string{"name": name}).SetResult(&environment).Post(url)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmithapi: Error cloning environment: %s", resp)
+	}
+	c.ids.setEnvironment(&environment)
+
+	for _, key := range serverSideKeys {
+		if err := c.CreateServerSideEnvKey(environment.APIKey, key); err != nil {
+			return &environment, err
+		}
+	}
+	return &environment, nil
+}
 func (c *Client) UpdateEnvironment(environment *Environment) error {
+	c, op := c.startOperation("UpdateEnvironment", "environment", "update", environmentKeyAttribute(environment.APIKey))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/", c.baseURL, environment.APIKey)
-	resp, err := c.client.R().SetBody(environment).SetResult(environment).Put(url)
+	resp, err := c.request().SetBody(environment).SetResult(environment).Put(url)
 
 	if err != nil {
 		return err
@@ -61,13 +101,17 @@ func (c *Client) UpdateEnvironment(environment *Environment) error {
 	if !resp.IsSuccess() {
 		return fmt.Errorf("flagsmithapi: Error creating environment: %s", resp)
 	}
+	c.ids.deleteEnvironment(environment.APIKey)
 
 	return nil
 }
 func (c *Client) DeleteEnvironment(apiKey string) error {
+	c, op := c.startOperation("DeleteEnvironment", "environment", "delete", environmentKeyAttribute(apiKey))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/", c.baseURL, apiKey)
 
-	resp, err := c.client.R().Delete(url)
+	resp, err := c.request().Delete(url)
 	if err != nil {
 		return err
 	}
@@ -75,6 +119,24 @@ func (c *Client) DeleteEnvironment(apiKey string) error {
 	if !resp.IsSuccess() {
 		return fmt.Errorf("flagsmithapi: Error deleting environment: %s", resp)
 	}
+	c.ids.deleteEnvironment(apiKey)
 
 	return nil
 }
+
+func (c *Client) ListEnvironments(projectID int64) ([]Environment, error) {
+	c, op := c.startOperation("ListEnvironments", "environment", "list", idAttribute("project", projectID))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/environments/", c.baseURL)
+	environments, err := listResults[Environment](c, url, map[string]string{
+		"project": strconv.FormatInt(projectID, 10),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range environments {
+		c.ids.setEnvironment(&environments[i])
+	}
+	return environments, nil
+}
@@ -0,0 +1,297 @@
+package flagsmithapi
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// EnvironmentDocument is the document used by the Flagsmith SDKs for local evaluation. It contains everything
+// required to evaluate the flags of an environment: the environment defaults, the project segments with their
+// overrides and the identity overrides.
+type EnvironmentDocument struct {
+	ID                int64                      `json:"id"`
+	APIKey            string                     `json:"api_key"`
+	Name              string                     `json:"name"`
+	Project           DocumentProject            `json:"project"`
+	FeatureStates     []DocumentFeatureState     `json:"feature_states"`
+	IdentityOverrides []DocumentIdentityOverride `json:"identity_overrides"`
+}
+
+type DocumentProject struct {
+	ID                int64             `json:"id"`
+	Name              string            `json:"name"`
+	HideDisabledFlags bool              `json:"hide_disabled_flags"`
+	Segments          []DocumentSegment `json:"segments"`
+}
+
+type DocumentSegment struct {
+	ID            int64                  `json:"id"`
+	Name          string                 `json:"name"`
+	Rules         []DocumentRule         `json:"rules"`
+	FeatureStates []DocumentFeatureState `json:"feature_states"`
+}
+
+type DocumentRule struct {
+	Type       string              `json:"type"`
+	Rules      []DocumentRule      `json:"rules"`
+	Conditions []DocumentCondition `json:"conditions"`
+}
+
+type DocumentCondition struct {
+	Operator string `json:"operator"`
+	Property string `json:"property_"`
+	Value    string `json:"value"`
+}
+
+type DocumentFeature struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type DocumentFeatureState struct {
+	DjangoID                       *int64                           `json:"django_id"`
+	FeatureStateUUID               string                           `json:"featurestate_uuid"`
+	Feature                        DocumentFeature                  `json:"feature"`
+	Enabled                        bool                             `json:"enabled"`
+	Value                          interface{}                      `json:"feature_state_value"`
+	FeatureSegment                 *DocumentFeatureSegment          `json:"feature_segment"`
+	MultivariateFeatureStateValues []DocumentMultivariateStateValue `json:"multivariate_feature_state_values"`
+}
+
+type DocumentFeatureSegment struct {
+	Priority *int64 `json:"priority"`
+}
+
+type DocumentMultivariateStateValue struct {
+	ID                        *int64                     `json:"id"`
+	MVFSValueUUID             string                     `json:"mv_fs_value_uuid"`
+	PercentageAllocation      float64                    `json:"percentage_allocation"`
+	MultivariateFeatureOption DocumentMultivariateOption `json:"multivariate_feature_option"`
+}
+
+type DocumentMultivariateOption struct {
+	ID    *int64      `json:"id"`
+	Value interface{} `json:"value"`
+}
+
+type DocumentIdentityOverride struct {
+	Identifier       string                 `json:"identifier"`
+	IdentityUUID     string                 `json:"identity_uuid"`
+	IdentityFeatures []DocumentFeatureState `json:"identity_features"`
+}
+
+// EvaluationReason explains which part of the environment document a resolved feature state came from
+type EvaluationReason string
+
+const (
+	EvaluationReasonDefault          EvaluationReason = "DEFAULT"
+	EvaluationReasonSegmentOverride  EvaluationReason = "SEGMENT_OVERRIDE"
+	EvaluationReasonIdentityOverride EvaluationReason = "IDENTITY_OVERRIDE"
+)
+
+type FeatureEvaluation struct {
+	// FeatureState is the resolved feature state, with its value set to the selected multivariate option(if any)
+	FeatureState *FeatureState
+	Reason       EvaluationReason
+	// SegmentID and SegmentName are set when Reason is EvaluationReasonSegmentOverride
+	SegmentID   *int64
+	SegmentName string
+	// MultivariateOptionID is set when the value was selected from a multivariate option
+	MultivariateOptionID *int64
+	// MatchedSegments lists the IDs of all the segments the identity belongs to
+	MatchedSegments []int64
+}
+
+func (c *Client) GetEnvironmentDocument(environmentKey string) (*EnvironmentDocument, error) {
+	c, op := c.startOperation("GetEnvironmentDocument", "environment_document", "get",
+		environmentKeyAttribute(environmentKey))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/environments/%s/document/", c.baseURL, environmentKey)
+	document := EnvironmentDocument{}
+	resp, err := c.request().
+		SetResult(&document).Get(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("flagsmithapi: Error getting environment document: %s", resp)
+	}
+	return &document, nil
+}
+
+// EvaluateFeature resolves the feature state of featureName for the identity: the environment default, overridden
+// by the highest priority(lowest number) matching segment override, the last one winning ties like in the engine,
+// overridden by the identity override. The value of multivariate features is then selected using the identity's
+// hashed percentage.
+func (d *EnvironmentDocument) EvaluateFeature(featureName, identifier string, traits []Trait) (*FeatureEvaluation, error) {
+	var featureState *DocumentFeatureState
+	for i := range d.FeatureStates {
+		if d.FeatureStates[i].Feature.Name == featureName {
+			featureState = &d.FeatureStates[i]
+			break
+		}
+	}
+	if featureState == nil {
+		return nil, FeatureNotFoundError{featureUUID: featureName}
+	}
+	evaluation := FeatureEvaluation{Reason: EvaluationReasonDefault}
+	identityKey := d.identityKey(identifier)
+
+	var segmentPriority *int64
+	for i := range d.Project.Segments {
+		documentSegment := &d.Project.Segments[i]
+		segment := documentSegment.toSegment()
+		matches, err := segment.MatchesIdentity(identityKey, traits)
+		if err != nil {
+			return nil, err
+		}
+		if !matches {
+			continue
+		}
+		evaluation.MatchedSegments = append(evaluation.MatchedSegments, documentSegment.ID)
+
+		for j := range documentSegment.FeatureStates {
+			override := &documentSegment.FeatureStates[j]
+			if override.Feature.ID != featureState.Feature.ID {
+				continue
+			}
+			if evaluation.Reason == EvaluationReasonSegmentOverride && override.priority() > featureState.priority() {
+				continue
+			}
+			featureState = override
+			segmentPriority = nil
+			if override.FeatureSegment != nil {
+				segmentPriority = override.FeatureSegment.Priority
+			}
+			segmentID := documentSegment.ID
+			evaluation.Reason = EvaluationReasonSegmentOverride
+			evaluation.SegmentID = &segmentID
+			evaluation.SegmentName = documentSegment.Name
+		}
+	}
+
+	for i := range d.IdentityOverrides {
+		if d.IdentityOverrides[i].Identifier != identifier {
+			continue
+		}
+		for j := range d.IdentityOverrides[i].IdentityFeatures {
+			override := &d.IdentityOverrides[i].IdentityFeatures[j]
+			if override.Feature.ID == featureState.Feature.ID {
+				featureState = override
+				evaluation.Reason = EvaluationReasonIdentityOverride
+				evaluation.SegmentID = nil
+				evaluation.SegmentName = ""
+				segmentPriority = nil
+			}
+		}
+	}
+
+	value, mvOptionID := featureState.valueForIdentity(identityKey)
+	evaluation.MultivariateOptionID = mvOptionID
+	environmentID := d.ID
+	evaluation.FeatureState = &FeatureState{
+		UUID:              featureState.FeatureStateUUID,
+		FeatureStateValue: newFeatureStateValue(value),
+		Enabled:           featureState.Enabled,
+		Feature:           featureState.Feature.ID,
+		Environment:       &environmentID,
+		EnvironmentKey:    d.APIKey,
+		Segment:           evaluation.SegmentID,
+		SegmentPriority:   segmentPriority,
+	}
+	if featureState.DjangoID != nil {
+		evaluation.FeatureState.ID = *featureState.DjangoID
+	}
+	return &evaluation, nil
+}
+
+// identityKey returns the key used for hashing identities, which matches the composite key used by the engine
+func (d *EnvironmentDocument) identityKey(identifier string) string {
+	return d.APIKey + "_" + identifier
+}
+
+func (s *DocumentSegment) toSegment() *Segment {
+	id := s.ID
+	segment := Segment{ID: &id, Name: s.Name, Rules: make([]Rule, 0, len(s.Rules))}
+	for _, rule := range s.Rules {
+		segment.Rules = append(segment.Rules, rule.toRule())
+	}
+	return &segment
+}
+
+func (r *DocumentRule) toRule() Rule {
+	rule := Rule{Type: r.Type}
+	for _, condition := range r.Conditions {
+		rule.Conditions = append(rule.Conditions, Condition{
+			Operator: condition.Operator,
+			Property: condition.Property,
+			Value:    condition.Value,
+		})
+	}
+	for i := range r.Rules {
+		rule.Rules = append(rule.Rules, r.Rules[i].toRule())
+	}
+	return rule
+}
+
+// priority returns the priority of a segment override; overrides without a priority have the lowest priority
+func (fs *DocumentFeatureState) priority() int64 {
+	if fs.FeatureSegment == nil || fs.FeatureSegment.Priority == nil {
+		return math.MaxInt64
+	}
+	return *fs.FeatureSegment.Priority
+}
+
+// valueForIdentity returns the value of the feature state for the identity and the ID of the selected
+// multivariate option, if any
+func (fs *DocumentFeatureState) valueForIdentity(identityKey string) (interface{}, *int64) {
+	if len(fs.MultivariateFeatureStateValues) == 0 {
+		return fs.Value, nil
+	}
+	objectID := fs.FeatureStateUUID
+	if fs.DjangoID != nil {
+		objectID = strconv.FormatInt(*fs.DjangoID, 10)
+	}
+	percentage := hashedPercentageForObjectIDs([]string{objectID, identityKey})
+
+	mvValues := make([]DocumentMultivariateStateValue, len(fs.MultivariateFeatureStateValues))
+	copy(mvValues, fs.MultivariateFeatureStateValues)
+	sort.SliceStable(mvValues, func(i, j int) bool {
+		if mvValues[i].ID != nil && mvValues[j].ID != nil {
+			return *mvValues[i].ID < *mvValues[j].ID
+		}
+		return mvValues[i].MVFSValueUUID < mvValues[j].MVFSValueUUID
+	})
+
+	startPercentage := 0.0
+	for _, mvValue := range mvValues {
+		limit := startPercentage + mvValue.PercentageAllocation
+		if startPercentage <= percentage && percentage < limit {
+			return mvValue.MultivariateFeatureOption.Value, mvValue.MultivariateFeatureOption.ID
+		}
+		startPercentage = limit
+	}
+	return fs.Value, nil
+}
+
+func newFeatureStateValue(value interface{}) *FeatureStateValue {
+	switch v := value.(type) {
+	case string:
+		return &FeatureStateValue{Type: "unicode", StringValue: &v}
+	case bool:
+		return &FeatureStateValue{Type: "bool", BooleanValue: &v}
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < math.MaxInt64 {
+			intValue := int64(v)
+			return &FeatureStateValue{Type: "int", IntegerValue: &intValue}
+		}
+		return &FeatureStateValue{Type: "float", FloatValue: &v}
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+package flagsmithapi_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+const EnvironmentDocumentJson = `
+{
+  "id": 100,
+  "api_key": "environment_api_key",
+  "name": "Development",
+  "project": {
+    "id": 10,
+    "name": "project-1",
+    "hide_disabled_flags": false,
+    "segments": [
+      {
+        "id": 300,
+        "name": "pro_users",
+        "rules": [
+          {
+            "type": "ALL",
+            "rules": [
+              {
+                "type": "ANY",
+                "rules": [],
+                "conditions": [{"operator": "EQUAL", "property_": "plan", "value": "pro"}]
+              }
+            ],
+            "conditions": []
+          }
+        ],
+        "feature_states": [
+          {
+            "django_id": 20,
+            "featurestate_uuid": "5fa6a9d4-6f45-4b8a-8e0b-2a8d6a3e0a01",
+            "feature": {"id": 1, "name": "test_feature", "type": "STANDARD"},
+            "enabled": true,
+            "feature_state_value": "pro",
+            "feature_segment": {"priority": 1},
+            "multivariate_feature_state_values": []
+          }
+        ]
+      },
+      {
+        "id": 301,
+        "name": "half_of_users",
+        "rules": [
+          {
+            "type": "ALL",
+            "rules": [],
+            "conditions": [{"operator": "PERCENTAGE_SPLIT", "property_": null, "value": "50"}]
+          }
+        ],
+        "feature_states": [
+          {
+            "django_id": 21,
+            "featurestate_uuid": "5fa6a9d4-6f45-4b8a-8e0b-2a8d6a3e0a02",
+            "feature": {"id": 1, "name": "test_feature", "type": "STANDARD"},
+            "enabled": true,
+            "feature_state_value": "split",
+            "feature_segment": {"priority": 0},
+            "multivariate_feature_state_values": []
+          }
+        ]
+      }
+    ]
+  },
+  "feature_states": [
+    {
+      "django_id": 10,
+      "featurestate_uuid": "5fa6a9d4-6f45-4b8a-8e0b-2a8d6a3e0a10",
+      "feature": {"id": 1, "name": "test_feature", "type": "STANDARD"},
+      "enabled": false,
+      "feature_state_value": "default",
+      "feature_segment": null,
+      "multivariate_feature_state_values": []
+    },
+    {
+      "django_id": 11,
+      "featurestate_uuid": "5fa6a9d4-6f45-4b8a-8e0b-2a8d6a3e0a11",
+      "feature": {"id": 2, "name": "mv_feature", "type": "MULTIVARIATE"},
+      "enabled": true,
+      "feature_state_value": "control",
+      "feature_segment": null,
+      "multivariate_feature_state_values": [
+        {
+          "id": 2,
+          "mv_fs_value_uuid": "b1",
+          "percentage_allocation": 30,
+          "multivariate_feature_option": {"id": 201, "value": "b"}
+        },
+        {
+          "id": 1,
+          "mv_fs_value_uuid": "a1",
+          "percentage_allocation": 30,
+          "multivariate_feature_option": {"id": 200, "value": "a"}
+        }
+      ]
+    }
+  ],
+  "identity_overrides": [
+    {
+      "identifier": "vip",
+      "identity_uuid": "2cf1e3d4-b23a-4dbb-a5c0-0c3a3a1f0b11",
+      "identity_features": [
+        {
+          "django_id": null,
+          "featurestate_uuid": "5fa6a9d4-6f45-4b8a-8e0b-2a8d6a3e0a30",
+          "feature": {"id": 1, "name": "test_feature", "type": "STANDARD"},
+          "enabled": true,
+          "feature_state_value": "vip",
+          "feature_segment": null,
+          "multivariate_feature_state_values": []
+        }
+      ]
+    }
+  ]
+}
+`
+
+func getEnvironmentDocument(t *testing.T) *flagsmithapi.EnvironmentDocument {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/api/v1/environments/%s/document/", EnvironmentAPIKey), req.URL.Path)
+		assert.Equal(t, "GET", req.Method)
+		assert.Equal(t, "Api-Key "+MasterAPIKey, req.Header.Get("Authorization"))
+
+		rw.Header().Set("Content-Type", "application/json")
+		_, err := io.WriteString(rw, EnvironmentDocumentJson)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := flagsmithapi.NewClient(MasterAPIKey, server.URL+"/api/v1")
+	document, err := client.GetEnvironmentDocument(EnvironmentAPIKey)
+	assert.NoError(t, err)
+	return document
+}
+
+func TestGetEnvironmentDocument(t *testing.T) {
+	// When
+	document := getEnvironmentDocument(t)
+
+	// Then
+	assert.Equal(t, EnvironmentID, document.ID)
+	assert.Equal(t, EnvironmentAPIKey, document.APIKey)
+	assert.Len(t, document.FeatureStates, 2)
+	assert.Len(t, document.Project.Segments, 2)
+	assert.Equal(t, "plan", document.Project.Segments[0].Rules[0].Rules[0].Conditions[0].Property)
+	assert.Len(t, document.IdentityOverrides, 1)
+}
+
+func TestEvaluateFeatureReturnsEnvironmentDefault(t *testing.T) {
+	// Given
+	document := getEnvironmentDocument(t)
+
+	// When
+	evaluation, err := document.EvaluateFeature(FeatureName, "user_4", nil)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, flagsmithapi.EvaluationReasonDefault, evaluation.Reason)
+	assert.Nil(t, evaluation.SegmentID)
+	assert.Empty(t, evaluation.MatchedSegments)
+	assert.Equal(t, int64(10), evaluation.FeatureState.ID)
+	assert.Equal(t, FeatureID, evaluation.FeatureState.Feature)
+	assert.Equal(t, EnvironmentID, *evaluation.FeatureState.Environment)
+	assert.False(t, evaluation.FeatureState.Enabled)
+	assert.Equal(t, "default", *evaluation.FeatureState.FeatureStateValue.StringValue)
+}
+
+func TestEvaluateFeatureReturnsSegmentOverride(t *testing.T) {
+	// Given
+	document := getEnvironmentDocument(t)
+	traits := []flagsmithapi.Trait{stringTrait("plan", "pro")}
+
+	// When
+	evaluation, err := document.EvaluateFeature(FeatureName, "pro_user", traits)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, flagsmithapi.EvaluationReasonSegmentOverride, evaluation.Reason)
+	assert.Equal(t, SegmentID, *evaluation.SegmentID)
+	assert.Equal(t, "pro_users", evaluation.SegmentName)
+	assert.Equal(t, []int64{SegmentID}, evaluation.MatchedSegments)
+	assert.Equal(t, int64(1), *evaluation.FeatureState.SegmentPriority)
+	assert.True(t, evaluation.FeatureState.Enabled)
+	assert.Equal(t, "pro", *evaluation.FeatureState.FeatureStateValue.StringValue)
+}
+
+func TestEvaluateFeatureUsesHighestPrioritySegmentOverride(t *testing.T) {
+	// Given
+	document := getEnvironmentDocument(t)
+	traits := []flagsmithapi.Trait{stringTrait("plan", "pro")}
+
+	// When
+	evaluation, err := document.EvaluateFeature(FeatureName, "user_1", traits)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, flagsmithapi.EvaluationReasonSegmentOverride, evaluation.Reason)
+	assert.Equal(t, int64(301), *evaluation.SegmentID)
+	assert.Equal(t, []int64{SegmentID, 301}, evaluation.MatchedSegments)
+	assert.Equal(t, int64(0), *evaluation.FeatureState.SegmentPriority)
+	assert.Equal(t, "split", *evaluation.FeatureState.FeatureStateValue.StringValue)
+}
+
+func TestEvaluateFeatureRanksSegmentOverridesWithoutPriorityLast(t *testing.T) {
+	// Given
+	document := getEnvironmentDocument(t)
+	// the override of pro_users, which matches first, has no priority while the one of half_of_users has priority 0
+	document.Project.Segments[0].FeatureStates[0].FeatureSegment.Priority = nil
+	traits := []flagsmithapi.Trait{stringTrait("plan", "pro")}
+
+	// When
+	evaluation, err := document.EvaluateFeature(FeatureName, "user_1", traits)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, flagsmithapi.EvaluationReasonSegmentOverride, evaluation.Reason)
+	assert.Equal(t, int64(301), *evaluation.SegmentID)
+	assert.Equal(t, int64(0), *evaluation.FeatureState.SegmentPriority)
+	assert.Equal(t, "split", *evaluation.FeatureState.FeatureStateValue.StringValue)
+}
+
+func TestEvaluateFeatureUsesLastSegmentOverrideOnPriorityTie(t *testing.T) {
+	// Given
+	document := getEnvironmentDocument(t)
+	priority := int64(0)
+	document.Project.Segments[0].FeatureStates[0].FeatureSegment.Priority = &priority
+	traits := []flagsmithapi.Trait{stringTrait("plan", "pro")}
+
+	// When
+	evaluation, err := document.EvaluateFeature(FeatureName, "user_1", traits)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, flagsmithapi.EvaluationReasonSegmentOverride, evaluation.Reason)
+	assert.Equal(t, int64(301), *evaluation.SegmentID)
+	assert.Equal(t, "split", *evaluation.FeatureState.FeatureStateValue.StringValue)
+}
+
+func TestEvaluateFeatureKeepsFloatValues(t *testing.T) {
+	// Given
+	document := getEnvironmentDocument(t)
+	// user_1 is in the half_of_users split
+	document.Project.Segments[1].FeatureStates[0].Value = 1.5
+	document.IdentityOverrides[0].IdentityFeatures[0].Value = float64(2)
+
+	// When
+	float, floatErr := document.EvaluateFeature(FeatureName, "user_1", nil)
+	integer, integerErr := document.EvaluateFeature(FeatureName, "vip", nil)
+
+	// Then
+	assert.NoError(t, floatErr)
+	assert.NoError(t, integerErr)
+	assert.Equal(t, "float", float.FeatureState.FeatureStateValue.Type)
+	assert.Equal(t, 1.5, *float.FeatureState.FeatureStateValue.FloatValue)
+	assert.Equal(t, "int", integer.FeatureState.FeatureStateValue.Type)
+	assert.Equal(t, int64(2), *integer.FeatureState.FeatureStateValue.IntegerValue)
+}
+
+func TestEvaluateFeatureReturnsIdentityOverride(t *testing.T) {
+	// Given
+	document := getEnvironmentDocument(t)
+	traits := []flagsmithapi.Trait{stringTrait("plan", "pro")}
+
+	// When
+	evaluation, err := document.EvaluateFeature(FeatureName, "vip", traits)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, flagsmithapi.EvaluationReasonIdentityOverride, evaluation.Reason)
+	assert.Nil(t, evaluation.SegmentID)
+	assert.Nil(t, evaluation.FeatureState.SegmentPriority)
+	assert.Equal(t, "vip", *evaluation.FeatureState.FeatureStateValue.StringValue)
+}
+
+func TestEvaluateFeatureSelectsMultivariateValue(t *testing.T) {
+	// Given
+	document := getEnvironmentDocument(t)
+
+	// hashed percentages for feature state 11: user_1 => 22.45, user_3 => 54.62, user_2 => 95.63
+	testCases := []struct {
+		identifier string
+		value      string
+		optionID   *int64
+	}{
+		{"user_1", "a", int64Pointer(200)},
+		{"user_3", "b", int64Pointer(201)},
+		{"user_2", "control", nil},
+	}
+	for _, tc := range testCases {
+		// When
+		evaluation, err := document.EvaluateFeature("mv_feature", tc.identifier, nil)
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, tc.value, *evaluation.FeatureState.FeatureStateValue.StringValue, tc.identifier)
+		assert.Equal(t, tc.optionID, evaluation.MultivariateOptionID, tc.identifier)
+	}
+}
+
+func TestEvaluateFeatureReturnsErrorForUnknownFeature(t *testing.T) {
+	// Given
+	document := getEnvironmentDocument(t)
+
+	// When
+	_, err := document.EvaluateFeature("unknown_feature", "user_1", nil)
+
+	// Then
+	assert.Error(t, err)
+}
+
+func int64Pointer(value int64) *int64 {
+	return &value
+}
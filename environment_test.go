@@ -118,6 +118,46 @@ func TestCreateEnvironment(t *testing.T) {
 	assert.Equal(t, "environment_api_key", environment.APIKey)
 }
 
+func TestCloneEnvironment(t *testing.T) {
+	// Given
+	const clonedAPIKey = "cloned_api_key"
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf("/api/v1/environments/%s/clone/", EnvironmentAPIKey), func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		rawBody, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name": "preview-branch-1"}`, string(rawBody))
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusCreated)
+		_, err = fmt.Fprintf(rw, `{"id": 200, "name": "preview-branch-1", "api_key": "%s", "project": %d}`, clonedAPIKey, ProjectID)
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc(fmt.Sprintf("/api/v1/environments/%s/api-keys/", clonedAPIKey), func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "POST", req.Method)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusCreated)
+		_, err := io.WriteString(rw, `{"id": 10, "active": true, "name": "ci", "key": "ser.cloned"}`)
+		assert.NoError(t, err)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := flagsmithapi.NewClient(MasterAPIKey, server.URL+"/api/v1")
+	key := flagsmithapi.ServerSideEnvKey{Name: "ci", Active: true}
+
+	// When
+	environment, err := client.CloneEnvironment(EnvironmentAPIKey, "preview-branch-1", &key)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, int64(200), environment.ID)
+	assert.Equal(t, clonedAPIKey, environment.APIKey)
+	assert.Equal(t, ProjectID, environment.ProjectID)
+	assert.Equal(t, int64(10), key.ID)
+	assert.Equal(t, "ser.cloned", key.Key)
+}
+
 func TestUpdateEnvironment(t *testing.T) {
 	// Given
 	updatedDescription := "Updated environment description"
@@ -2,6 +2,7 @@ package flagsmithapi
 
 import (
 	"fmt"
+	"strings"
 )
 
 type FeatureNotFoundError struct {
@@ -19,6 +20,28 @@ type FeatureMVOptionNotFoundError struct {
 type UserNotFoundError struct {
 	email string
 }
+type InvalidFeatureValueError struct {
+	feature string
+	err     error
+}
+type InvalidFeatureNameError struct {
+	feature    string
+	project    string
+	violations []featureNameViolation
+}
+type featureNameViolation struct {
+	rule   string
+	reason string
+}
+type BulkUpdateError struct {
+	failed int
+	total  int
+	errs   []error
+}
+type InvalidSegmentRuleError struct {
+	path   string
+	reason string
+}
 
 func (e FeatureNotFoundError) Error() string {
 	return fmt.Sprintf("flagsmithapi: feature '%s' not found", e.featureUUID)
@@ -39,3 +62,47 @@ func (e FeatureMVOptionNotFoundError) Error() string {
 func (e UserNotFoundError) Error() string {
 	return fmt.Sprintf("flagsmithapi: user with email '%s' not found", e.email)
 }
+
+func (e InvalidFeatureValueError) Error() string {
+	return fmt.Sprintf("flagsmithapi: invalid value for feature '%s': %s", e.feature, e.err)
+}
+
+func (e InvalidFeatureValueError) Unwrap() error {
+	return e.err
+}
+
+func (e InvalidFeatureNameError) Error() string {
+	reasons := make([]string, 0, len(e.violations))
+	for _, violation := range e.violations {
+		reasons = append(reasons, violation.reason)
+	}
+	return fmt.Sprintf("flagsmithapi: invalid name for feature '%s' in project '%s': %s", e.feature, e.project,
+		strings.Join(reasons, "; "))
+}
+
+// Rules returns the violated rules of the naming policy, i.e: FeatureNameRuleRegex
+func (e InvalidFeatureNameError) Rules() []string {
+	rules := make([]string, 0, len(e.violations))
+	for _, violation := range e.violations {
+		rules = append(rules, violation.rule)
+	}
+	return rules
+}
+
+func (e BulkUpdateError) Error() string {
+	messages := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("flagsmithapi: %d of %d feature state changes failed: %s", e.failed, e.total,
+		strings.Join(messages, "; "))
+}
+
+// Unwrap returns the errors of the failed changes and rollbacks
+func (e BulkUpdateError) Unwrap() []error {
+	return e.errs
+}
+
+func (e InvalidSegmentRuleError) Error() string {
+	return fmt.Sprintf("flagsmithapi: invalid segment rule at %s: %s", e.path, e.reason)
+}
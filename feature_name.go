@@ -0,0 +1,56 @@
+package flagsmithapi
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Rules of the feature naming policy of a project, as reported by InvalidFeatureNameError.Rules
+const (
+	FeatureNameRuleLowerCase = "only_allow_lower_case_feature_names"
+	FeatureNameRuleRegex     = "feature_name_regex"
+)
+
+// ValidateFeatureName checks the name against the feature naming policy of the project: the name must be lower case
+// if OnlyAllowLowerCaseFeatureNames is set, and must match FeatureNameRegex in full if it is set. It returns an
+// InvalidFeatureNameError listing the violated rules; a regex that is not valid RE2 syntax is an error as well.
+func ValidateFeatureName(project *Project, name string) error {
+	err := InvalidFeatureNameError{feature: name, project: project.Name}
+	if project.OnlyAllowLowerCaseFeatureNames && name != strings.ToLower(name) {
+		err.violations = append(err.violations, featureNameViolation{
+			rule:   FeatureNameRuleLowerCase,
+			reason: "only lower case names are allowed",
+		})
+	}
+	if project.FeatureNameRegex != "" {
+		regex, compileErr := regexp.Compile(`^(?:` + project.FeatureNameRegex + `)$`)
+		if compileErr != nil {
+			return fmt.Errorf("flagsmithapi: unsupported feature_name_regex '%s' in project '%s': %w",
+				project.FeatureNameRegex, project.Name, compileErr)
+		}
+		if !regex.MatchString(name) {
+			err.violations = append(err.violations, featureNameViolation{
+				rule:   FeatureNameRuleRegex,
+				reason: fmt.Sprintf("name must match '%s'", project.FeatureNameRegex),
+			})
+		}
+	}
+	if len(err.violations) > 0 {
+		return err
+	}
+	return nil
+}
+
+// validateFeatureName checks the name of a feature about to be created against the policy of its project, when the
+// client was created with WithFeatureNameValidation
+func (c *Client) validateFeatureName(feature *Feature) error {
+	if !c.validateFeatureNames {
+		return nil
+	}
+	project, err := c.getProject(*feature.ProjectID)
+	if err != nil {
+		return err
+	}
+	return ValidateFeatureName(project, feature.Name)
+}
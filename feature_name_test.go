@@ -0,0 +1,166 @@
+package flagsmithapi_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+// countingTransport counts the requests by method and path
+type countingTransport struct {
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.requests[req.Method+" "+req.URL.Path]++
+	t.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (t *countingTransport) count(key string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.requests[key]
+}
+
+func TestValidateFeatureName(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		project flagsmithapi.Project
+		feature string
+		rules   []string
+		err     string
+	}{
+		{name: "no policy", project: flagsmithapi.Project{Name: "p"}, feature: "Any Name"},
+		{
+			name:    "lower case",
+			project: flagsmithapi.Project{Name: "p", OnlyAllowLowerCaseFeatureNames: true},
+			feature: "Checkout_V2",
+			rules:   []string{flagsmithapi.FeatureNameRuleLowerCase},
+			err:     "flagsmithapi: invalid name for feature 'Checkout_V2' in project 'p': only lower case names are allowed",
+		},
+		{
+			name:    "regex is matched in full",
+			project: flagsmithapi.Project{Name: "p", FeatureNameRegex: "[a-z]+(_[a-z0-9]+)*"},
+			feature: "checkout-v2",
+			rules:   []string{flagsmithapi.FeatureNameRuleRegex},
+			err:     "flagsmithapi: invalid name for feature 'checkout-v2' in project 'p': name must match '[a-z]+(_[a-z0-9]+)*'",
+		},
+		{
+			name:    "every rule",
+			project: flagsmithapi.Project{Name: "p", OnlyAllowLowerCaseFeatureNames: true, FeatureNameRegex: "^[a-z_]+$"},
+			feature: "Checkout",
+			rules:   []string{flagsmithapi.FeatureNameRuleLowerCase, flagsmithapi.FeatureNameRuleRegex},
+			err: "flagsmithapi: invalid name for feature 'Checkout' in project 'p': only lower case names are allowed; " +
+				"name must match '^[a-z_]+$'",
+		},
+		{
+			name:    "valid",
+			project: flagsmithapi.Project{Name: "p", OnlyAllowLowerCaseFeatureNames: true, FeatureNameRegex: "^[a-z_]+$"},
+			feature: "checkout",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			// When
+			err := flagsmithapi.ValidateFeatureName(&test.project, test.feature)
+
+			// Then
+			if test.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.err)
+			var nameErr flagsmithapi.InvalidFeatureNameError
+			require.True(t, errors.As(err, &nameErr))
+			assert.Equal(t, test.rules, nameErr.Rules())
+		})
+	}
+}
+
+func TestValidateFeatureNameRejectsUnsupportedRegex(t *testing.T) {
+	// Given
+	project := flagsmithapi.Project{Name: "p", FeatureNameRegex: `^(?!legacy_)[a-z_]+$`}
+
+	// When
+	err := flagsmithapi.ValidateFeatureName(&project, "checkout")
+
+	// Then
+	assert.ErrorContains(t, err, "flagsmithapi: unsupported feature_name_regex '^(?!legacy_)[a-z_]+$' in project 'p'")
+	assert.False(t, errors.As(err, &flagsmithapi.InvalidFeatureNameError{}))
+}
+
+func TestCreateFeatureValidatesNameWithFeatureNameValidation(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	transport := &countingTransport{requests: map[string]int{}}
+	client := server.Client(flagsmithapi.WithFeatureNameValidation(), flagsmithapi.WithIDCache(time.Minute),
+		flagsmithapi.WithTransport(transport))
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID, OnlyAllowLowerCaseFeatureNames: true}
+	require.NoError(t, client.CreateProject(&project))
+	projectPath := fmt.Sprintf("GET /api/v1/projects/%d/", project.ID)
+
+	// When
+	invalid := flagsmithapi.Feature{Name: "Checkout", ProjectID: &project.ID}
+	invalidErr := client.CreateFeature(&invalid)
+	valid := flagsmithapi.Feature{Name: "checkout", ProjectID: &project.ID}
+	validErr := client.CreateFeature(&valid)
+
+	// Then
+	var nameErr flagsmithapi.InvalidFeatureNameError
+	assert.True(t, errors.As(invalidErr, &nameErr))
+	assert.Nil(t, invalid.ID)
+	assert.NoError(t, validErr)
+	assert.NotNil(t, valid.ID)
+	// the project created through the client is cached
+	assert.Equal(t, 0, transport.count(projectPath))
+
+	// When
+	project.OnlyAllowLowerCaseFeatureNames = false
+	require.NoError(t, client.UpdateProject(&project))
+	other := flagsmithapi.Feature{Name: "Other", ProjectUUID: project.UUID}
+	err := client.CreateFeature(&other)
+
+	// Then
+	assert.NoError(t, err)
+	// the project fetched to resolve the UUID replaces the evicted one
+	assert.Equal(t, 0, transport.count(projectPath))
+}
+
+func TestFeatureNameValidationFetchesProjectsAgainOnceTheCacheExpires(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	setupClient := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, setupClient.CreateProject(&project))
+	transport := &countingTransport{requests: map[string]int{}}
+	client := server.Client(flagsmithapi.WithFeatureNameValidation(), flagsmithapi.WithIDCache(20*time.Millisecond),
+		flagsmithapi.WithTransport(transport))
+	projectPath := fmt.Sprintf("GET /api/v1/projects/%d/", project.ID)
+	first := flagsmithapi.Feature{Name: "Checkout", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&first))
+
+	// When
+	project.OnlyAllowLowerCaseFeatureNames = true
+	require.NoError(t, setupClient.UpdateProject(&project))
+	time.Sleep(40 * time.Millisecond)
+	second := flagsmithapi.Feature{Name: "Banner", ProjectID: &project.ID}
+	err := client.CreateFeature(&second)
+
+	// Then
+	var nameErr flagsmithapi.InvalidFeatureNameError
+	assert.True(t, errors.As(err, &nameErr))
+	assert.Equal(t, 2, transport.count(projectPath))
+}
@@ -0,0 +1,46 @@
+package flagsmithtest
+
+import (
+	"net/http"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// ChangeRequests returns the change requests submitted to the environment, in order. The fake does not support
+// approving or committing change requests.
+func (s *Server) ChangeRequests(environmentKey string) []flagsmithapi.ChangeRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changeRequests := []flagsmithapi.ChangeRequest{}
+	for _, changeRequest := range s.changeRequests {
+		if changeRequest.EnvironmentKey == environmentKey {
+			changeRequests = append(changeRequests, *changeRequest)
+		}
+	}
+	sortByID(changeRequests, func(c flagsmithapi.ChangeRequest) int64 { return c.ID })
+	return changeRequests
+}
+
+func (s *Server) createChangeRequest(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	environment, ok := s.environments[params["key"]]
+	if !ok {
+		writeNotFound(rw)
+		return
+	}
+	changeRequest := flagsmithapi.ChangeRequest{}
+	if !readJSON(rw, req, &changeRequest) {
+		return
+	}
+	if changeRequest.Title == "" {
+		writeError(rw, http.StatusBadRequest, "title is required")
+		return
+	}
+	environmentID := environment.ID
+	for i := range changeRequest.FeatureStates {
+		changeRequest.FeatureStates[i].Environment = &environmentID
+	}
+	changeRequest.ID = s.nextID()
+	changeRequest.EnvironmentKey = environment.APIKey
+	s.changeRequests[changeRequest.ID] = &changeRequest
+	writeJSON(rw, http.StatusCreated, changeRequest)
+}
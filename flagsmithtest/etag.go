@@ -0,0 +1,44 @@
+package flagsmithtest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+)
+
+// conditionalResponseWriter buffers the response to a GET request to set its ETag, and answers 304 Not Modified when
+// the ETag matches the If-None-Match header of the request
+type conditionalResponseWriter struct {
+	http.ResponseWriter
+	req    *http.Request
+	status int
+	body   bytes.Buffer
+}
+
+func newConditionalResponseWriter(rw http.ResponseWriter, req *http.Request) *conditionalResponseWriter {
+	return &conditionalResponseWriter{ResponseWriter: rw, req: req, status: http.StatusOK}
+}
+
+func (w *conditionalResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *conditionalResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *conditionalResponseWriter) flush() {
+	if w.status == http.StatusOK {
+		hash := sha256.Sum256(w.body.Bytes())
+		etag := `"` + hex.EncodeToString(hash[:16]) + `"`
+		w.Header().Set("ETag", etag)
+		if w.req.Header.Get("If-None-Match") == etag {
+			w.Header().Del("Content-Type")
+			w.ResponseWriter.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	_, _ = w.ResponseWriter.Write(w.body.Bytes())
+}
@@ -0,0 +1,621 @@
+package flagsmithtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// featureBody decodes features as sent by the client(owners as IDs), without Feature's custom UnmarshalJSON
+type featureBody flagsmithapi.Feature
+
+type ownerResponse struct {
+	ID int64 `json:"id"`
+}
+
+// featureResponse renders a feature the way the API does, with owners as objects and the multivariate options
+func (s *Server) featureResponse(feature *flagsmithapi.Feature) map[string]interface{} {
+	raw, _ := json.Marshal(featureBody(*feature))
+	response := map[string]interface{}{}
+	_ = json.Unmarshal(raw, &response)
+
+	owners := []ownerResponse{}
+	if feature.Owners != nil {
+		for _, id := range *feature.Owners {
+			owners = append(owners, ownerResponse{ID: id})
+		}
+	}
+	groupOwners := []ownerResponse{}
+	if feature.GroupOwners != nil {
+		for _, id := range *feature.GroupOwners {
+			groupOwners = append(groupOwners, ownerResponse{ID: id})
+		}
+	}
+	response["owners"] = owners
+	response["group_owners"] = groupOwners
+	response["multivariate_options"] = s.featureMVOptions(*feature.ID)
+	return response
+}
+
+func (s *Server) featureMVOptions(featureID int64) []flagsmithapi.FeatureMultivariateOption {
+	options := []flagsmithapi.FeatureMultivariateOption{}
+	for _, option := range s.mvOptions {
+		if *option.FeatureID == featureID {
+			options = append(options, *option)
+		}
+	}
+	sortByID(options, func(o flagsmithapi.FeatureMultivariateOption) int64 { return o.ID })
+	return options
+}
+
+func (s *Server) projectFeature(params map[string]string) *flagsmithapi.Feature {
+	feature, ok := s.features[int64Param(params, "feature_id")]
+	if !ok || *feature.ProjectID != int64Param(params, "project_id") {
+		return nil
+	}
+	return feature
+}
+
+func (s *Server) getFeatureByUUID(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	for _, feature := range s.features {
+		if feature.UUID == params["uuid"] {
+			writeJSON(rw, http.StatusOK, s.featureResponse(feature))
+			return
+		}
+	}
+	writeNotFound(rw)
+}
+
+func (s *Server) listFeatures(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	projectID := int64Param(params, "project_id")
+	if _, ok := s.projects[projectID]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	features := []*flagsmithapi.Feature{}
+	for _, feature := range s.features {
+		if *feature.ProjectID == projectID {
+			features = append(features, feature)
+		}
+	}
+	sortByID(features, func(f *flagsmithapi.Feature) int64 { return *f.ID })
+	results := []map[string]interface{}{}
+	for _, feature := range features {
+		results = append(results, s.featureResponse(feature))
+	}
+	writePage(rw, req, results, s.PageSize)
+}
+
+func (s *Server) createFeature(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	projectID := int64Param(params, "project_id")
+	if _, ok := s.projects[projectID]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	body := featureBody{}
+	if !readJSON(rw, req, &body) {
+		return
+	}
+	feature := flagsmithapi.Feature(body)
+	for _, existing := range s.features {
+		if *existing.ProjectID == projectID && existing.Name == feature.Name {
+			writeError(rw, http.StatusBadRequest, "Feature with that name already exists.")
+			return
+		}
+	}
+	id := s.nextID()
+	feature.ID = &id
+	feature.UUID = newUUID()
+	feature.CreatedDate = s.now()
+	feature.ProjectID = &projectID
+	if feature.Type == nil {
+		featureType := "STANDARD"
+		feature.Type = &featureType
+	}
+	if feature.Tags == nil {
+		feature.Tags = []int64{}
+	}
+	s.features[id] = &feature
+
+	for _, environment := range s.environments {
+		if environment.ProjectID == projectID {
+			s.createEnvironmentFeatureState(environment, &feature)
+		}
+	}
+	writeJSON(rw, http.StatusCreated, s.featureResponse(&feature))
+}
+
+func (s *Server) updateFeature(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	existing := s.projectFeature(params)
+	if existing == nil {
+		writeNotFound(rw)
+		return
+	}
+	body := featureBody{}
+	if !readJSON(rw, req, &body) {
+		return
+	}
+	feature := flagsmithapi.Feature(body)
+	feature.ID = existing.ID
+	feature.UUID = existing.UUID
+	feature.ProjectID = existing.ProjectID
+	feature.CreatedDate = existing.CreatedDate
+	if feature.Type == nil {
+		feature.Type = existing.Type
+	}
+	if feature.Owners == nil {
+		feature.Owners = existing.Owners
+	}
+	if feature.GroupOwners == nil {
+		feature.GroupOwners = existing.GroupOwners
+	}
+	if feature.Tags == nil {
+		feature.Tags = []int64{}
+	}
+	*existing = feature
+	writeJSON(rw, http.StatusOK, s.featureResponse(existing))
+}
+
+func (s *Server) deleteFeature(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	feature := s.projectFeature(params)
+	if feature == nil {
+		writeNotFound(rw)
+		return
+	}
+	s.deleteFeatureData(*feature.ID)
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteFeatureData(featureID int64) {
+	for id, record := range s.featureStates {
+		if record.state.Feature == featureID {
+			delete(s.featureStates, id)
+		}
+	}
+	for id, featureSegment := range s.featureSegments {
+		if featureSegment.Feature == featureID {
+			delete(s.featureSegments, id)
+		}
+	}
+	for id, option := range s.mvOptions {
+		if *option.FeatureID == featureID {
+			delete(s.mvOptions, id)
+		}
+	}
+	delete(s.features, featureID)
+}
+
+func (s *Server) manageFeatureOwners(field string, add bool) handlerFunc {
+	return func(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+		feature := s.projectFeature(params)
+		if feature == nil {
+			writeNotFound(rw)
+			return
+		}
+		body := map[string][]int64{}
+		if !readJSON(rw, req, &body) {
+			return
+		}
+		owners := &feature.Owners
+		if field == "group_ids" {
+			owners = &feature.GroupOwners
+		}
+		if *owners == nil {
+			*owners = &[]int64{}
+		}
+		updated := []int64{}
+		for _, id := range **owners {
+			if !containsID(body[field], id) {
+				updated = append(updated, id)
+			}
+		}
+		if add {
+			updated = append(updated, body[field]...)
+		}
+		*owners = &updated
+		writeJSON(rw, http.StatusOK, s.featureResponse(feature))
+	}
+}
+
+func (s *Server) getFeatureMVOptionByUUID(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	for _, option := range s.mvOptions {
+		if option.UUID == params["uuid"] {
+			writeJSON(rw, http.StatusOK, option)
+			return
+		}
+	}
+	writeNotFound(rw)
+}
+
+func (s *Server) listFeatureMVOptions(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	feature := s.projectFeature(params)
+	if feature == nil {
+		writeNotFound(rw)
+		return
+	}
+	writePage(rw, req, s.featureMVOptions(*feature.ID), s.PageSize)
+}
+
+func (s *Server) createFeatureMVOption(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	feature := s.projectFeature(params)
+	if feature == nil {
+		writeNotFound(rw)
+		return
+	}
+	option := flagsmithapi.FeatureMultivariateOption{}
+	if !readJSON(rw, req, &option) {
+		return
+	}
+	option.ID = s.nextID()
+	option.UUID = newUUID()
+	option.FeatureID = feature.ID
+	s.mvOptions[option.ID] = &option
+	for _, record := range s.featureStates {
+		if record.state.Feature == *feature.ID {
+			record.state.MultivariateFeatureStateValues = append(record.state.MultivariateFeatureStateValues,
+				s.newMVStateValue(&option))
+		}
+	}
+	writeJSON(rw, http.StatusCreated, option)
+}
+
+func (s *Server) updateFeatureMVOption(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	feature := s.projectFeature(params)
+	existing, ok := s.mvOptions[int64Param(params, "id")]
+	if feature == nil || !ok || *existing.FeatureID != *feature.ID {
+		writeNotFound(rw)
+		return
+	}
+	option := flagsmithapi.FeatureMultivariateOption{}
+	if !readJSON(rw, req, &option) {
+		return
+	}
+	option.ID = existing.ID
+	option.UUID = existing.UUID
+	option.FeatureID = existing.FeatureID
+	*existing = option
+	writeJSON(rw, http.StatusOK, existing)
+}
+
+func (s *Server) deleteFeatureMVOption(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	feature := s.projectFeature(params)
+	option, ok := s.mvOptions[int64Param(params, "id")]
+	if feature == nil || !ok || *option.FeatureID != *feature.ID {
+		writeNotFound(rw)
+		return
+	}
+	delete(s.mvOptions, option.ID)
+	for _, record := range s.featureStates {
+		values := []flagsmithapi.MultivariateFeatureStateValue{}
+		for _, value := range record.state.MultivariateFeatureStateValues {
+			if value.MultivariateFeatureOption != option.ID {
+				values = append(values, value)
+			}
+		}
+		record.state.MultivariateFeatureStateValues = values
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// createEnvironmentFeatureState creates the environment default feature state of a feature, using the feature's
+// default enabled state and initial value
+func (s *Server) createEnvironmentFeatureState(environment *flagsmithapi.Environment, feature *flagsmithapi.Feature) {
+	environmentID := environment.ID
+	state := flagsmithapi.FeatureState{
+		ID:                s.nextID(),
+		UUID:              newUUID(),
+		FeatureStateValue: valueFromString(feature.InitialValue),
+		Enabled:           feature.DefaultEnabled,
+		Feature:           *feature.ID,
+		Environment:       &environmentID,
+		UpdatedAt:         s.now(),
+	}
+	for _, option := range s.featureMVOptions(*feature.ID) {
+		state.MultivariateFeatureStateValues = append(state.MultivariateFeatureStateValues, s.newMVStateValue(&option))
+	}
+	s.featureStates[state.ID] = &featureStateRecord{state: state}
+}
+
+// newMVStateValue allocates the default percentage of a multivariate option to a feature state
+func (s *Server) newMVStateValue(option *flagsmithapi.FeatureMultivariateOption) flagsmithapi.MultivariateFeatureStateValue {
+	id := s.nextID()
+	return flagsmithapi.MultivariateFeatureStateValue{
+		ID:                        &id,
+		MultivariateFeatureOption: option.ID,
+		PercentageAllocation:      option.DefaultPercentageAllocation,
+	}
+}
+
+// updateMVStateValues updates the percentage allocations of a feature state; options not in values keep their
+// allocation
+func updateMVStateValues(state *flagsmithapi.FeatureState, values []flagsmithapi.MultivariateFeatureStateValue) {
+	for _, value := range values {
+		for i := range state.MultivariateFeatureStateValues {
+			if state.MultivariateFeatureStateValues[i].MultivariateFeatureOption == value.MultivariateFeatureOption {
+				state.MultivariateFeatureStateValues[i].PercentageAllocation = value.PercentageAllocation
+			}
+		}
+	}
+}
+
+// replaceMVStateValues sets the percentage allocations of a feature state to values, removing the allocations of the
+// options that are not in values, like the API does when the multivariate_feature_state_values of an update are set
+func (s *Server) replaceMVStateValues(state *flagsmithapi.FeatureState, values []flagsmithapi.MultivariateFeatureStateValue) {
+	replaced := []flagsmithapi.MultivariateFeatureStateValue{}
+	for _, value := range values {
+		option, ok := s.mvOptions[value.MultivariateFeatureOption]
+		if !ok || *option.FeatureID != state.Feature {
+			continue
+		}
+		updated, found := flagsmithapi.MultivariateFeatureStateValue{}, false
+		for _, existing := range state.MultivariateFeatureStateValues {
+			if existing.MultivariateFeatureOption == option.ID {
+				updated, found = existing, true
+			}
+		}
+		if !found {
+			updated = s.newMVStateValue(option)
+		}
+		updated.PercentageAllocation = value.PercentageAllocation
+		replaced = append(replaced, updated)
+	}
+	state.MultivariateFeatureStateValues = replaced
+}
+
+// valueFromString infers the type of a feature initial value the same way the API does
+func valueFromString(value string) *flagsmithapi.FeatureStateValue {
+	if value == "" {
+		return &flagsmithapi.FeatureStateValue{Type: "unicode"}
+	}
+	if intValue, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return &flagsmithapi.FeatureStateValue{Type: "int", IntegerValue: &intValue}
+	}
+	if value == "true" || value == "false" {
+		boolValue := value == "true"
+		return &flagsmithapi.FeatureStateValue{Type: "bool", BooleanValue: &boolValue}
+	}
+	return &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value}
+}
+
+// rawValue returns the feature state value as the plain JSON value used by the environment endpoints
+func rawValue(value *flagsmithapi.FeatureStateValue) interface{} {
+	switch {
+	case value == nil:
+		return nil
+	case value.IntegerValue != nil:
+		return *value.IntegerValue
+	case value.BooleanValue != nil:
+		return *value.BooleanValue
+	case value.StringValue != nil:
+		return *value.StringValue
+	}
+	return nil
+}
+
+func (s *Server) getFeatureStateByUUID(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	for _, record := range s.featureStates {
+		if record.state.UUID == params["uuid"] {
+			writeJSON(rw, http.StatusOK, record.state)
+			return
+		}
+	}
+	writeNotFound(rw)
+}
+
+func (s *Server) updateFeatureState(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	record, ok := s.featureStates[int64Param(params, "id")]
+	if !ok {
+		writeNotFound(rw)
+		return
+	}
+	state := flagsmithapi.FeatureState{}
+	if !readJSON(rw, req, &state) {
+		return
+	}
+	record.state.Enabled = state.Enabled
+	record.state.UpdatedAt = s.now()
+	if state.FeatureStateValue != nil {
+		record.state.FeatureStateValue = state.FeatureStateValue
+	}
+	if state.MultivariateFeatureStateValues != nil {
+		s.replaceMVStateValues(&record.state, state.MultivariateFeatureStateValues)
+	}
+	writeJSON(rw, http.StatusOK, record.state)
+}
+
+// createFeatureState creates segment override feature states
+func (s *Server) createFeatureState(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	state := flagsmithapi.FeatureState{}
+	if !readJSON(rw, req, &state) {
+		return
+	}
+	if state.FeatureSegment == nil {
+		writeError(rw, http.StatusBadRequest, "feature_segment is required")
+		return
+	}
+	featureSegment, ok := s.featureSegments[*state.FeatureSegment]
+	if !ok || featureSegment.Feature != state.Feature {
+		writeError(rw, http.StatusBadRequest, "Invalid feature segment")
+		return
+	}
+	environmentID := featureSegment.Environment
+	state.ID = s.nextID()
+	state.UUID = newUUID()
+	state.UpdatedAt = s.now()
+	state.Environment = &environmentID
+	if state.FeatureStateValue == nil {
+		state.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "unicode"}
+	}
+	values := state.MultivariateFeatureStateValues
+	state.MultivariateFeatureStateValues = nil
+	for _, option := range s.featureMVOptions(state.Feature) {
+		state.MultivariateFeatureStateValues = append(state.MultivariateFeatureStateValues, s.newMVStateValue(&option))
+	}
+	updateMVStateValues(&state, values)
+	s.featureStates[state.ID] = &featureStateRecord{state: state}
+	writeJSON(rw, http.StatusCreated, state)
+}
+
+// listEnvironmentFeatureStates lists the environment default feature states, optionally filtered by feature
+func (s *Server) listEnvironmentFeatureStates(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	environment, ok := s.environments[params["key"]]
+	if !ok {
+		writeNotFound(rw)
+		return
+	}
+	featureFilter := req.URL.Query().Get("feature")
+	results := []map[string]interface{}{}
+	for _, record := range s.sortedFeatureStates() {
+		state := record.state
+		if *state.Environment != environment.ID || state.FeatureSegment != nil || record.identity != nil {
+			continue
+		}
+		if featureFilter != "" && featureFilter != strconv.FormatInt(state.Feature, 10) {
+			continue
+		}
+		results = append(results, featureStateResponse(record))
+	}
+	writePage(rw, req, results, s.PageSize)
+}
+
+// listFeatureStates lists the feature states(including segment overrides) filtered by environment and feature
+func (s *Server) listFeatureStates(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	query := req.URL.Query()
+	results := []map[string]interface{}{}
+	for _, record := range s.sortedFeatureStates() {
+		state := record.state
+		if record.identity != nil {
+			continue
+		}
+		if query.Get("environment") != "" && query.Get("environment") != strconv.FormatInt(*state.Environment, 10) {
+			continue
+		}
+		if query.Get("feature") != "" && query.Get("feature") != strconv.FormatInt(state.Feature, 10) {
+			continue
+		}
+		results = append(results, featureStateResponse(record))
+	}
+	writePage(rw, req, results, s.PageSize)
+}
+
+// featureStateResponse renders a feature state the way the list endpoints do, with the value as a plain JSON value
+func featureStateResponse(record *featureStateRecord) map[string]interface{} {
+	state := record.state
+	return map[string]interface{}{
+		"id":                  state.ID,
+		"uuid":                state.UUID,
+		"feature_state_value": rawValue(state.FeatureStateValue),
+		"enabled":             state.Enabled,
+		"feature":             state.Feature,
+		"environment":         state.Environment,
+		"feature_segment":     state.FeatureSegment,
+		"updated_at":          state.UpdatedAt,
+		"identity":            record.identity,
+
+		"multivariate_feature_state_values": mvStateValues(state),
+	}
+}
+
+func mvStateValues(state flagsmithapi.FeatureState) []flagsmithapi.MultivariateFeatureStateValue {
+	if state.MultivariateFeatureStateValues == nil {
+		return []flagsmithapi.MultivariateFeatureStateValue{}
+	}
+	return state.MultivariateFeatureStateValues
+}
+
+func (s *Server) sortedFeatureStates() []*featureStateRecord {
+	records := make([]*featureStateRecord, 0, len(s.featureStates))
+	for _, record := range s.featureStates {
+		records = append(records, record)
+	}
+	sortByID(records, func(r *featureStateRecord) int64 { return r.state.ID })
+	return records
+}
+
+func (s *Server) listTags(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	projectID := int64Param(params, "project_id")
+	tags := []flagsmithapi.Tag{}
+	for _, tag := range s.tags {
+		if *tag.ProjectID == projectID {
+			tags = append(tags, *tag)
+		}
+	}
+	sortByID(tags, func(t flagsmithapi.Tag) int64 { return *t.ID })
+	writeJSON(rw, http.StatusOK, tags)
+}
+
+func (s *Server) getTagByUUID(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	projectID := int64Param(params, "project_id")
+	for _, tag := range s.tags {
+		if tag.UUID == params["uuid"] && *tag.ProjectID == projectID {
+			writeJSON(rw, http.StatusOK, tag)
+			return
+		}
+	}
+	writeNotFound(rw)
+}
+
+func (s *Server) createTag(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	projectID := int64Param(params, "project_id")
+	if _, ok := s.projects[projectID]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	tag := flagsmithapi.Tag{}
+	if !readJSON(rw, req, &tag) {
+		return
+	}
+	id := s.nextID()
+	tag.ID = &id
+	tag.UUID = newUUID()
+	tag.ProjectID = &projectID
+	s.tags[id] = &tag
+	writeJSON(rw, http.StatusCreated, tag)
+}
+
+func (s *Server) updateTag(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	existing, ok := s.tags[int64Param(params, "id")]
+	if !ok || *existing.ProjectID != int64Param(params, "project_id") {
+		writeNotFound(rw)
+		return
+	}
+	tag := flagsmithapi.Tag{}
+	if !readJSON(rw, req, &tag) {
+		return
+	}
+	tag.ID = existing.ID
+	tag.UUID = existing.UUID
+	tag.ProjectID = existing.ProjectID
+	*existing = tag
+	writeJSON(rw, http.StatusOK, existing)
+}
+
+func (s *Server) deleteTag(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	tag, ok := s.tags[int64Param(params, "id")]
+	if !ok || *tag.ProjectID != int64Param(params, "project_id") {
+		writeNotFound(rw)
+		return
+	}
+	delete(s.tags, *tag.ID)
+	for _, feature := range s.features {
+		tags := []int64{}
+		for _, id := range feature.Tags {
+			if id != *tag.ID {
+				tags = append(tags, id)
+			}
+		}
+		feature.Tags = tags
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
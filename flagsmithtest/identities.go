@@ -0,0 +1,270 @@
+package flagsmithtest
+
+import (
+	"net/http"
+	"strings"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// AddIdentityOverride seeds an identity override of a feature for an existing identity and returns the created
+// feature state
+func (s *Server) AddIdentityOverride(environmentKey string, identityID int64, featureID int64, enabled bool,
+	value *flagsmithapi.FeatureStateValue) *flagsmithapi.FeatureState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := s.addIdentityOverride(environmentKey, identityID, featureID, enabled, value).state
+	return &state
+}
+
+// addIdentityOverride must be called with s.mu held
+func (s *Server) addIdentityOverride(environmentKey string, identityID int64, featureID int64, enabled bool,
+	value *flagsmithapi.FeatureStateValue) *featureStateRecord {
+	environmentID := s.environments[environmentKey].ID
+	identity := identityID
+	state := flagsmithapi.FeatureState{
+		ID:                s.nextID(),
+		UUID:              newUUID(),
+		FeatureStateValue: value,
+		Enabled:           enabled,
+		Feature:           featureID,
+		Environment:       &environmentID,
+		UpdatedAt:         s.now(),
+	}
+	record := &featureStateRecord{state: state, identity: &identity}
+	s.featureStates[state.ID] = record
+	return record
+}
+
+func (s *Server) environmentIdentity(params map[string]string) *identityRecord {
+	record, ok := s.identities[int64Param(params, "id")]
+	if !ok || record.environmentKey != params["key"] {
+		return nil
+	}
+	return record
+}
+
+func (s *Server) getIdentity(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	record := s.environmentIdentity(params)
+	if record == nil {
+		writeNotFound(rw)
+		return
+	}
+	writeJSON(rw, http.StatusOK, record.identity)
+}
+
+func (s *Server) listIdentities(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	if _, ok := s.environments[params["key"]]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	query := req.URL.Query().Get("q")
+	identities := []flagsmithapi.Identity{}
+	for _, record := range s.identities {
+		if record.environmentKey == params["key"] && strings.Contains(record.identity.Identifier, query) {
+			identities = append(identities, record.identity)
+		}
+	}
+	sortByID(identities, func(identity flagsmithapi.Identity) int64 { return *identity.ID })
+	writePage(rw, req, identities, s.PageSize)
+}
+
+func (s *Server) createIdentity(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	if _, ok := s.environments[params["key"]]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	identity := flagsmithapi.Identity{}
+	if !readJSON(rw, req, &identity) {
+		return
+	}
+	for _, record := range s.identities {
+		if record.environmentKey == params["key"] && record.identity.Identifier == identity.Identifier {
+			writeError(rw, http.StatusBadRequest, "Identity with identifier already exists in this environment")
+			return
+		}
+	}
+	id := s.nextID()
+	identity.ID = &id
+	s.identities[id] = &identityRecord{environmentKey: params["key"], identity: identity}
+	writeJSON(rw, http.StatusCreated, identity)
+}
+
+func (s *Server) deleteIdentity(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	record := s.environmentIdentity(params)
+	if record == nil {
+		writeNotFound(rw)
+		return
+	}
+	s.deleteIdentityData(*record.identity.ID)
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteIdentityData(identityID int64) {
+	for id, record := range s.traits {
+		if record.identityID == identityID {
+			delete(s.traits, id)
+		}
+	}
+	for id, record := range s.featureStates {
+		if record.identity != nil && *record.identity == identityID {
+			delete(s.featureStates, id)
+		}
+	}
+	delete(s.identities, identityID)
+}
+
+func (s *Server) listTraits(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	record := s.environmentIdentity(params)
+	if record == nil {
+		writeNotFound(rw)
+		return
+	}
+	traits := []flagsmithapi.Trait{}
+	for _, trait := range s.traits {
+		if trait.identityID == *record.identity.ID {
+			traits = append(traits, trait.trait)
+		}
+	}
+	sortByID(traits, func(trait flagsmithapi.Trait) int64 { return trait.ID })
+	writePage(rw, req, traits, s.PageSize)
+}
+
+func (s *Server) createTrait(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	record := s.environmentIdentity(params)
+	if record == nil {
+		writeNotFound(rw)
+		return
+	}
+	trait := flagsmithapi.Trait{}
+	if !readJSON(rw, req, &trait) {
+		return
+	}
+	trait.ID = s.nextID()
+	s.traits[trait.ID] = &traitRecord{identityID: *record.identity.ID, trait: trait}
+	writeJSON(rw, http.StatusCreated, trait)
+}
+
+func (s *Server) updateTrait(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	record := s.environmentIdentity(params)
+	trait, ok := s.traits[int64Param(params, "trait_id")]
+	if record == nil || !ok || trait.identityID != *record.identity.ID {
+		writeNotFound(rw)
+		return
+	}
+	updated := flagsmithapi.Trait{}
+	if !readJSON(rw, req, &updated) {
+		return
+	}
+	updated.ID = trait.trait.ID
+	trait.trait = updated
+	writeJSON(rw, http.StatusOK, updated)
+}
+
+func (s *Server) deleteTrait(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	record := s.environmentIdentity(params)
+	trait, ok := s.traits[int64Param(params, "trait_id")]
+	if record == nil || !ok || trait.identityID != *record.identity.ID {
+		writeNotFound(rw)
+		return
+	}
+	delete(s.traits, trait.trait.ID)
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// identityOverrideBody decodes identity feature states, whose value is sent as a plain JSON value
+type identityOverrideBody struct {
+	Feature           int64       `json:"feature"`
+	Enabled           bool        `json:"enabled"`
+	FeatureStateValue interface{} `json:"feature_state_value"`
+}
+
+func (b *identityOverrideBody) value() *flagsmithapi.FeatureStateValue {
+	switch v := b.FeatureStateValue.(type) {
+	case float64:
+		intValue := int64(v)
+		return &flagsmithapi.FeatureStateValue{Type: "int", IntegerValue: &intValue}
+	case bool:
+		return &flagsmithapi.FeatureStateValue{Type: "bool", BooleanValue: &v}
+	case string:
+		return &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &v}
+	}
+	return &flagsmithapi.FeatureStateValue{Type: "unicode"}
+}
+
+func (s *Server) identityOverride(params map[string]string) (*identityRecord, *featureStateRecord) {
+	identity := s.environmentIdentity(params)
+	if identity == nil {
+		return nil, nil
+	}
+	record, ok := s.featureStates[int64Param(params, "fs_id")]
+	if !ok || record.identity == nil || *record.identity != *identity.identity.ID {
+		return identity, nil
+	}
+	return identity, record
+}
+
+func (s *Server) listIdentityOverrides(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	identity := s.environmentIdentity(params)
+	if identity == nil {
+		writeNotFound(rw)
+		return
+	}
+	results := []map[string]interface{}{}
+	for _, record := range s.sortedFeatureStates() {
+		if record.identity != nil && *record.identity == *identity.identity.ID {
+			results = append(results, featureStateResponse(record))
+		}
+	}
+	writePage(rw, req, results, s.PageSize)
+}
+
+func (s *Server) createIdentityOverride(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	identity := s.environmentIdentity(params)
+	if identity == nil {
+		writeNotFound(rw)
+		return
+	}
+	body := identityOverrideBody{}
+	if !readJSON(rw, req, &body) {
+		return
+	}
+	if _, ok := s.features[body.Feature]; !ok {
+		writeError(rw, http.StatusBadRequest, "Invalid feature")
+		return
+	}
+	for _, record := range s.featureStates {
+		if record.identity != nil && *record.identity == *identity.identity.ID && record.state.Feature == body.Feature {
+			writeError(rw, http.StatusBadRequest, "Feature state already exists for this identity and feature.")
+			return
+		}
+	}
+	record := s.addIdentityOverride(identity.environmentKey, *identity.identity.ID, body.Feature, body.Enabled, body.value())
+	writeJSON(rw, http.StatusCreated, featureStateResponse(record))
+}
+
+func (s *Server) updateIdentityOverride(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	_, record := s.identityOverride(params)
+	if record == nil {
+		writeNotFound(rw)
+		return
+	}
+	body := identityOverrideBody{}
+	if !readJSON(rw, req, &body) {
+		return
+	}
+	record.state.Enabled = body.Enabled
+	record.state.UpdatedAt = s.now()
+	record.state.FeatureStateValue = body.value()
+	writeJSON(rw, http.StatusOK, featureStateResponse(record))
+}
+
+func (s *Server) deleteIdentityOverride(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	_, record := s.identityOverride(params)
+	if record == nil {
+		writeNotFound(rw)
+		return
+	}
+	delete(s.featureStates, record.state.ID)
+	rw.WriteHeader(http.StatusNoContent)
+}
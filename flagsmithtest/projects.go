@@ -0,0 +1,354 @@
+package flagsmithtest
+
+import (
+	"net/http"
+	"strconv"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+func (s *Server) getOrganisationByUUID(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	for _, organisation := range s.organisations {
+		if organisation.UUID == params["uuid"] {
+			writeJSON(rw, http.StatusOK, organisation)
+			return
+		}
+	}
+	writeNotFound(rw)
+}
+
+func (s *Server) listOrganisationUsers(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	organisationID := int64Param(params, "id")
+	if _, ok := s.organisations[organisationID]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	users := s.users[organisationID]
+	if users == nil {
+		users = []flagsmithapi.User{}
+	}
+	writeJSON(rw, http.StatusOK, users)
+}
+
+func (s *Server) listProjects(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	organisationFilter := req.URL.Query().Get("organisation")
+	projects := []flagsmithapi.Project{}
+	for _, project := range s.projects {
+		if organisationFilter == "" || organisationFilter == strconv.FormatInt(project.Organisation, 10) {
+			projects = append(projects, *project)
+		}
+	}
+	sortByID(projects, func(p flagsmithapi.Project) int64 { return p.ID })
+	writeJSON(rw, http.StatusOK, projects)
+}
+
+func (s *Server) getProjectByUUID(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	for _, project := range s.projects {
+		if project.UUID == params["uuid"] {
+			writeJSON(rw, http.StatusOK, project)
+			return
+		}
+	}
+	writeNotFound(rw)
+}
+
+func (s *Server) getProject(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	project, ok := s.projects[int64Param(params, "id")]
+	if !ok {
+		writeNotFound(rw)
+		return
+	}
+	writeJSON(rw, http.StatusOK, project)
+}
+
+func (s *Server) createProject(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	project := flagsmithapi.Project{}
+	if !readJSON(rw, req, &project) {
+		return
+	}
+	if _, ok := s.organisations[project.Organisation]; !ok {
+		writeError(rw, http.StatusBadRequest, "Invalid organisation")
+		return
+	}
+	project.ID = s.nextID()
+	project.UUID = newUUID()
+	s.projects[project.ID] = &project
+	writeJSON(rw, http.StatusCreated, project)
+}
+
+func (s *Server) updateProject(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	existing, ok := s.projects[int64Param(params, "id")]
+	if !ok {
+		writeNotFound(rw)
+		return
+	}
+	project := flagsmithapi.Project{}
+	if !readJSON(rw, req, &project) {
+		return
+	}
+	project.ID = existing.ID
+	project.UUID = existing.UUID
+	*existing = project
+	writeJSON(rw, http.StatusOK, existing)
+}
+
+func (s *Server) deleteProject(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	projectID := int64Param(params, "id")
+	if _, ok := s.projects[projectID]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	for key, environment := range s.environments {
+		if environment.ProjectID == projectID {
+			s.deleteEnvironmentData(key)
+		}
+	}
+	for id, feature := range s.features {
+		if *feature.ProjectID == projectID {
+			s.deleteFeatureData(id)
+		}
+	}
+	for id, segment := range s.segments {
+		if *segment.ProjectID == projectID {
+			s.deleteSegmentData(id)
+		}
+	}
+	for id, tag := range s.tags {
+		if *tag.ProjectID == projectID {
+			delete(s.tags, id)
+		}
+	}
+	delete(s.projects, projectID)
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listEnvironments(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	projectFilter := req.URL.Query().Get("project")
+	environments := []flagsmithapi.Environment{}
+	for _, environment := range s.environments {
+		if projectFilter == "" || projectFilter == strconv.FormatInt(environment.ProjectID, 10) {
+			environments = append(environments, *environment)
+		}
+	}
+	sortByID(environments, func(e flagsmithapi.Environment) int64 { return e.ID })
+	writeJSON(rw, http.StatusOK, environments)
+}
+
+func (s *Server) getEnvironment(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	environment, ok := s.environments[params["key"]]
+	if !ok {
+		writeNotFound(rw)
+		return
+	}
+	writeJSON(rw, http.StatusOK, environment)
+}
+
+func (s *Server) getEnvironmentByUUID(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	for _, environment := range s.environments {
+		if environment.UUID == params["uuid"] {
+			writeJSON(rw, http.StatusOK, environment)
+			return
+		}
+	}
+	writeNotFound(rw)
+}
+
+func (s *Server) createEnvironment(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	environment := flagsmithapi.Environment{}
+	if !readJSON(rw, req, &environment) {
+		return
+	}
+	if _, ok := s.projects[environment.ProjectID]; !ok {
+		writeError(rw, http.StatusBadRequest, "Invalid project")
+		return
+	}
+	environment.ID = s.nextID()
+	environment.UUID = newUUID()
+	environment.APIKey = newKey("")
+	s.environments[environment.APIKey] = &environment
+
+	for _, feature := range s.features {
+		if *feature.ProjectID == environment.ProjectID {
+			s.createEnvironmentFeatureState(&environment, feature)
+		}
+	}
+	writeJSON(rw, http.StatusCreated, environment)
+}
+
+// cloneEnvironment copies the settings, feature states and segment overrides of an environment; identities and
+// server-side keys are not copied
+func (s *Server) cloneEnvironment(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	source, ok := s.environments[params["key"]]
+	if !ok {
+		writeNotFound(rw)
+		return
+	}
+	body := struct {
+		Name string `json:"name"`
+	}{}
+	if !readJSON(rw, req, &body) {
+		return
+	}
+	if body.Name == "" {
+		writeError(rw, http.StatusBadRequest, "name is required")
+		return
+	}
+	environment := *source
+	environment.Name = body.Name
+	environment.ID = s.nextID()
+	environment.UUID = newUUID()
+	environment.APIKey = newKey("")
+	s.environments[environment.APIKey] = &environment
+
+	featureSegments := map[int64]int64{}
+	for _, featureSegment := range s.featureSegments {
+		if featureSegment.Environment != source.ID {
+			continue
+		}
+		cloned := *featureSegment
+		id := s.nextID()
+		cloned.ID = &id
+		cloned.Environment = environment.ID
+		s.featureSegments[id] = &cloned
+		featureSegments[*featureSegment.ID] = id
+	}
+	for _, record := range s.sortedFeatureStates() {
+		if *record.state.Environment != source.ID || record.identity != nil {
+			continue
+		}
+		state := record.state
+		environmentID := environment.ID
+		state.ID = s.nextID()
+		state.UUID = newUUID()
+		state.Environment = &environmentID
+		state.UpdatedAt = s.now()
+		if state.FeatureSegment != nil {
+			featureSegment := featureSegments[*state.FeatureSegment]
+			state.FeatureSegment = &featureSegment
+		}
+		state.MultivariateFeatureStateValues = nil
+		for _, value := range record.state.MultivariateFeatureStateValues {
+			id := s.nextID()
+			value.ID = &id
+			state.MultivariateFeatureStateValues = append(state.MultivariateFeatureStateValues, value)
+		}
+		s.featureStates[state.ID] = &featureStateRecord{state: state}
+	}
+	writeJSON(rw, http.StatusCreated, environment)
+}
+
+func (s *Server) updateEnvironment(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	existing, ok := s.environments[params["key"]]
+	if !ok {
+		writeNotFound(rw)
+		return
+	}
+	environment := flagsmithapi.Environment{}
+	if !readJSON(rw, req, &environment) {
+		return
+	}
+	environment.ID = existing.ID
+	environment.UUID = existing.UUID
+	environment.APIKey = existing.APIKey
+	environment.ProjectID = existing.ProjectID
+	*existing = environment
+	writeJSON(rw, http.StatusOK, existing)
+}
+
+func (s *Server) deleteEnvironment(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	if _, ok := s.environments[params["key"]]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	s.deleteEnvironmentData(params["key"])
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteEnvironmentData(environmentKey string) {
+	environment := s.environments[environmentKey]
+	for id, record := range s.featureStates {
+		if *record.state.Environment == environment.ID {
+			delete(s.featureStates, id)
+		}
+	}
+	for id, featureSegment := range s.featureSegments {
+		if featureSegment.Environment == environment.ID {
+			delete(s.featureSegments, id)
+		}
+	}
+	for id, record := range s.identities {
+		if record.environmentKey == environmentKey {
+			s.deleteIdentityData(id)
+		}
+	}
+	for id, record := range s.apiKeys {
+		if record.environmentKey == environmentKey {
+			delete(s.apiKeys, id)
+		}
+	}
+	delete(s.environments, environmentKey)
+}
+
+func (s *Server) environmentByID(environmentID int64) *flagsmithapi.Environment {
+	for _, environment := range s.environments {
+		if environment.ID == environmentID {
+			return environment
+		}
+	}
+	return nil
+}
+
+func (s *Server) listServerSideEnvKeys(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	if _, ok := s.environments[params["key"]]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	keys := []flagsmithapi.ServerSideEnvKey{}
+	for _, record := range s.apiKeys {
+		if record.environmentKey == params["key"] {
+			keys = append(keys, record.key)
+		}
+	}
+	writeJSON(rw, http.StatusOK, keys)
+}
+
+func (s *Server) createServerSideEnvKey(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	if _, ok := s.environments[params["key"]]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	key := flagsmithapi.ServerSideEnvKey{}
+	if !readJSON(rw, req, &key) {
+		return
+	}
+	key.ID = s.nextID()
+	key.Key = newKey("ser.")
+	s.apiKeys[key.ID] = &apiKeyRecord{environmentKey: params["key"], key: key}
+	writeJSON(rw, http.StatusCreated, key)
+}
+
+func (s *Server) updateServerSideEnvKey(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	record, ok := s.apiKeys[int64Param(params, "id")]
+	if !ok || record.environmentKey != params["key"] {
+		writeNotFound(rw)
+		return
+	}
+	key := flagsmithapi.ServerSideEnvKey{}
+	if !readJSON(rw, req, &key) {
+		return
+	}
+	record.key.Active = key.Active
+	record.key.Name = key.Name
+	record.key.ExpiresAt = key.ExpiresAt
+	writeJSON(rw, http.StatusOK, record.key)
+}
+
+func (s *Server) deleteServerSideEnvKey(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	record, ok := s.apiKeys[int64Param(params, "id")]
+	if !ok || record.environmentKey != params["key"] {
+		writeNotFound(rw)
+		return
+	}
+	delete(s.apiKeys, record.key.ID)
+	rw.WriteHeader(http.StatusNoContent)
+}
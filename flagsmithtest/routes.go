@@ -0,0 +1,92 @@
+package flagsmithtest
+
+import (
+	"net/http"
+	"sort"
+)
+
+// registerRoutes registers the API endpoints; routes with literal segments are registered before overlapping routes
+// with parameters
+func (s *Server) registerRoutes() {
+	s.handle(http.MethodGet, "/organisations/get-by-uuid/{uuid}/", s.getOrganisationByUUID)
+	s.handle(http.MethodGet, "/organisations/{id}/users/", s.listOrganisationUsers)
+
+	s.handle(http.MethodGet, "/projects/get-by-uuid/{uuid}/", s.getProjectByUUID)
+	s.handle(http.MethodGet, "/projects/", s.listProjects)
+	s.handle(http.MethodPost, "/projects/", s.createProject)
+	s.handle(http.MethodGet, "/projects/{id}/", s.getProject)
+	s.handle(http.MethodPut, "/projects/{id}/", s.updateProject)
+	s.handle(http.MethodDelete, "/projects/{id}/", s.deleteProject)
+
+	s.handle(http.MethodGet, "/environments/get-by-uuid/{uuid}/", s.getEnvironmentByUUID)
+	s.handle(http.MethodGet, "/environments/", s.listEnvironments)
+	s.handle(http.MethodPost, "/environments/", s.createEnvironment)
+	s.handle(http.MethodGet, "/environments/{key}/", s.getEnvironment)
+	s.handle(http.MethodPut, "/environments/{key}/", s.updateEnvironment)
+	s.handle(http.MethodDelete, "/environments/{key}/", s.deleteEnvironment)
+	s.handle(http.MethodPost, "/environments/{key}/clone/", s.cloneEnvironment)
+	s.handle(http.MethodGet, "/environments/{key}/featurestates/", s.listEnvironmentFeatureStates)
+	s.handle(http.MethodGet, "/environments/{key}/document/", s.getEnvironmentDocument)
+	s.handle(http.MethodPost, "/environments/{key}/create-change-request/", s.createChangeRequest)
+
+	s.handle(http.MethodGet, "/environments/{key}/api-keys/", s.listServerSideEnvKeys)
+	s.handle(http.MethodPost, "/environments/{key}/api-keys/", s.createServerSideEnvKey)
+	s.handle(http.MethodPut, "/environments/{key}/api-keys/{id}/", s.updateServerSideEnvKey)
+	s.handle(http.MethodDelete, "/environments/{key}/api-keys/{id}/", s.deleteServerSideEnvKey)
+
+	s.handle(http.MethodGet, "/environments/{key}/identities/", s.listIdentities)
+	s.handle(http.MethodPost, "/environments/{key}/identities/", s.createIdentity)
+	s.handle(http.MethodGet, "/environments/{key}/identities/{id}/", s.getIdentity)
+	s.handle(http.MethodDelete, "/environments/{key}/identities/{id}/", s.deleteIdentity)
+	s.handle(http.MethodGet, "/environments/{key}/identities/{id}/traits/", s.listTraits)
+	s.handle(http.MethodPost, "/environments/{key}/identities/{id}/traits/", s.createTrait)
+	s.handle(http.MethodPut, "/environments/{key}/identities/{id}/traits/{trait_id}/", s.updateTrait)
+	s.handle(http.MethodDelete, "/environments/{key}/identities/{id}/traits/{trait_id}/", s.deleteTrait)
+	s.handle(http.MethodGet, "/environments/{key}/identities/{id}/featurestates/", s.listIdentityOverrides)
+	s.handle(http.MethodPost, "/environments/{key}/identities/{id}/featurestates/", s.createIdentityOverride)
+	s.handle(http.MethodPut, "/environments/{key}/identities/{id}/featurestates/{fs_id}/", s.updateIdentityOverride)
+	s.handle(http.MethodDelete, "/environments/{key}/identities/{id}/featurestates/{fs_id}/", s.deleteIdentityOverride)
+
+	s.handle(http.MethodGet, "/features/get-by-uuid/{uuid}/", s.getFeatureByUUID)
+	s.handle(http.MethodGet, "/projects/{project_id}/features/", s.listFeatures)
+	s.handle(http.MethodPost, "/projects/{project_id}/features/", s.createFeature)
+	s.handle(http.MethodPut, "/projects/{project_id}/features/{feature_id}/", s.updateFeature)
+	s.handle(http.MethodDelete, "/projects/{project_id}/features/{feature_id}/", s.deleteFeature)
+	s.handle(http.MethodPost, "/projects/{project_id}/features/{feature_id}/add-owners/", s.manageFeatureOwners("user_ids", true))
+	s.handle(http.MethodPost, "/projects/{project_id}/features/{feature_id}/remove-owners/", s.manageFeatureOwners("user_ids", false))
+	s.handle(http.MethodPost, "/projects/{project_id}/features/{feature_id}/add-group-owners/", s.manageFeatureOwners("group_ids", true))
+	s.handle(http.MethodPost, "/projects/{project_id}/features/{feature_id}/remove-group-owners/", s.manageFeatureOwners("group_ids", false))
+
+	s.handle(http.MethodGet, "/multivariate/options/get-by-uuid/{uuid}/", s.getFeatureMVOptionByUUID)
+	s.handle(http.MethodGet, "/projects/{project_id}/features/{feature_id}/mv-options/", s.listFeatureMVOptions)
+	s.handle(http.MethodPost, "/projects/{project_id}/features/{feature_id}/mv-options/", s.createFeatureMVOption)
+	s.handle(http.MethodPut, "/projects/{project_id}/features/{feature_id}/mv-options/{id}/", s.updateFeatureMVOption)
+	s.handle(http.MethodDelete, "/projects/{project_id}/features/{feature_id}/mv-options/{id}/", s.deleteFeatureMVOption)
+
+	s.handle(http.MethodGet, "/features/featurestates/get-by-uuid/{uuid}/", s.getFeatureStateByUUID)
+	s.handle(http.MethodGet, "/features/featurestates/", s.listFeatureStates)
+	s.handle(http.MethodPost, "/features/featurestates/", s.createFeatureState)
+	s.handle(http.MethodPut, "/features/featurestates/{id}/", s.updateFeatureState)
+
+	s.handle(http.MethodGet, "/segments/get-by-uuid/{uuid}/", s.getSegmentByUUID)
+	s.handle(http.MethodGet, "/projects/{project_id}/segments/", s.listSegments)
+	s.handle(http.MethodPost, "/projects/{project_id}/segments/", s.createSegment)
+	s.handle(http.MethodPut, "/projects/{project_id}/segments/{id}/", s.updateSegment)
+	s.handle(http.MethodDelete, "/projects/{project_id}/segments/{id}/", s.deleteSegment)
+
+	s.handle(http.MethodPost, "/features/feature-segments/update-priorities/", s.updateFeatureSegmentPriorities)
+	s.handle(http.MethodGet, "/features/feature-segments/", s.listFeatureSegments)
+	s.handle(http.MethodPost, "/features/feature-segments/", s.createFeatureSegment)
+	s.handle(http.MethodGet, "/features/feature-segments/{id}/", s.getFeatureSegment)
+	s.handle(http.MethodDelete, "/features/feature-segments/{id}/", s.deleteFeatureSegment)
+
+	s.handle(http.MethodGet, "/projects/{project_id}/tags/get-by-uuid/{uuid}/", s.getTagByUUID)
+	s.handle(http.MethodGet, "/projects/{project_id}/tags/", s.listTags)
+	s.handle(http.MethodPost, "/projects/{project_id}/tags/", s.createTag)
+	s.handle(http.MethodPut, "/projects/{project_id}/tags/{id}/", s.updateTag)
+	s.handle(http.MethodDelete, "/projects/{project_id}/tags/{id}/", s.deleteTag)
+}
+
+func sortByID[T any](items []T, id func(T) int64) {
+	sort.Slice(items, func(i, j int) bool { return id(items[i]) < id(items[j]) })
+}
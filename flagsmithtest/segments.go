@@ -0,0 +1,339 @@
+package flagsmithtest
+
+import (
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+func (s *Server) getSegmentByUUID(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	for _, segment := range s.segments {
+		if segment.UUID == params["uuid"] {
+			writeJSON(rw, http.StatusOK, segment)
+			return
+		}
+	}
+	writeNotFound(rw)
+}
+
+func (s *Server) listSegments(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	projectID := int64Param(params, "project_id")
+	if _, ok := s.projects[projectID]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	segments := []flagsmithapi.Segment{}
+	for _, segment := range s.segments {
+		if *segment.ProjectID == projectID {
+			segments = append(segments, *segment)
+		}
+	}
+	sortByID(segments, func(segment flagsmithapi.Segment) int64 { return *segment.ID })
+	writePage(rw, req, segments, s.PageSize)
+}
+
+func (s *Server) createSegment(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	projectID := int64Param(params, "project_id")
+	if _, ok := s.projects[projectID]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	segment := flagsmithapi.Segment{}
+	if !readJSON(rw, req, &segment) {
+		return
+	}
+	id := s.nextID()
+	segment.ID = &id
+	segment.UUID = newUUID()
+	segment.ProjectID = &projectID
+	s.segments[id] = &segment
+	writeJSON(rw, http.StatusCreated, segment)
+}
+
+func (s *Server) updateSegment(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	existing, ok := s.segments[int64Param(params, "id")]
+	if !ok || *existing.ProjectID != int64Param(params, "project_id") {
+		writeNotFound(rw)
+		return
+	}
+	segment := flagsmithapi.Segment{}
+	if !readJSON(rw, req, &segment) {
+		return
+	}
+	segment.ID = existing.ID
+	segment.UUID = existing.UUID
+	segment.ProjectID = existing.ProjectID
+	*existing = segment
+	writeJSON(rw, http.StatusOK, existing)
+}
+
+func (s *Server) deleteSegment(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	segment, ok := s.segments[int64Param(params, "id")]
+	if !ok || *segment.ProjectID != int64Param(params, "project_id") {
+		writeNotFound(rw)
+		return
+	}
+	s.deleteSegmentData(*segment.ID)
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSegmentData(segmentID int64) {
+	for id, featureSegment := range s.featureSegments {
+		if *featureSegment.Segment == segmentID {
+			s.deleteFeatureSegmentData(id)
+		}
+	}
+	delete(s.segments, segmentID)
+}
+
+func (s *Server) listFeatureSegments(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	query := req.URL.Query()
+	featureSegments := []flagsmithapi.FeatureSegment{}
+	for _, featureSegment := range s.featureSegments {
+		if query.Get("environment") != "" && query.Get("environment") != strconv.FormatInt(featureSegment.Environment, 10) {
+			continue
+		}
+		if query.Get("feature") != "" && query.Get("feature") != strconv.FormatInt(featureSegment.Feature, 10) {
+			continue
+		}
+		featureSegments = append(featureSegments, *featureSegment)
+	}
+	// ordered by priority then ID like the API, so that feature segments without a priority keep a stable order
+	sortByID(featureSegments, func(f flagsmithapi.FeatureSegment) int64 { return *f.ID })
+	sort.SliceStable(featureSegments, func(i, j int) bool {
+		return priorityOrLast(featureSegments[i].Priority) < priorityOrLast(featureSegments[j].Priority)
+	})
+	writePage(rw, req, featureSegments, s.PageSize)
+}
+
+func priorityOrLast(priority *int64) int64 {
+	if priority == nil {
+		return math.MaxInt64
+	}
+	return *priority
+}
+
+// SetFeatureSegmentPriority sets the priority of a feature segment, which the API allows to be null
+func (s *Server) SetFeatureSegmentPriority(featureSegmentID int64, priority *int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.featureSegments[featureSegmentID].Priority = priority
+}
+
+func (s *Server) getFeatureSegment(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	featureSegment, ok := s.featureSegments[int64Param(params, "id")]
+	if !ok {
+		writeNotFound(rw)
+		return
+	}
+	writeJSON(rw, http.StatusOK, featureSegment)
+}
+
+func (s *Server) createFeatureSegment(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	featureSegment := flagsmithapi.FeatureSegment{}
+	if !readJSON(rw, req, &featureSegment) {
+		return
+	}
+	if _, ok := s.features[featureSegment.Feature]; !ok {
+		writeError(rw, http.StatusBadRequest, "Invalid feature")
+		return
+	}
+	if featureSegment.Segment == nil || s.segments[*featureSegment.Segment] == nil {
+		writeError(rw, http.StatusBadRequest, "Invalid segment")
+		return
+	}
+	if s.environmentByID(featureSegment.Environment) == nil {
+		writeError(rw, http.StatusBadRequest, "Invalid environment")
+		return
+	}
+	for _, existing := range s.featureSegments {
+		if existing.Feature == featureSegment.Feature && *existing.Segment == *featureSegment.Segment &&
+			existing.Environment == featureSegment.Environment {
+			writeError(rw, http.StatusBadRequest, "Feature segment already exists.")
+			return
+		}
+	}
+	if featureSegment.Priority == nil {
+		priority := int64(0)
+		featureSegment.Priority = &priority
+	}
+	id := s.nextID()
+	featureSegment.ID = &id
+	s.featureSegments[id] = &featureSegment
+	writeJSON(rw, http.StatusCreated, featureSegment)
+}
+
+func (s *Server) updateFeatureSegmentPriorities(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	body := []struct {
+		ID       int64 `json:"id"`
+		Priority int64 `json:"priority"`
+	}{}
+	if !readJSON(rw, req, &body) {
+		return
+	}
+	updated := []flagsmithapi.FeatureSegment{}
+	for _, item := range body {
+		featureSegment, ok := s.featureSegments[item.ID]
+		if !ok {
+			writeError(rw, http.StatusBadRequest, "Invalid feature segment")
+			return
+		}
+		priority := item.Priority
+		featureSegment.Priority = &priority
+		updated = append(updated, *featureSegment)
+	}
+	writeJSON(rw, http.StatusOK, updated)
+}
+
+func (s *Server) deleteFeatureSegment(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	id := int64Param(params, "id")
+	if _, ok := s.featureSegments[id]; !ok {
+		writeNotFound(rw)
+		return
+	}
+	s.deleteFeatureSegmentData(id)
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteFeatureSegmentData(featureSegmentID int64) {
+	for id, record := range s.featureStates {
+		if record.state.FeatureSegment != nil && *record.state.FeatureSegment == featureSegmentID {
+			delete(s.featureStates, id)
+		}
+	}
+	delete(s.featureSegments, featureSegmentID)
+}
+
+// getEnvironmentDocument builds the environment document from the current state of the environment
+func (s *Server) getEnvironmentDocument(rw http.ResponseWriter, req *http.Request, params map[string]string) {
+	environment, ok := s.environments[params["key"]]
+	if !ok {
+		writeNotFound(rw)
+		return
+	}
+	project := s.projects[environment.ProjectID]
+	document := flagsmithapi.EnvironmentDocument{
+		ID:     environment.ID,
+		APIKey: environment.APIKey,
+		Name:   environment.Name,
+		Project: flagsmithapi.DocumentProject{
+			ID:                project.ID,
+			Name:              project.Name,
+			HideDisabledFlags: project.HideDisabledFlags,
+			Segments:          []flagsmithapi.DocumentSegment{},
+		},
+		FeatureStates:     []flagsmithapi.DocumentFeatureState{},
+		IdentityOverrides: []flagsmithapi.DocumentIdentityOverride{},
+	}
+
+	segmentFeatureStates := map[int64][]flagsmithapi.DocumentFeatureState{}
+	identityFeatureStates := map[int64][]flagsmithapi.DocumentFeatureState{}
+	for _, record := range s.sortedFeatureStates() {
+		if *record.state.Environment != environment.ID {
+			continue
+		}
+		documentState := s.documentFeatureState(&record.state)
+		switch {
+		case record.identity != nil:
+			identityFeatureStates[*record.identity] = append(identityFeatureStates[*record.identity], documentState)
+		case record.state.FeatureSegment != nil:
+			featureSegment := s.featureSegments[*record.state.FeatureSegment]
+			documentState.FeatureSegment = &flagsmithapi.DocumentFeatureSegment{Priority: featureSegment.Priority}
+			segmentFeatureStates[*featureSegment.Segment] = append(segmentFeatureStates[*featureSegment.Segment], documentState)
+		default:
+			document.FeatureStates = append(document.FeatureStates, documentState)
+		}
+	}
+
+	segments := []*flagsmithapi.Segment{}
+	for _, segment := range s.segments {
+		if *segment.ProjectID == project.ID {
+			segments = append(segments, segment)
+		}
+	}
+	sortByID(segments, func(segment *flagsmithapi.Segment) int64 { return *segment.ID })
+	for _, segment := range segments {
+		documentSegment := flagsmithapi.DocumentSegment{
+			ID:            *segment.ID,
+			Name:          segment.Name,
+			Rules:         documentRules(segment.Rules),
+			FeatureStates: segmentFeatureStates[*segment.ID],
+		}
+		if documentSegment.FeatureStates == nil {
+			documentSegment.FeatureStates = []flagsmithapi.DocumentFeatureState{}
+		}
+		document.Project.Segments = append(document.Project.Segments, documentSegment)
+	}
+
+	for identityID, states := range identityFeatureStates {
+		record := s.identities[identityID]
+		document.IdentityOverrides = append(document.IdentityOverrides, flagsmithapi.DocumentIdentityOverride{
+			Identifier:       record.identity.Identifier,
+			IdentityFeatures: states,
+		})
+	}
+	writeJSON(rw, http.StatusOK, document)
+}
+
+func (s *Server) documentFeatureState(state *flagsmithapi.FeatureState) flagsmithapi.DocumentFeatureState {
+	feature := s.features[state.Feature]
+	id := state.ID
+	documentState := flagsmithapi.DocumentFeatureState{
+		DjangoID:                       &id,
+		FeatureStateUUID:               state.UUID,
+		Feature:                        flagsmithapi.DocumentFeature{ID: *feature.ID, Name: feature.Name, Type: *feature.Type},
+		Enabled:                        state.Enabled,
+		Value:                          rawValue(state.FeatureStateValue),
+		MultivariateFeatureStateValues: []flagsmithapi.DocumentMultivariateStateValue{},
+	}
+	for _, value := range state.MultivariateFeatureStateValues {
+		option := s.mvOptions[value.MultivariateFeatureOption]
+		optionID := option.ID
+		documentState.MultivariateFeatureStateValues = append(documentState.MultivariateFeatureStateValues,
+			flagsmithapi.DocumentMultivariateStateValue{
+				ID:                   value.ID,
+				MVFSValueUUID:        option.UUID,
+				PercentageAllocation: value.PercentageAllocation,
+				MultivariateFeatureOption: flagsmithapi.DocumentMultivariateOption{
+					ID:    &optionID,
+					Value: mvOptionValue(*option),
+				},
+			})
+	}
+	return documentState
+}
+
+func mvOptionValue(option flagsmithapi.FeatureMultivariateOption) interface{} {
+	switch {
+	case option.IntegerValue != nil:
+		return *option.IntegerValue
+	case option.BooleanValue != nil:
+		return *option.BooleanValue
+	case option.StringValue != nil:
+		return *option.StringValue
+	}
+	return nil
+}
+
+func documentRules(rules []flagsmithapi.Rule) []flagsmithapi.DocumentRule {
+	result := []flagsmithapi.DocumentRule{}
+	for _, rule := range rules {
+		documentRule := flagsmithapi.DocumentRule{
+			Type:       rule.Type,
+			Rules:      documentRules(rule.Rules),
+			Conditions: []flagsmithapi.DocumentCondition{},
+		}
+		for _, condition := range rule.Conditions {
+			documentRule.Conditions = append(documentRule.Conditions, flagsmithapi.DocumentCondition{
+				Operator: condition.Operator,
+				Property: condition.Property,
+				Value:    condition.Value,
+			})
+		}
+		result = append(result, documentRule)
+	}
+	return result
+}
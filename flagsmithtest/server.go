@@ -0,0 +1,432 @@
+// Package flagsmithtest provides an in-memory fake of the Flagsmith Admin API that a flagsmithapi.Client can be
+// pointed at in tests.
+package flagsmithtest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// MasterAPIKey is the key the fake server accepts in the `Authorization: Api-Key <key>` header
+const MasterAPIKey = "flagsmithtest_master_api_key"
+
+// UserToken is the user API token the fake server accepts in the `Authorization: Token <token>` header
+const UserToken = "flagsmithtest_user_token"
+
+const apiPrefix = "/api/v1"
+
+// DefaultPageSize is the initial PageSize of a Server
+const DefaultPageSize = 100
+
+// Request is a request received by the fake server
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Fault makes the fake server delay or fail matching requests
+type Fault struct {
+	// Method and Path restrict the fault to matching requests; empty values match every request. Path is matched
+	// as a prefix of the path without the `/api/v1` prefix, i.e: "/projects/"
+	Method string
+	Path   string
+
+	// Latency is added before the request is handled
+	Latency time.Duration
+	// StatusCode, if set, is returned instead of handling the request
+	StatusCode int
+	// Times limits how many requests the fault applies to; zero means every matching request
+	Times int
+}
+
+func (f *Fault) matches(method, path string) bool {
+	return (f.Method == "" || f.Method == method) && strings.HasPrefix(path, f.Path)
+}
+
+type handlerFunc func(rw http.ResponseWriter, req *http.Request, params map[string]string)
+
+type route struct {
+	method  string
+	pattern []string
+	handler handlerFunc
+}
+
+// Server is a stateful in-memory fake of the Flagsmith Admin API
+type Server struct {
+	// URL is the base API URL(including `/api/v1`) to pass to flagsmithapi.NewClient
+	URL string
+	// PageSize is the number of results returned per page by paginated endpoints
+	PageSize int
+	// Clock returns the time used for the created and updated timestamps of features and feature states
+	Clock func() time.Time
+
+	server *httptest.Server
+	routes []route
+
+	mu       sync.Mutex
+	lastID   int64
+	requests []Request
+	faults   []*Fault
+
+	organisations   map[int64]*flagsmithapi.Organisation
+	users           map[int64][]flagsmithapi.User
+	projects        map[int64]*flagsmithapi.Project
+	environments    map[string]*flagsmithapi.Environment
+	features        map[int64]*flagsmithapi.Feature
+	mvOptions       map[int64]*flagsmithapi.FeatureMultivariateOption
+	featureStates   map[int64]*featureStateRecord
+	segments        map[int64]*flagsmithapi.Segment
+	featureSegments map[int64]*flagsmithapi.FeatureSegment
+	tags            map[int64]*flagsmithapi.Tag
+	identities      map[int64]*identityRecord
+	traits          map[int64]*traitRecord
+	apiKeys         map[int64]*apiKeyRecord
+	changeRequests  map[int64]*flagsmithapi.ChangeRequest
+}
+
+type featureStateRecord struct {
+	state    flagsmithapi.FeatureState
+	identity *int64
+}
+
+type identityRecord struct {
+	environmentKey string
+	identity       flagsmithapi.Identity
+}
+
+type traitRecord struct {
+	identityID int64
+	trait      flagsmithapi.Trait
+}
+
+type apiKeyRecord struct {
+	environmentKey string
+	key            flagsmithapi.ServerSideEnvKey
+}
+
+// NewServer starts a fake server with a single organisation(see Organisation). Close must be called when done.
+func NewServer() *Server {
+	s := &Server{
+		PageSize:        DefaultPageSize,
+		Clock:           time.Now,
+		organisations:   map[int64]*flagsmithapi.Organisation{},
+		users:           map[int64][]flagsmithapi.User{},
+		projects:        map[int64]*flagsmithapi.Project{},
+		environments:    map[string]*flagsmithapi.Environment{},
+		features:        map[int64]*flagsmithapi.Feature{},
+		mvOptions:       map[int64]*flagsmithapi.FeatureMultivariateOption{},
+		featureStates:   map[int64]*featureStateRecord{},
+		segments:        map[int64]*flagsmithapi.Segment{},
+		featureSegments: map[int64]*flagsmithapi.FeatureSegment{},
+		tags:            map[int64]*flagsmithapi.Tag{},
+		identities:      map[int64]*identityRecord{},
+		traits:          map[int64]*traitRecord{},
+		apiKeys:         map[int64]*apiKeyRecord{},
+		changeRequests:  map[int64]*flagsmithapi.ChangeRequest{},
+	}
+	s.AddOrganisation(&flagsmithapi.Organisation{Name: "Test Organisation"})
+	s.registerRoutes()
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL + apiPrefix
+	return s
+}
+
+// Client returns a flagsmithapi.Client configured to talk to the fake server
+func (s *Server) Client(opts ...flagsmithapi.Option) *flagsmithapi.Client {
+	return flagsmithapi.NewClient(MasterAPIKey, s.URL, opts...)
+}
+
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Organisation returns the organisation created by NewServer
+func (s *Server) Organisation() *flagsmithapi.Organisation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	organisation := *s.organisations[1]
+	return &organisation
+}
+
+// AddOrganisation seeds an organisation, setting its ID and UUID
+func (s *Server) AddOrganisation(organisation *flagsmithapi.Organisation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	organisation.ID = s.nextID()
+	organisation.UUID = newUUID()
+	stored := *organisation
+	s.organisations[organisation.ID] = &stored
+}
+
+// AddUser seeds a user of the organisation, setting its ID and UUID
+func (s *Server) AddUser(organisationID int64, user *flagsmithapi.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	user.ID = s.nextID()
+	user.UUID = newUUID()
+	s.users[organisationID] = append(s.users[organisationID], *user)
+}
+
+// InjectFault adds a fault; faults are evaluated in the order they were added
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the requests received so far, in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	requests := make([]Request, len(s.requests))
+	copy(requests, s.requests)
+	return requests
+}
+
+func (s *Server) ResetRequests() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+// RequestCount returns the number of requests received for method and path(without the `/api/v1` prefix)
+func (s *Server) RequestCount(method, path string) int {
+	count := 0
+	for _, req := range s.Requests() {
+		if req.Method == method && req.Path == path {
+			count++
+		}
+	}
+	return count
+}
+
+// AssertRequested fails the test if no request was received for method and path
+func (s *Server) AssertRequested(t testing.TB, method, path string) bool {
+	t.Helper()
+	if s.RequestCount(method, path) == 0 {
+		t.Errorf("flagsmithtest: expected a %s request to %s, got: %s", method, path, s.describeRequests())
+		return false
+	}
+	return true
+}
+
+// AssertNotRequested fails the test if a request was received for method and path
+func (s *Server) AssertNotRequested(t testing.TB, method, path string) bool {
+	t.Helper()
+	if count := s.RequestCount(method, path); count != 0 {
+		t.Errorf("flagsmithtest: expected no %s request to %s, got %d", method, path, count)
+		return false
+	}
+	return true
+}
+
+func (s *Server) describeRequests() string {
+	requests := s.Requests()
+	if len(requests) == 0 {
+		return "no requests"
+	}
+	descriptions := make([]string, 0, len(requests))
+	for _, req := range requests {
+		descriptions = append(descriptions, req.Method+" "+req.Path)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func (s *Server) serveHTTP(rw http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	path := strings.TrimPrefix(req.URL.Path, apiPrefix)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: req.Method,
+		Path:   path,
+		Query:  req.URL.Query(),
+		Header: req.Header.Clone(),
+		Body:   body,
+	})
+	fault := s.matchFault(req.Method, path)
+	s.mu.Unlock()
+
+	if fault != nil {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-req.Context().Done():
+				return
+			}
+		}
+		if fault.StatusCode != 0 {
+			if fault.StatusCode == http.StatusTooManyRequests {
+				rw.Header().Set("Retry-After", "1")
+			}
+			writeError(rw, fault.StatusCode, http.StatusText(fault.StatusCode))
+			return
+		}
+	}
+
+	if auth := req.Header.Get("Authorization"); auth != "Api-Key "+MasterAPIKey && auth != "Token "+UserToken {
+		writeError(rw, http.StatusUnauthorized, "Invalid API Key")
+		return
+	}
+
+	segments := splitPath(path)
+	for _, r := range s.routes {
+		if r.method != req.Method {
+			continue
+		}
+		if params, ok := matchPattern(r.pattern, segments); ok {
+			if req.Method == http.MethodGet {
+				rw = newConditionalResponseWriter(rw, req)
+				defer rw.(*conditionalResponseWriter).flush()
+			}
+			s.mu.Lock()
+			r.handler(rw, req, params)
+			s.mu.Unlock()
+			return
+		}
+	}
+	writeError(rw, http.StatusNotFound, "Not found.")
+}
+
+// matchFault returns the first matching fault and consumes one of its uses. Must be called with s.mu held.
+func (s *Server) matchFault(method, path string) *Fault {
+	for i, fault := range s.faults {
+		if !fault.matches(method, path) {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+func (s *Server) handle(method, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, route{method: method, pattern: splitPath(pattern), handler: handler})
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func matchPattern(pattern, segments []string) (map[string]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for i, part := range pattern {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			params[part[1:len(part)-1]] = segments[i]
+		} else if part != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func (s *Server) now() *time.Time {
+	now := s.Clock().UTC()
+	return &now
+}
+
+func (s *Server) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func newKey(prefix string) string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return prefix + hex.EncodeToString(b)
+}
+
+func int64Param(params map[string]string, name string) int64 {
+	value, _ := strconv.ParseInt(params[name], 10, 64)
+	return value
+}
+
+func readJSON(rw http.ResponseWriter, req *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(req.Body).Decode(v); err != nil {
+		writeError(rw, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(rw http.ResponseWriter, status int, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	_ = json.NewEncoder(rw).Encode(v)
+}
+
+func writeError(rw http.ResponseWriter, status int, detail string) {
+	writeJSON(rw, status, map[string]string{"detail": detail})
+}
+
+func writeNotFound(rw http.ResponseWriter) {
+	writeError(rw, http.StatusNotFound, "Not found.")
+}
+
+// writePage writes the page of results selected by the `page` query parameter, linking to the next page if any
+func writePage[T any](rw http.ResponseWriter, req *http.Request, results []T, pageSize int) {
+	page, err := strconv.Atoi(req.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	start := (page - 1) * pageSize
+	if start > len(results) {
+		start = len(results)
+	}
+	end := start + pageSize
+	var next *string
+	if end < len(results) {
+		query := req.URL.Query()
+		query.Set("page", strconv.Itoa(page+1))
+		link := fmt.Sprintf("http://%s%s?%s", req.Host, req.URL.Path, query.Encode())
+		next = &link
+	} else {
+		end = len(results)
+	}
+	writeJSON(rw, http.StatusOK, map[string]interface{}{
+		"count":    len(results),
+		"next":     next,
+		"previous": nil,
+		"results":  results[start:end],
+	})
+}
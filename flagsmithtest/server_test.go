@@ -0,0 +1,379 @@
+package flagsmithtest_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+func setupProject(t *testing.T, client *flagsmithapi.Client, organisationID int64) (*flagsmithapi.Project, *flagsmithapi.Environment) {
+	project := flagsmithapi.Project{Name: "project-1", Organisation: organisationID}
+	require.NoError(t, client.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&environment))
+	return &project, &environment
+}
+
+func TestFeatureLifecycle(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project, environment := setupProject(t, client, server.Organisation().ID)
+
+	// When
+	feature := flagsmithapi.Feature{Name: "test_feature", ProjectUUID: project.UUID, InitialValue: "10", DefaultEnabled: true}
+	require.NoError(t, client.CreateFeature(&feature))
+
+	// Then
+	fetched, err := client.GetFeature(feature.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, "test_feature", fetched.Name)
+	assert.Equal(t, project.UUID, fetched.ProjectUUID)
+	assert.Equal(t, "STANDARD", *fetched.Type)
+
+	featureState, err := client.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+	require.NoError(t, err)
+	assert.True(t, featureState.Enabled)
+	assert.Equal(t, int64(10), *featureState.FeatureStateValue.IntegerValue)
+
+	// When
+	value := "updated"
+	featureState.Enabled = false
+	featureState.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value}
+	require.NoError(t, client.UpdateFeatureState(featureState, false))
+
+	// Then
+	updated, err := client.GetFeatureState(featureState.UUID)
+	require.NoError(t, err)
+	assert.False(t, updated.Enabled)
+	assert.Equal(t, "updated", *updated.FeatureStateValue.StringValue)
+
+	// When
+	require.NoError(t, client.AddFeatureOwners(&feature, []int64{1, 2}))
+	require.NoError(t, client.RemoveFeatureOwners(&feature, []int64{1}))
+
+	// Then
+	fetched, err = client.GetFeature(feature.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, *fetched.Owners)
+
+	// When
+	require.NoError(t, client.DeleteFeature(project.ID, *feature.ID))
+
+	// Then
+	_, err = client.GetFeature(feature.UUID)
+	assert.IsType(t, flagsmithapi.FeatureNotFoundError{}, err)
+	_, err = client.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+	assert.Error(t, err)
+}
+
+func TestEnvironmentCreatedAfterFeatureGetsFeatureStates(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project, _ := setupProject(t, client, server.Organisation().ID)
+	feature := flagsmithapi.Feature{Name: "test_feature", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&feature))
+
+	// When
+	production := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&production))
+
+	// Then
+	featureState, err := client.GetEnvironmentFeatureState(production.APIKey, *feature.ID)
+	require.NoError(t, err)
+	assert.False(t, featureState.Enabled)
+}
+
+func TestSegmentOverrideAndDocument(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project, environment := setupProject(t, client, server.Organisation().ID)
+	feature := flagsmithapi.Feature{Name: "test_feature", ProjectID: &project.ID, InitialValue: "default"}
+	require.NoError(t, client.CreateFeature(&feature))
+	segment := flagsmithapi.Segment{
+		Name:      "pro_users",
+		ProjectID: &project.ID,
+		Rules:     []flagsmithapi.Rule{flagsmithapi.All(flagsmithapi.Any(flagsmithapi.Eq("plan", "pro")))},
+	}
+	require.NoError(t, client.CreateSegment(&segment))
+
+	// When
+	value := "pro"
+	priority := int64(0)
+	override := flagsmithapi.FeatureState{
+		Feature:           *feature.ID,
+		Enabled:           true,
+		EnvironmentKey:    environment.APIKey,
+		Segment:           segment.ID,
+		SegmentPriority:   &priority,
+		FeatureStateValue: &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value},
+	}
+	require.NoError(t, client.CreateSegmentOverride(&override))
+
+	// Then
+	fetched, err := client.GetFeatureState(override.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, *segment.ID, *fetched.Segment)
+	assert.Equal(t, int64(0), *fetched.SegmentPriority)
+
+	document, err := client.GetEnvironmentDocument(environment.APIKey)
+	require.NoError(t, err)
+	traits := []flagsmithapi.Trait{{TraitKey: "plan", StringValue: &value}}
+	evaluation, err := document.EvaluateFeature("test_feature", "user_1", traits)
+	require.NoError(t, err)
+	assert.Equal(t, flagsmithapi.EvaluationReasonSegmentOverride, evaluation.Reason)
+	assert.Equal(t, "pro", *evaluation.FeatureState.FeatureStateValue.StringValue)
+
+	// When
+	require.NoError(t, client.DeleteSegment(project.ID, *segment.ID))
+
+	// Then
+	_, err = client.GetFeatureState(override.UUID)
+	assert.IsType(t, flagsmithapi.FeatureStateNotFoundError{}, err)
+}
+
+func TestIdentitiesTraitsAndKeys(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	_, environment := setupProject(t, client, server.Organisation().ID)
+
+	// When
+	identity := flagsmithapi.Identity{Identifier: "user_1"}
+	require.NoError(t, client.CreateIdentity(environment.APIKey, &identity))
+	value := "pro"
+	trait := flagsmithapi.Trait{TraitKey: "plan", ValueType: "unicode", StringValue: &value}
+	require.NoError(t, client.CreateTrait(environment.APIKey, *identity.ID, &trait))
+	key := flagsmithapi.ServerSideEnvKey{Name: "server key", Active: true}
+	require.NoError(t, client.CreateServerSideEnvKey(environment.APIKey, &key))
+
+	// Then
+	traits, err := client.GetTraits(environment.APIKey, *identity.ID)
+	require.NoError(t, err)
+	assert.Len(t, traits, 1)
+	assert.Equal(t, "pro", *traits[0].StringValue)
+
+	keys, err := client.GetServerSideEnvKeys(environment.APIKey)
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+	assert.Contains(t, keys[0].Key, "ser.")
+
+	// When
+	require.NoError(t, client.DeleteEnvironment(environment.APIKey))
+
+	// Then
+	_, err = client.GetIdentity(environment.APIKey, *identity.ID)
+	assert.Error(t, err)
+}
+
+func TestTags(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project, _ := setupProject(t, client, server.Organisation().ID)
+
+	// When
+	tag := flagsmithapi.Tag{Name: "backend", Colour: "#FF0000", ProjectUUID: project.UUID}
+	require.NoError(t, client.CreateTag(&tag))
+
+	// Then
+	fetched, err := client.GetTag(project.UUID, tag.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, "backend", fetched.Name)
+}
+
+func TestFaultInjection(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project, _ := setupProject(t, client, server.Organisation().ID)
+	server.InjectFault(flagsmithtest.Fault{Method: http.MethodGet, Path: "/projects/", StatusCode: http.StatusTooManyRequests, Times: 1})
+
+	// When
+	_, firstErr := client.GetProjectByID(project.ID)
+	_, secondErr := client.GetProjectByID(project.ID)
+
+	// Then
+	assert.ErrorContains(t, firstErr, "Too Many Requests")
+	assert.NoError(t, secondErr)
+
+	// Given
+	server.InjectFault(flagsmithtest.Fault{Latency: 50 * time.Millisecond, StatusCode: http.StatusInternalServerError})
+
+	// When
+	start := time.Now()
+	_, err := client.GetProjectByID(project.ID)
+
+	// Then
+	assert.Error(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// When
+	server.ClearFaults()
+	_, err = client.GetProjectByID(project.ID)
+
+	// Then
+	assert.NoError(t, err)
+}
+
+func TestRequestAssertions(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project, _ := setupProject(t, client, server.Organisation().ID)
+	server.ResetRequests()
+
+	// When
+	_, err := client.GetProject(project.UUID)
+
+	// Then
+	require.NoError(t, err)
+	server.AssertRequested(t, http.MethodGet, fmt.Sprintf("/projects/get-by-uuid/%s/", project.UUID))
+	server.AssertNotRequested(t, http.MethodGet, fmt.Sprintf("/projects/%d/", project.ID))
+	assert.Len(t, server.Requests(), 1)
+	assert.Equal(t, "Api-Key "+flagsmithtest.MasterAPIKey, server.Requests()[0].Header.Get("Authorization"))
+}
+
+func TestRejectsInvalidAPIKey(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := flagsmithapi.NewClient("wrong_key", server.URL)
+
+	// When
+	_, err := client.GetProjectByID(1)
+
+	// Then
+	assert.Error(t, err)
+}
+
+func TestListsFollowPagination(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	server.PageSize = 2
+	client := server.Client()
+	project, environment := setupProject(t, client, server.Organisation().ID)
+	for i := 0; i < 5; i++ {
+		feature := flagsmithapi.Feature{Name: fmt.Sprintf("feature_%d", i), ProjectID: &project.ID}
+		require.NoError(t, client.CreateFeature(&feature))
+	}
+	server.ResetRequests()
+
+	// When
+	features, err := client.ListFeatures(project.ID)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, features, 5)
+	assert.Equal(t, "feature_4", features[4].Name)
+	assert.Equal(t, project.UUID, features[4].ProjectUUID)
+	assert.Equal(t, 3, server.RequestCount(http.MethodGet, fmt.Sprintf("/projects/%d/features/", project.ID)))
+
+	// When
+	featureStates, err := client.ListEnvironmentFeatureStates(environment.APIKey)
+
+	// Then
+	require.NoError(t, err)
+	assert.Len(t, featureStates, 5)
+	assert.Equal(t, environment.APIKey, featureStates[0].EnvironmentKey)
+}
+
+func TestListSegmentOverrides(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project, environment := setupProject(t, client, server.Organisation().ID)
+	feature := flagsmithapi.Feature{Name: "test_feature", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&feature))
+	segmentIDs := []int64{}
+	for i, name := range []string{"segment_a", "segment_b"} {
+		segment := flagsmithapi.Segment{Name: name, ProjectID: &project.ID, Rules: []flagsmithapi.Rule{flagsmithapi.All()}}
+		require.NoError(t, client.CreateSegment(&segment))
+		segmentIDs = append(segmentIDs, *segment.ID)
+		priority := int64(1 - i)
+		override := flagsmithapi.FeatureState{
+			Feature: *feature.ID, EnvironmentKey: environment.APIKey, Segment: segment.ID, SegmentPriority: &priority,
+		}
+		require.NoError(t, client.CreateSegmentOverride(&override))
+	}
+
+	// When
+	overrides, err := client.ListSegmentOverrides(environment.APIKey, *feature.ID)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, overrides, 2)
+	assert.Equal(t, segmentIDs[1], *overrides[0].Segment)
+	assert.Equal(t, int64(0), *overrides[0].SegmentPriority)
+	assert.Equal(t, segmentIDs[0], *overrides[1].Segment)
+	assert.Equal(t, environment.APIKey, overrides[1].EnvironmentKey)
+
+	projects, err := client.ListProjects(server.Organisation().ID)
+	require.NoError(t, err)
+	assert.Len(t, projects, 1)
+	segments, err := client.ListSegments(project.ID)
+	require.NoError(t, err)
+	assert.Len(t, segments, 2)
+}
+
+func TestCloneEnvironment(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project, environment := setupProject(t, client, server.Organisation().ID)
+	feature := flagsmithapi.Feature{Name: "test_feature", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&feature))
+	featureState, err := client.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+	require.NoError(t, err)
+	featureState.Enabled = true
+	require.NoError(t, client.UpdateFeatureState(featureState, false))
+	segment := flagsmithapi.Segment{Name: "segment_a", ProjectID: &project.ID, Rules: []flagsmithapi.Rule{flagsmithapi.All()}}
+	require.NoError(t, client.CreateSegment(&segment))
+	priority := int64(0)
+	override := flagsmithapi.FeatureState{
+		Feature: *feature.ID, EnvironmentKey: environment.APIKey, Segment: segment.ID, SegmentPriority: &priority,
+	}
+	require.NoError(t, client.CreateSegmentOverride(&override))
+
+	// When
+	key := flagsmithapi.ServerSideEnvKey{Name: "preview", Active: true}
+	cloned, err := client.CloneEnvironment(environment.APIKey, "preview", &key)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "preview", cloned.Name)
+	assert.NotEqual(t, environment.APIKey, cloned.APIKey)
+	assert.NotEmpty(t, key.Key)
+	keys, err := client.GetServerSideEnvKeys(cloned.APIKey)
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+
+	clonedState, err := client.GetEnvironmentFeatureState(cloned.APIKey, *feature.ID)
+	require.NoError(t, err)
+	assert.True(t, clonedState.Enabled)
+	assert.NotEqual(t, featureState.ID, clonedState.ID)
+	overrides, err := client.ListSegmentOverrides(cloned.APIKey, *feature.ID)
+	require.NoError(t, err)
+	require.Len(t, overrides, 1)
+	assert.Equal(t, *segment.ID, *overrides[0].Segment)
+	assert.NotEqual(t, *override.FeatureSegment, *overrides[0].FeatureSegment)
+}
@@ -0,0 +1,109 @@
+// Package httpcache caches the responses to the GET requests of a flagsmithapi.Client and revalidates them with
+// conditional requests(If-None-Match and If-Modified-Since), so that polling unchanged resources does not download
+// them again.
+//
+//	client := flagsmithapi.NewClient(masterAPIKey, baseURL, flagsmithapi.WithHTTPCache(httpcache.NewMemoryStore(1000)))
+//
+// Every request still reaches the server: cached responses are only served when the server answers 304 Not Modified,
+// so a cached response is never stale. Responses without an ETag or Last-Modified header are not cached.
+package httpcache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+)
+
+// Entry is a cached response
+type Entry struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+}
+
+// Store holds cached responses by key. Stores must be safe for concurrent use; a store that fails to read or write
+// an entry treats it as a miss.
+type Store interface {
+	Get(key string) (*Entry, bool)
+	Set(key string, entry *Entry)
+	Delete(key string)
+}
+
+// Transport is an http.RoundTripper that serves the responses to GET requests from the store when the server reports
+// they have not been modified
+type Transport struct {
+	Store Store
+	// Inner is the transport used to send requests; defaults to http.DefaultTransport
+	Inner http.RoundTripper
+}
+
+// New returns a Transport caching responses in store
+func New(store Store, inner http.RoundTripper) *Transport {
+	return &Transport{Store: store, Inner: inner}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	inner := t.Inner
+	if inner == nil {
+		inner = http.DefaultTransport
+	}
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return inner.RoundTrip(req)
+	}
+
+	key := Key(req)
+	cached, ok := t.Store.Get(key)
+	if ok {
+		req = req.Clone(req.Context())
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := cached.Header.Get("Last-Modified"); lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := inner.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotModified && ok:
+		resp.Body.Close()
+		return cached.response(req), nil
+	case resp.StatusCode == http.StatusOK && (resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""):
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		t.Store.Set(key, &Entry{StatusCode: resp.StatusCode, Header: resp.Header.Clone(), Body: body})
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		t.Store.Delete(key)
+	}
+	return resp, nil
+}
+
+func (e *Entry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.StatusCode),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// Key returns the cache key of the request: its URL and a hash of its Authorization header, so that clients with
+// different credentials sharing a store do not see each other's responses
+func Key(req *http.Request) string {
+	credentials := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(credentials[:8]) + " " + req.URL.String()
+}
@@ -0,0 +1,107 @@
+package httpcache_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+	"github.com/Flagsmith/flagsmith-go-api-client/httpcache"
+)
+
+// countingTransport counts the 304 responses
+type countingTransport struct {
+	notModified atomic.Int64
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusNotModified {
+		t.notModified.Add(1)
+	}
+	return resp, err
+}
+
+func TestClientRevalidatesCachedResponses(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	store := httpcache.NewMemoryStore(100)
+	transport := &countingTransport{}
+	client := server.Client(flagsmithapi.WithTransport(transport), flagsmithapi.WithHTTPCache(store))
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&feature))
+
+	// When
+	first, err := client.GetFeature(feature.UUID)
+	require.NoError(t, err)
+	server.ResetRequests()
+	second, err := client.GetFeature(feature.UUID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	for _, request := range requests {
+		assert.NotEmpty(t, request.Header.Get("If-None-Match"), request.Path)
+	}
+	assert.Equal(t, int64(2), transport.notModified.Load())
+	assert.Equal(t, 2, store.Len())
+
+	// When
+	description := "New checkout flow"
+	feature.Description = &description
+	require.NoError(t, client.UpdateFeature(&feature))
+	updated, err := client.GetFeature(feature.UUID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, "New checkout flow", *updated.Description)
+	// the project is unchanged
+	assert.Equal(t, int64(3), transport.notModified.Load())
+}
+
+func TestTransportDoesNotCacheResponsesWithoutValidators(t *testing.T) {
+	// Given
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		assert.Empty(t, req.Header.Get("If-None-Match"))
+		_, _ = io.WriteString(rw, "{}")
+	}))
+	defer server.Close()
+	store := httpcache.NewMemoryStore(0)
+	client := http.Client{Transport: httpcache.New(store, nil)}
+
+	// When
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// Then
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 0, store.Len())
+}
+
+func TestKeyDependsOnCredentials(t *testing.T) {
+	// Given
+	first, _ := http.NewRequest(http.MethodGet, "https://api.flagsmith.com/api/v1/projects/1/", nil)
+	first.Header.Set("Authorization", "Api-Key first")
+	second := first.Clone(first.Context())
+	second.Header.Set("Authorization", "Api-Key second")
+
+	// Then
+	assert.NotEqual(t, httpcache.Key(first), httpcache.Key(second))
+	assert.Equal(t, httpcache.Key(first), httpcache.Key(first.Clone(first.Context())))
+}
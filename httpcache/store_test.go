@@ -0,0 +1,66 @@
+package httpcache_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Flagsmith/flagsmith-go-api-client/httpcache"
+)
+
+func entry(body string) *httpcache.Entry {
+	return &httpcache.Entry{StatusCode: http.StatusOK, Header: http.Header{"Etag": {`"1"`}}, Body: []byte(body)}
+}
+
+func TestMemoryStoreEvictsLeastRecentlyUsed(t *testing.T) {
+	// Given
+	store := httpcache.NewMemoryStore(2)
+	store.Set("a", entry("a"))
+	store.Set("b", entry("b"))
+	_, ok := store.Get("a")
+	require.True(t, ok)
+
+	// When
+	store.Set("c", entry("c"))
+
+	// Then
+	assert.Equal(t, 2, store.Len())
+	_, ok = store.Get("b")
+	assert.False(t, ok)
+	cached, ok := store.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, entry("a"), cached)
+
+	// When
+	store.Delete("a")
+
+	// Then
+	_, ok = store.Get("a")
+	assert.False(t, ok)
+}
+
+func TestDiskStore(t *testing.T) {
+	// Given
+	dir := t.TempDir()
+	store, err := httpcache.NewDiskStore(dir)
+	require.NoError(t, err)
+
+	// When
+	store.Set("key", entry("body"))
+	reopened, err := httpcache.NewDiskStore(dir)
+	require.NoError(t, err)
+	cached, ok := reopened.Get("key")
+
+	// Then
+	assert.True(t, ok)
+	assert.Equal(t, entry("body"), cached)
+
+	// When
+	reopened.Delete("key")
+
+	// Then
+	_, ok = store.Get("key")
+	assert.False(t, ok)
+}
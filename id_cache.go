@@ -0,0 +1,194 @@
+package flagsmithapi
+
+import (
+	"sync"
+	"time"
+)
+
+// idCache maps between the IDs, UUIDs and API keys of projects, environments and features so that methods that only
+// need to resolve one into another(i.e: GetFeature, CreateSegmentOverride) skip the lookup request. It also holds the
+// projects themselves for the feature naming policy of WithFeatureNameValidation. It is enabled with WithIDCache; a
+// nil *idCache is a cache that never hits.
+type idCache struct {
+	projects       *ttlCache[int64, Project]
+	projectIDs     *ttlCache[string, int64]
+	environmentIDs *ttlCache[string, int64]
+	// features maps feature UUIDs to the IDs of the feature and its project
+	features *ttlCache[string, featureIDs]
+}
+
+type featureIDs struct {
+	featureID int64
+	projectID int64
+}
+
+func newIDCache(ttl time.Duration) *idCache {
+	return &idCache{
+		projects:       newTTLCache[int64, Project](ttl),
+		projectIDs:     newTTLCache[string, int64](ttl),
+		environmentIDs: newTTLCache[string, int64](ttl),
+		features:       newTTLCache[string, featureIDs](ttl),
+	}
+}
+
+func (c *idCache) projectID(projectUUID string) (int64, bool) {
+	if c == nil {
+		return 0, false
+	}
+	return c.projectIDs.get(projectUUID)
+}
+
+// project returns a copy of the cached project, so that callers can not change the cached one
+func (c *idCache) project(projectID int64) (*Project, bool) {
+	if c == nil {
+		return nil, false
+	}
+	project, ok := c.projects.get(projectID)
+	return &project, ok
+}
+
+func (c *idCache) setProject(project *Project) {
+	if c == nil || project.UUID == "" {
+		return
+	}
+	c.projectIDs.set(project.UUID, project.ID)
+	c.projects.set(project.ID, *project)
+}
+
+func (c *idCache) deleteProject(projectID int64) {
+	if c == nil {
+		return
+	}
+	c.projects.delete(projectID)
+	c.projectIDs.deleteWhere(func(_ string, id int64) bool { return id == projectID })
+	c.features.deleteWhere(func(_ string, ids featureIDs) bool { return ids.projectID == projectID })
+}
+
+func (c *idCache) environmentID(environmentKey string) (int64, bool) {
+	if c == nil {
+		return 0, false
+	}
+	return c.environmentIDs.get(environmentKey)
+}
+
+func (c *idCache) setEnvironment(environment *Environment) {
+	if c == nil || environment.APIKey == "" {
+		return
+	}
+	c.environmentIDs.set(environment.APIKey, environment.ID)
+}
+
+func (c *idCache) deleteEnvironment(environmentKey string) {
+	if c == nil {
+		return
+	}
+	c.environmentIDs.delete(environmentKey)
+}
+
+func (c *idCache) feature(featureUUID string) (featureIDs, bool) {
+	if c == nil {
+		return featureIDs{}, false
+	}
+	return c.features.get(featureUUID)
+}
+
+func (c *idCache) setFeature(feature *Feature) {
+	if c == nil || feature.UUID == "" || feature.ID == nil || feature.ProjectID == nil {
+		return
+	}
+	c.features.set(feature.UUID, featureIDs{featureID: *feature.ID, projectID: *feature.ProjectID})
+}
+
+func (c *idCache) deleteFeature(featureID int64) {
+	if c == nil {
+		return
+	}
+	c.features.deleteWhere(func(_ string, ids featureIDs) bool { return ids.featureID == featureID })
+}
+
+func (c *Client) getProjectUUID(projectID int64) (string, error) {
+	project, err := c.getProject(projectID)
+	if err != nil {
+		return "", err
+	}
+	return project.UUID, nil
+}
+
+func (c *Client) getProject(projectID int64) (*Project, error) {
+	if project, ok := c.ids.project(projectID); ok {
+		return project, nil
+	}
+	return c.GetProjectByID(projectID)
+}
+
+func (c *Client) getFeatureIDs(featureUUID string) (featureIDs, error) {
+	if ids, ok := c.ids.feature(featureUUID); ok {
+		return ids, nil
+	}
+	feature, err := c.GetFeature(featureUUID)
+	if err != nil {
+		return featureIDs{}, err
+	}
+	return featureIDs{featureID: *feature.ID, projectID: *feature.ProjectID}, nil
+}
+
+func (c *Client) getEnvironmentID(environmentKey string) (int64, error) {
+	if environmentID, ok := c.ids.environmentID(environmentKey); ok {
+		return environmentID, nil
+	}
+	environment, err := c.GetEnvironment(environmentKey)
+	if err != nil {
+		return 0, err
+	}
+	return environment.ID, nil
+}
+
+// ttlCache is a map safe for concurrent use whose entries expire ttl after they are set
+type ttlCache[K comparable, V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[K]ttlEntry[V]
+}
+
+type ttlEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+func newTTLCache[K comparable, V any](ttl time.Duration) *ttlCache[K, V] {
+	return &ttlCache[K, V]{ttl: ttl, entries: map[K]ttlEntry[V]{}}
+}
+
+func (c *ttlCache[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+func (c *ttlCache[K, V]) set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = ttlEntry[V]{value: value, expires: time.Now().Add(c.ttl)}
+}
+
+func (c *ttlCache[K, V]) delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+func (c *ttlCache[K, V]) deleteWhere(match func(key K, value V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.entries {
+		if match(key, entry.value) {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package flagsmithapi_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+func TestIDCacheSkipsLookups(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	setupClient := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, setupClient.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, setupClient.CreateEnvironment(&environment))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID}
+	require.NoError(t, setupClient.CreateFeature(&feature))
+	value := "blue"
+	option := flagsmithapi.FeatureMultivariateOption{
+		Type: "unicode", StringValue: &value, FeatureID: feature.ID, ProjectID: &project.ID,
+	}
+	require.NoError(t, setupClient.CreateFeatureMVOption(&option))
+
+	uncachedTransport := &countingTransport{requests: map[string]int{}}
+	uncached := server.Client(flagsmithapi.WithTransport(uncachedTransport))
+	cachedTransport := &countingTransport{requests: map[string]int{}}
+	cached := server.Client(flagsmithapi.WithIDCache(time.Minute), flagsmithapi.WithTransport(cachedTransport))
+	getProject := fmt.Sprintf("GET /api/v1/projects/%d/", project.ID)
+	getProjectByUUID := fmt.Sprintf("GET /api/v1/projects/get-by-uuid/%s/", project.UUID)
+	getFeature := fmt.Sprintf("GET /api/v1/features/get-by-uuid/%s/", feature.UUID)
+	getEnvironment := fmt.Sprintf("GET /api/v1/environments/%s/", environment.APIKey)
+
+	// When
+	for _, client := range []*flagsmithapi.Client{uncached, cached} {
+		for i := 0; i < 2; i++ {
+			_, err := client.GetFeature(feature.UUID)
+			require.NoError(t, err)
+			_, err = client.GetFeatureMVOption(feature.UUID, option.UUID)
+			require.NoError(t, err)
+			_, err = client.ListSegmentOverrides(environment.APIKey, *feature.ID)
+			require.NoError(t, err)
+			tag := flagsmithapi.Tag{Name: fmt.Sprintf("tag-%d", i), Colour: "#000000", ProjectUUID: project.UUID}
+			require.NoError(t, client.CreateTag(&tag))
+		}
+	}
+
+	// Then
+	assert.Equal(t, 4, uncachedTransport.count(getProject))
+	assert.Equal(t, 2, uncachedTransport.count(getProjectByUUID))
+	assert.Equal(t, 4, uncachedTransport.count(getFeature))
+	assert.Equal(t, 2, uncachedTransport.count(getEnvironment))
+
+	assert.Equal(t, 1, cachedTransport.count(getProject))
+	// the project fetched by ID also resolves the UUID
+	assert.Equal(t, 0, cachedTransport.count(getProjectByUUID))
+	assert.Equal(t, 2, cachedTransport.count(getFeature))
+	assert.Equal(t, 1, cachedTransport.count(getEnvironment))
+}
+
+func TestIDCacheInvalidation(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	setupClient := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, setupClient.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, setupClient.CreateEnvironment(&environment))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID}
+	require.NoError(t, setupClient.CreateFeature(&feature))
+
+	transport := &countingTransport{requests: map[string]int{}}
+	client := server.Client(flagsmithapi.WithIDCache(time.Minute), flagsmithapi.WithTransport(transport))
+	getEnvironment := fmt.Sprintf("GET /api/v1/environments/%s/", environment.APIKey)
+	_, err := client.ListSegmentOverrides(environment.APIKey, *feature.ID)
+	require.NoError(t, err)
+
+	// When
+	require.NoError(t, client.UpdateEnvironment(&environment))
+	_, err = client.ListSegmentOverrides(environment.APIKey, *feature.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, transport.count(getEnvironment))
+
+	// When
+	require.NoError(t, client.DeleteEnvironment(environment.APIKey))
+	_, err = client.ListSegmentOverrides(environment.APIKey, *feature.ID)
+
+	// Then
+	assert.Error(t, err)
+	assert.Equal(t, 3, transport.count(getEnvironment))
+}
+
+func TestIDCacheExpiry(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	setupClient := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, setupClient.CreateProject(&project))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID}
+	require.NoError(t, setupClient.CreateFeature(&feature))
+
+	transport := &countingTransport{requests: map[string]int{}}
+	client := server.Client(flagsmithapi.WithIDCache(20*time.Millisecond), flagsmithapi.WithTransport(transport))
+	getProject := fmt.Sprintf("GET /api/v1/projects/%d/", project.ID)
+	_, err := client.GetFeature(feature.UUID)
+	require.NoError(t, err)
+
+	// When
+	time.Sleep(40 * time.Millisecond)
+	_, err = client.GetFeature(feature.UUID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 2, transport.count(getProject))
+}
+
+func TestIDCacheResolvesSegmentProjectsAndEnvironmentsFetchedByUUID(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	setupClient := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, setupClient.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, setupClient.CreateEnvironment(&environment))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID}
+	require.NoError(t, setupClient.CreateFeature(&feature))
+	segment := flagsmithapi.Segment{Name: "beta", ProjectID: &project.ID, Rules: []flagsmithapi.Rule{flagsmithapi.All()}}
+	require.NoError(t, setupClient.CreateSegment(&segment))
+
+	transport := &countingTransport{requests: map[string]int{}}
+	client := server.Client(flagsmithapi.WithIDCache(time.Minute), flagsmithapi.WithTransport(transport))
+	getProject := fmt.Sprintf("GET /api/v1/projects/%d/", project.ID)
+	getEnvironment := fmt.Sprintf("GET /api/v1/environments/%s/", environment.APIKey)
+
+	// When
+	fetched, err := client.GetSegment(segment.UUID)
+	require.NoError(t, err)
+	segments, err := client.ListSegments(project.ID)
+	require.NoError(t, err)
+	_, err = client.GetEnvironmentByUUID(environment.UUID)
+	require.NoError(t, err)
+	_, err = client.ListSegmentOverrides(environment.APIKey, *feature.ID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, project.UUID, fetched.ProjectUUID)
+	assert.Equal(t, project.UUID, segments[0].ProjectUUID)
+	assert.Equal(t, 1, transport.count(getProject))
+	assert.Equal(t, 0, transport.count(getEnvironment))
+}
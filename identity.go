@@ -4,10 +4,37 @@ import (
 	"fmt"
 )
 
+// identityOverrideBody is the request body of the identity feature state endpoints, which take the value as a plain
+// JSON value
+type identityOverrideBody struct {
+	Feature           int64       `json:"feature"`
+	Enabled           bool        `json:"enabled"`
+	FeatureStateValue interface{} `json:"feature_state_value"`
+}
+
+func newIdentityOverrideBody(featureState *FeatureState) identityOverrideBody {
+	body := identityOverrideBody{Feature: featureState.Feature, Enabled: featureState.Enabled}
+	if value := featureState.FeatureStateValue; value != nil {
+		switch {
+		case value.IntegerValue != nil:
+			body.FeatureStateValue = *value.IntegerValue
+		case value.BooleanValue != nil:
+			body.FeatureStateValue = *value.BooleanValue
+		case value.StringValue != nil:
+			body.FeatureStateValue = *value.StringValue
+		}
+	}
+	return body
+}
+
 func (c *Client) GetIdentity(environmentKey string, identityID int64) (*Identity, error) {
+	c, op := c.startOperation("GetIdentity", "identity", "get",
+		environmentKeyAttribute(environmentKey), idAttribute("identity", identityID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/identities/%d/", c.baseURL, environmentKey, identityID)
 	identity := Identity{}
-	resp, err := c.client.R().SetResult(&identity).Get(url)
+	resp, err := c.request().SetResult(&identity).Get(url)
 	if err != nil {
 		return nil, err
 	}
@@ -17,10 +44,26 @@ func (c *Client) GetIdentity(environmentKey string, identityID int64) (*Identity
 	}
 	return &identity, nil
 }
+
+// List the identities of the environment whose identifier contains query; an empty query lists every identity
+func (c *Client) ListIdentities(environmentKey string, query string) ([]Identity, error) {
+	c, op := c.startOperation("ListIdentities", "identity", "list", environmentKeyAttribute(environmentKey))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/environments/%s/identities/", c.baseURL, environmentKey)
+	queryParams := map[string]string{}
+	if query != "" {
+		queryParams["q"] = query
+	}
+	return listResults[Identity](c, url, queryParams)
+}
 func (c *Client) CreateIdentity(environmentKey string, identity *Identity) error {
+	c, op := c.startOperation("CreateIdentity", "identity", "create", environmentKeyAttribute(environmentKey))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/identities/", c.baseURL, environmentKey)
 
-	resp, err := c.client.R().SetBody(identity).SetResult(&identity).Post(url)
+	resp, err := c.request().SetBody(identity).SetResult(&identity).Post(url)
 	if err != nil {
 		return err
 	}
@@ -31,8 +74,12 @@ func (c *Client) CreateIdentity(environmentKey string, identity *Identity) error
 
 }
 func (c *Client) DeleteIdentity(environmentKey string, identityID int64) error {
+	c, op := c.startOperation("DeleteIdentity", "identity", "delete",
+		environmentKeyAttribute(environmentKey), idAttribute("identity", identityID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/identities/%d/", c.baseURL, environmentKey, identityID)
-	resp, err := c.client.R().Delete(url)
+	resp, err := c.request().Delete(url)
 	if err != nil {
 		return err
 	}
@@ -44,11 +91,15 @@ func (c *Client) DeleteIdentity(environmentKey string, identityID int64) error {
 
 }
 func (c *Client) GetTraits(environmentKey string, identityID int64) ([]Trait, error) {
+	c, op := c.startOperation("GetTraits", "trait", "list",
+		environmentKeyAttribute(environmentKey), idAttribute("identity", identityID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/identities/%d/traits/", c.baseURL, environmentKey, identityID)
 	result := struct {
 		Traits []Trait `json:"results"`
 	}{}
-	resp, err := c.client.R().SetResult(&result).Get(url)
+	resp, err := c.request().SetResult(&result).Get(url)
 	if err != nil {
 		return nil, err
 	}
@@ -60,9 +111,13 @@ func (c *Client) GetTraits(environmentKey string, identityID int64) ([]Trait, er
 }
 
 func (c *Client) CreateTrait(environmentKey string, identityID int64, trait *Trait) error {
+	c, op := c.startOperation("CreateTrait", "trait", "create",
+		environmentKeyAttribute(environmentKey), idAttribute("identity", identityID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/identities/%d/traits/", c.baseURL, environmentKey, identityID)
 
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetBody(trait).
 		SetResult(&trait).
 		Post(url)
@@ -79,9 +134,13 @@ func (c *Client) CreateTrait(environmentKey string, identityID int64, trait *Tra
 }
 
 func (c *Client) UpdateTrait(environmentKey string, identityID int64, trait *Trait) error {
+	c, op := c.startOperation("UpdateTrait", "trait", "update",
+		environmentKeyAttribute(environmentKey), idAttribute("identity", identityID), idAttribute("trait", trait.ID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/identities/%d/traits/%d/", c.baseURL, environmentKey, identityID, trait.ID)
 
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetBody(trait).
 		Put(url)
 
@@ -97,9 +156,13 @@ func (c *Client) UpdateTrait(environmentKey string, identityID int64, trait *Tra
 }
 
 func (c *Client) DeleteTrait(environmentKey string, identityID int64, traitID int64) error {
+	c, op := c.startOperation("DeleteTrait", "trait", "delete",
+		environmentKeyAttribute(environmentKey), idAttribute("identity", identityID), idAttribute("trait", traitID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/environments/%s/identities/%d/traits/%d/", c.baseURL, environmentKey, identityID, traitID)
 
-	resp, err := c.client.R().
+	resp, err := c.request().
 		Delete(url)
 
 	if err != nil {
@@ -112,3 +175,79 @@ func (c *Client) DeleteTrait(environmentKey string, identityID int64, traitID in
 
 	return nil
 }
+
+// List the feature states overridden for the identity
+func (c *Client) ListIdentityOverrides(environmentKey string, identityID int64) ([]FeatureState, error) {
+	c, op := c.startOperation("ListIdentityOverrides", "identity_override", "list",
+		environmentKeyAttribute(environmentKey), idAttribute("identity", identityID))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/environments/%s/identities/%d/featurestates/", c.baseURL, environmentKey, identityID)
+	featureStates, err := listResults[FeatureState](c, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range featureStates {
+		featureStates[i].EnvironmentKey = environmentKey
+	}
+	return featureStates, nil
+}
+
+func (c *Client) CreateIdentityOverride(environmentKey string, identityID int64, featureState *FeatureState) error {
+	c, op := c.startOperation("CreateIdentityOverride", "identity_override", "create",
+		environmentKeyAttribute(environmentKey), idAttribute("identity", identityID), idAttribute("feature", featureState.Feature))
+	defer op.end()
+
+	if err := c.validateFeatureStateValue(environmentKey, featureState); err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/environments/%s/identities/%d/featurestates/", c.baseURL, environmentKey, identityID)
+	resp, err := c.request().SetBody(newIdentityOverrideBody(featureState)).SetResult(featureState).Post(url)
+	if err != nil {
+		return err
+	}
+
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmithapi: Error creating identity override: %s", resp)
+	}
+	featureState.EnvironmentKey = environmentKey
+	return nil
+}
+
+func (c *Client) UpdateIdentityOverride(environmentKey string, identityID int64, featureState *FeatureState) error {
+	c, op := c.startOperation("UpdateIdentityOverride", "identity_override", "update",
+		environmentKeyAttribute(environmentKey), idAttribute("identity", identityID), idAttribute("feature_state", featureState.ID))
+	defer op.end()
+
+	if err := c.validateFeatureStateValue(environmentKey, featureState); err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/environments/%s/identities/%d/featurestates/%d/", c.baseURL, environmentKey, identityID, featureState.ID)
+	resp, err := c.request().SetBody(newIdentityOverrideBody(featureState)).SetResult(featureState).Put(url)
+	if err != nil {
+		return err
+	}
+
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmithapi: Error updating identity override: %s", resp)
+	}
+	featureState.EnvironmentKey = environmentKey
+	return nil
+}
+
+func (c *Client) DeleteIdentityOverride(environmentKey string, identityID, featureStateID int64) error {
+	c, op := c.startOperation("DeleteIdentityOverride", "identity_override", "delete",
+		environmentKeyAttribute(environmentKey), idAttribute("identity", identityID), idAttribute("feature_state", featureStateID))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/environments/%s/identities/%d/featurestates/%d/", c.baseURL, environmentKey, identityID, featureStateID)
+	resp, err := c.request().Delete(url)
+	if err != nil {
+		return err
+	}
+
+	if !resp.IsSuccess() {
+		return fmt.Errorf("flagsmithapi: Error deleting identity override: %s", resp)
+	}
+	return nil
+}
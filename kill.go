@@ -0,0 +1,180 @@
+package flagsmithapi
+
+import (
+	"errors"
+	"fmt"
+)
+
+type KillOptions struct {
+	// IdentityOverrides also disables the identity overrides of the feature, which takes a request per identity of
+	// every environment
+	IdentityOverrides bool
+}
+
+// FeatureSnapshot records the feature states disabled by KillFeature, from which RestoreFeature restores them. It can
+// be saved as JSON, i.e: to restore the feature from another process once an incident is over.
+type FeatureSnapshot struct {
+	Feature      SnapshotFeature       `json:"feature"`
+	Environments []EnvironmentSnapshot `json:"environments"`
+}
+
+// SnapshotFeature identifies the feature of a snapshot
+type SnapshotFeature struct {
+	ID   int64  `json:"id"`
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+// EnvironmentSnapshot holds the feature states of an environment as they were before KillFeature disabled them; states
+// that were already disabled are not included
+type EnvironmentSnapshot struct {
+	EnvironmentKey string `json:"environment_key"`
+	// Default is the environment default feature state; nil if it was disabled
+	Default           *FeatureState              `json:"default,omitempty"`
+	SegmentOverrides  []FeatureState             `json:"segment_overrides,omitempty"`
+	IdentityOverrides []IdentityOverrideSnapshot `json:"identity_overrides,omitempty"`
+}
+
+type IdentityOverrideSnapshot struct {
+	IdentityID   int64        `json:"identity_id"`
+	FeatureState FeatureState `json:"feature_state"`
+}
+
+// KillFeature disables the feature in every environment of its project: the environment default, the segment
+// overrides and, with opts.IdentityOverrides, the identity overrides. Environments are processed concurrently.
+//
+// The returned snapshot records the states that were disabled, including when an error stops the kill part way, so
+// that it can be passed to RestoreFeature.
+func (c *Client) KillFeature(featureUUID string, opts KillOptions) (*FeatureSnapshot, error) {
+	c, op := c.startOperation("KillFeature", "feature", "kill", uuidAttribute("feature", featureUUID))
+	defer op.end()
+
+	feature, err := c.GetFeature(featureUUID)
+	if err != nil {
+		return nil, err
+	}
+	environments, err := c.ListEnvironments(*feature.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]EnvironmentSnapshot, len(environments))
+	errs := make([]error, len(environments))
+	forEachConcurrently(len(environments), DefaultBulkConcurrency, func(i int) {
+		snapshots[i], errs[i] = c.killFeatureInEnvironment(*feature.ID, environments[i].APIKey, opts)
+		if errs[i] != nil {
+			errs[i] = fmt.Errorf("flagsmithapi: Error disabling feature '%s' in environment '%s': %w",
+				feature.Name, environments[i].Name, errs[i])
+		}
+	})
+
+	snapshot := &FeatureSnapshot{
+		Feature:      SnapshotFeature{ID: *feature.ID, UUID: feature.UUID, Name: feature.Name},
+		Environments: []EnvironmentSnapshot{},
+	}
+	for _, environment := range snapshots {
+		if environment.Default != nil || len(environment.SegmentOverrides) > 0 || len(environment.IdentityOverrides) > 0 {
+			snapshot.Environments = append(snapshot.Environments, environment)
+		}
+	}
+	return snapshot, errors.Join(errs...)
+}
+
+func (c *Client) killFeatureInEnvironment(featureID int64, environmentKey string, opts KillOptions) (EnvironmentSnapshot, error) {
+	snapshot := EnvironmentSnapshot{EnvironmentKey: environmentKey}
+	featureState, err := c.GetEnvironmentFeatureState(environmentKey, featureID)
+	if err != nil {
+		return snapshot, err
+	}
+	if featureState.Enabled {
+		previous := *featureState
+		previous.EnvironmentKey = environmentKey
+		featureState.Enabled = false
+		if err := c.UpdateFeatureState(featureState, false); err != nil {
+			return snapshot, err
+		}
+		snapshot.Default = &previous
+	}
+
+	overrides, err := c.ListSegmentOverrides(environmentKey, featureID)
+	if err != nil {
+		return snapshot, err
+	}
+	for _, override := range overrides {
+		if !override.Enabled {
+			continue
+		}
+		previous := override
+		override.Enabled = false
+		if err := c.UpdateFeatureState(&override, false); err != nil {
+			return snapshot, err
+		}
+		snapshot.SegmentOverrides = append(snapshot.SegmentOverrides, previous)
+	}
+
+	if !opts.IdentityOverrides {
+		return snapshot, nil
+	}
+	identities, err := c.ListIdentities(environmentKey, "")
+	if err != nil {
+		return snapshot, err
+	}
+	for _, identity := range identities {
+		identityID := *identity.ID
+		overrides, err := c.ListIdentityOverrides(environmentKey, identityID)
+		if err != nil {
+			return snapshot, err
+		}
+		for _, override := range overrides {
+			if override.Feature != featureID || !override.Enabled {
+				continue
+			}
+			previous := override
+			override.Enabled = false
+			if err := c.UpdateIdentityOverride(environmentKey, identityID, &override); err != nil {
+				return snapshot, err
+			}
+			snapshot.IdentityOverrides = append(snapshot.IdentityOverrides,
+				IdentityOverrideSnapshot{IdentityID: identityID, FeatureState: previous})
+		}
+	}
+	return snapshot, nil
+}
+
+// RestoreFeature puts back the feature states recorded by KillFeature. Every state is restored even if some fail; the
+// errors are joined.
+func (c *Client) RestoreFeature(snapshot *FeatureSnapshot) error {
+	c, op := c.startOperation("RestoreFeature", "feature", "restore", uuidAttribute("feature", snapshot.Feature.UUID))
+	defer op.end()
+
+	errs := make([]error, len(snapshot.Environments))
+	forEachConcurrently(len(snapshot.Environments), DefaultBulkConcurrency, func(i int) {
+		errs[i] = c.restoreFeatureInEnvironment(&snapshot.Environments[i])
+	})
+	return errors.Join(errs...)
+}
+
+func (c *Client) restoreFeatureInEnvironment(snapshot *EnvironmentSnapshot) error {
+	errs := []error{}
+	if snapshot.Default != nil {
+		featureState := *snapshot.Default
+		if err := c.UpdateFeatureState(&featureState, false); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, override := range snapshot.SegmentOverrides {
+		if err := c.UpdateFeatureState(&override, false); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, override := range snapshot.IdentityOverrides {
+		featureState := override.FeatureState
+		if err := c.UpdateIdentityOverride(snapshot.EnvironmentKey, override.IdentityID, &featureState); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("flagsmithapi: Error restoring environment '%s': %w", snapshot.EnvironmentKey, err)
+	}
+	return nil
+}
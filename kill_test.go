@@ -0,0 +1,147 @@
+package flagsmithapi_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+func TestKillAndRestoreFeature(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	environments := []flagsmithapi.Environment{}
+	for _, name := range []string{"Staging", "Production"} {
+		environment := flagsmithapi.Environment{Name: name, ProjectID: project.ID}
+		require.NoError(t, client.CreateEnvironment(&environment))
+		environments = append(environments, environment)
+	}
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&feature))
+	owner := flagsmithapi.User{Email: "owner@example.com"}
+	server.AddUser(server.Organisation().ID, &owner)
+	require.NoError(t, client.AddFeatureOwners(&feature, []int64{owner.ID}))
+	otherFeature := flagsmithapi.Feature{Name: "other", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&otherFeature))
+
+	for _, environment := range environments {
+		featureState, err := client.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+		require.NoError(t, err)
+		featureState.Enabled = true
+		require.NoError(t, client.UpdateFeatureState(featureState, false))
+	}
+	overrides := []flagsmithapi.FeatureState{}
+	for i, enabled := range []bool{true, false} {
+		segment := flagsmithapi.Segment{Name: "segment", ProjectID: &project.ID, Rules: []flagsmithapi.Rule{flagsmithapi.All()}}
+		require.NoError(t, client.CreateSegment(&segment))
+		priority := int64(i)
+		override := flagsmithapi.FeatureState{
+			Feature: *feature.ID, EnvironmentKey: environments[1].APIKey, Segment: segment.ID, SegmentPriority: &priority, Enabled: enabled,
+		}
+		require.NoError(t, client.CreateSegmentOverride(&override))
+		overrides = append(overrides, override)
+	}
+	identity := flagsmithapi.Identity{Identifier: "user-1"}
+	require.NoError(t, client.CreateIdentity(environments[0].APIKey, &identity))
+	for _, featureID := range []int64{*feature.ID, *otherFeature.ID} {
+		override := flagsmithapi.FeatureState{Feature: featureID, Enabled: true}
+		require.NoError(t, client.CreateIdentityOverride(environments[0].APIKey, *identity.ID, &override))
+	}
+
+	enabledStates := func() map[string]bool {
+		states := map[string]bool{}
+		for _, environment := range environments {
+			featureState, err := client.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+			require.NoError(t, err)
+			states[environment.Name] = featureState.Enabled
+			segmentOverrides, err := client.ListSegmentOverrides(environment.APIKey, *feature.ID)
+			require.NoError(t, err)
+			for _, override := range segmentOverrides {
+				states[environment.Name+"/segment/"+strconv.FormatInt(*override.SegmentPriority, 10)] = override.Enabled
+			}
+		}
+		identityOverrides, err := client.ListIdentityOverrides(environments[0].APIKey, *identity.ID)
+		require.NoError(t, err)
+		for _, override := range identityOverrides {
+			if override.Feature == *feature.ID {
+				states["Staging/identity"] = override.Enabled
+			} else {
+				states["Staging/identity/other"] = override.Enabled
+			}
+		}
+		return states
+	}
+	before := enabledStates()
+
+	// When
+	snapshot, err := client.KillFeature(feature.UUID, flagsmithapi.KillOptions{IdentityOverrides: true})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{
+		"Staging":                false,
+		"Staging/identity":       false,
+		"Staging/identity/other": true,
+		"Production":             false,
+		"Production/segment/0":   false,
+		"Production/segment/1":   false,
+	}, enabledStates())
+	require.Len(t, snapshot.Environments, 2)
+	assert.Equal(t, environments[0].APIKey, snapshot.Environments[0].EnvironmentKey)
+	assert.True(t, snapshot.Environments[0].Default.Enabled)
+	assert.Empty(t, snapshot.Environments[0].SegmentOverrides)
+	require.Len(t, snapshot.Environments[0].IdentityOverrides, 1)
+	assert.Equal(t, *identity.ID, snapshot.Environments[0].IdentityOverrides[0].IdentityID)
+	// the override that was already disabled is not recorded
+	require.Len(t, snapshot.Environments[1].SegmentOverrides, 1)
+	assert.Equal(t, overrides[0].ID, snapshot.Environments[1].SegmentOverrides[0].ID)
+
+	// When
+	data, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+	restored := flagsmithapi.FeatureSnapshot{}
+	require.NoError(t, json.Unmarshal(data, &restored))
+	assert.Equal(t, feature.UUID, restored.Feature.UUID)
+	err = client.RestoreFeature(&restored)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, before, enabledStates())
+}
+
+func TestKillFeatureWithoutIdentityOverrides(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&environment))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&feature))
+	identity := flagsmithapi.Identity{Identifier: "user-1"}
+	require.NoError(t, client.CreateIdentity(environment.APIKey, &identity))
+	override := flagsmithapi.FeatureState{Feature: *feature.ID, Enabled: true}
+	require.NoError(t, client.CreateIdentityOverride(environment.APIKey, *identity.ID, &override))
+
+	// When
+	snapshot, err := client.KillFeature(feature.UUID, flagsmithapi.KillOptions{})
+
+	// Then
+	require.NoError(t, err)
+	// the environment default was already disabled and identity overrides are left alone
+	assert.Empty(t, snapshot.Environments)
+	identityOverrides, err := client.ListIdentityOverrides(environment.APIKey, *identity.ID)
+	require.NoError(t, err)
+	assert.True(t, identityOverrides[0].Enabled)
+}
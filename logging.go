@@ -0,0 +1,221 @@
+package flagsmithapi
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Redacted replaces secrets in the headers, URLs and bodies given to the request hooks
+const Redacted = "[REDACTED]"
+
+// redactedHeaders are the headers carrying credentials
+var redactedHeaders = []string{"Authorization", "X-Environment-Key"}
+
+var (
+	// serverSideKeyPattern matches server-side environment keys, i.e: ser.abc123
+	serverSideKeyPattern = regexp.MustCompile(`\bser\.[A-Za-z0-9_-]+`)
+	// keyFieldPattern matches the key field of the server-side key objects of the api-keys endpoints
+	keyFieldPattern = regexp.MustCompile(`"key"\s*:\s*"[^"]*"`)
+)
+
+// maxLoggedBodySize is the size after which the bodies of error responses are truncated in the logs
+const maxLoggedBodySize = 1024
+
+// RequestInfo describes a request to the request hooks, with its credentials redacted
+type RequestInfo struct {
+	Method string
+	URL    string
+	// Path is the path of the URL, including the path of the base URL, i.e: /api/v1/projects/1/
+	Path   string
+	Header http.Header
+}
+
+// ResponseInfo describes the outcome of a request to the response hooks, with its credentials and server-side keys
+// redacted
+type ResponseInfo struct {
+	Request RequestInfo
+	// StatusCode is 0 when the request failed without a response
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	Duration   time.Duration
+	// Err is the error of a request that failed without a response, or whose response could not be read or decoded
+	Err error
+}
+
+// BeforeRequestHook is called before each request is sent
+type BeforeRequestHook func(info RequestInfo)
+
+// AfterResponseHook is called after each request, whether it succeeded or failed
+type AfterResponseHook func(info ResponseInfo)
+
+// registerHooks sets the resty hooks that authenticate the requests of the client and call its request hooks
+func (c *Client) registerHooks() {
+	// the pre-request hook sees the final request, with the client headers, so the request hooks run after the
+	// credentials are set to see them redacted
+	c.client.SetPreRequestHook(func(_ *resty.Client, req *http.Request) error {
+		if err := c.authenticator.Authenticate(req); err != nil {
+			return err
+		}
+		if len(c.beforeRequestHooks) > 0 {
+			info := requestInfo(req)
+			for _, hook := range c.beforeRequestHooks {
+				hook(info)
+			}
+		}
+		return nil
+	})
+	if len(c.afterResponseHooks) > 0 {
+		c.client.OnSuccess(func(_ *resty.Client, resp *resty.Response) {
+			c.runAfterResponseHooks(resp.Request, resp, nil)
+		})
+		c.client.OnError(func(req *resty.Request, err error) {
+			var resp *resty.Response
+			if responseErr, ok := err.(*resty.ResponseError); ok {
+				resp = responseErr.Response
+				err = responseErr.Err
+			}
+			c.runAfterResponseHooks(req, resp, err)
+		})
+	}
+}
+
+func (c *Client) runAfterResponseHooks(req *resty.Request, resp *resty.Response, err error) {
+	info := ResponseInfo{Err: err}
+	if req.RawRequest != nil {
+		info.Request = requestInfo(req.RawRequest)
+	} else {
+		// the request failed before it was created, i.e: while waiting for a rate limiter
+		info.Request = RequestInfo{Method: req.Method, URL: redactSecrets(req.URL)}
+	}
+	if !req.Time.IsZero() {
+		info.Duration = time.Since(req.Time)
+	}
+	if resp != nil && resp.RawResponse != nil {
+		info.StatusCode = resp.StatusCode()
+		info.Header = redactHeader(resp.Header())
+		info.Body = []byte(redactBody(info.Request.Path, string(resp.Body())))
+		info.Duration = resp.Time()
+	}
+	for _, hook := range c.afterResponseHooks {
+		hook(info)
+	}
+}
+
+func requestInfo(req *http.Request) RequestInfo {
+	return RequestInfo{
+		Method: req.Method,
+		URL:    redactSecrets(req.URL.String()),
+		Path:   redactSecrets(req.URL.Path),
+		Header: redactHeader(req.Header),
+	}
+}
+
+// redactHeader returns a copy of the header with the credentials replaced by Redacted
+func redactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range redactedHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, Redacted)
+		}
+	}
+	return redacted
+}
+
+// redactSecrets replaces the server-side environment keys in s by Redacted
+func redactSecrets(s string) string {
+	return serverSideKeyPattern.ReplaceAllString(s, Redacted)
+}
+
+// redactBody redacts the secrets of the body of a response to the request at path, and the key fields of the
+// responses of the api-keys endpoints
+func redactBody(path, body string) string {
+	if strings.Contains(path, "/api-keys/") {
+		body = keyFieldPattern.ReplaceAllString(body, `"key":"`+Redacted+`"`)
+	}
+	return redactSecrets(body)
+}
+
+// log returns the logger of the client, the default slog logger unless set WithLogger
+func (c *Client) log() *slog.Logger {
+	if c.logger == nil {
+		return slog.Default()
+	}
+	return c.logger
+}
+
+// logRequest logs the requests at the debug level
+func (c *Client) logRequest(info RequestInfo) {
+	c.logger.Debug("flagsmithapi: request", "method", info.Method, "path", info.Path)
+}
+
+// logResponse logs successful responses at the debug level, and failed requests and error responses, with their
+// body, at the warn level
+func (c *Client) logResponse(info ResponseInfo) {
+	attrs := []any{"method", info.Request.Method, "path", info.Request.Path}
+	if info.StatusCode != 0 {
+		attrs = append(attrs, "status", info.StatusCode)
+	}
+	attrs = append(attrs, "duration", info.Duration)
+	switch {
+	case info.Err != nil:
+		c.logger.Warn("flagsmithapi: request failed", append(attrs, "error", info.Err)...)
+	case info.StatusCode >= 400:
+		body := info.Body
+		if len(body) > maxLoggedBodySize {
+			body = append(body[:maxLoggedBodySize:maxLoggedBodySize], "..."...)
+		}
+		c.logger.Warn("flagsmithapi: error response", append(attrs, "body", string(body))...)
+	default:
+		c.logger.Debug("flagsmithapi: response", attrs...)
+	}
+}
+
+// unmarshalJSON decodes the responses of the client, logging the feature states whose value could not be decoded
+func (c *Client) unmarshalJSON(data []byte, v interface{}) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	for _, featureState := range featureStatesWithoutValue(reflect.ValueOf(v), nil) {
+		c.log().Warn("flagsmithapi: Error unmarshalling FeatureStateValue", "feature_state", featureState.UUID,
+			"error", "missing or unsupported feature_state_value")
+	}
+	return nil
+}
+
+var featureStateType = reflect.TypeOf(FeatureState{})
+
+// featureStatesWithoutValue collects the feature states reachable from v through pointers, slices and exported fields
+// whose value is nil, which FeatureState.UnmarshalJSON leaves when the value cannot be decoded
+func featureStatesWithoutValue(v reflect.Value, featureStates []*FeatureState) []*FeatureState {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			featureStates = featureStatesWithoutValue(v.Elem(), featureStates)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			featureStates = featureStatesWithoutValue(v.Index(i), featureStates)
+		}
+	case reflect.Struct:
+		if v.Type() == featureStateType {
+			if v.CanAddr() && v.Addr().Interface().(*FeatureState).FeatureStateValue == nil {
+				featureStates = append(featureStates, v.Addr().Interface().(*FeatureState))
+			}
+			return featureStates
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				featureStates = featureStatesWithoutValue(v.Field(i), featureStates)
+			}
+		}
+	}
+	return featureStates
+}
@@ -0,0 +1,111 @@
+package flagsmithapi_test
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+func TestLoggerAndHooksRedactSecrets(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	setupClient := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, setupClient.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, setupClient.CreateEnvironment(&environment))
+
+	logs := bytes.Buffer{}
+	requests := []flagsmithapi.RequestInfo{}
+	responses := []flagsmithapi.ResponseInfo{}
+	client := server.Client(
+		flagsmithapi.WithLogger(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		flagsmithapi.WithBeforeRequest(func(info flagsmithapi.RequestInfo) { requests = append(requests, info) }),
+		flagsmithapi.WithAfterResponse(func(info flagsmithapi.ResponseInfo) { responses = append(responses, info) }),
+	)
+
+	// When
+	key := flagsmithapi.ServerSideEnvKey{Name: "backend"}
+	require.NoError(t, client.CreateServerSideEnvKey(environment.APIKey, &key))
+	_, err := client.GetProjectByID(project.ID + 1000)
+
+	// Then
+	require.Error(t, err)
+	require.True(t, strings.HasPrefix(key.Key, "ser."))
+	require.Len(t, requests, 2)
+	require.Len(t, responses, 2)
+	assert.Equal(t, http.MethodPost, requests[0].Method)
+	assert.Equal(t, "/api/v1/environments/"+environment.APIKey+"/api-keys/", requests[0].Path)
+	assert.Equal(t, flagsmithapi.Redacted, requests[0].Header.Get("Authorization"))
+	assert.Equal(t, http.StatusCreated, responses[0].StatusCode)
+	assert.Contains(t, string(responses[0].Body), `"key":"[REDACTED]"`)
+	assert.NotContains(t, string(responses[0].Body), key.Key)
+	assert.Equal(t, http.StatusNotFound, responses[1].StatusCode)
+
+	output := logs.String()
+	assert.NotContains(t, output, key.Key)
+	assert.NotContains(t, output, flagsmithtest.MasterAPIKey)
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	require.Len(t, lines, 4)
+	assert.Contains(t, lines[0], `level=DEBUG msg="flagsmithapi: request" method=POST path=/api/v1/environments/`)
+	assert.Contains(t, lines[1], `level=DEBUG msg="flagsmithapi: response" method=POST`)
+	assert.Contains(t, lines[1], "status=201 duration=")
+	assert.Contains(t, lines[3], `level=WARN msg="flagsmithapi: error response" method=GET`)
+	assert.Contains(t, lines[3], "status=404")
+}
+
+func TestLoggerReceivesFeatureStateValueErrors(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		// the feature state value is missing
+		_, err := io.WriteString(rw, `{"id": 1, "uuid": "`+FeatureStateUUID+`", "enabled": true, "feature": 1}`)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+	logs := bytes.Buffer{}
+	client := flagsmithapi.NewClient(MasterAPIKey, server.URL+"/api/v1",
+		flagsmithapi.WithLogger(slog.NewTextHandler(&logs, nil)))
+
+	// When
+	featureState, err := client.GetFeatureState(FeatureStateUUID)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, &flagsmithapi.FeatureState{ID: 1, UUID: FeatureStateUUID, Enabled: true, Feature: 1}, featureState)
+	assert.Contains(t, logs.String(),
+		`level=WARN msg="flagsmithapi: Error unmarshalling FeatureStateValue" feature_state=`+FeatureStateUUID)
+}
+
+func TestAfterResponseHooksKeepKeyFieldsOutsideTheAPIKeysEndpoints(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		_, err := io.WriteString(rw, `{"id": 10, "name": "project-1", "key": "value", "api_key": "ser.abc123"}`)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+	responses := []flagsmithapi.ResponseInfo{}
+	client := flagsmithapi.NewClient(MasterAPIKey, server.URL+"/api/v1",
+		flagsmithapi.WithAfterResponse(func(info flagsmithapi.ResponseInfo) { responses = append(responses, info) }))
+
+	// When
+	_, err := client.GetProjectByID(ProjectID)
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	assert.Contains(t, string(responses[0].Body), `"key": "value"`)
+	assert.Contains(t, string(responses[0].Body), `"api_key": "[REDACTED]"`)
+}
@@ -0,0 +1,3919 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// API is an autogenerated mock type for the API type
+type API struct {
+	mock.Mock
+}
+
+type API_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *API) EXPECT() *API_Expecter {
+	return &API_Expecter{mock: &_m.Mock}
+}
+
+// AddFeatureGroupOwners provides a mock function with given fields: feature, groupIDs
+func (_m *API) AddFeatureGroupOwners(feature *flagsmithapi.Feature, groupIDs []int64) error {
+	ret := _m.Called(feature, groupIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFeatureGroupOwners")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature, []int64) error); ok {
+		r0 = rf(feature, groupIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_AddFeatureGroupOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatureGroupOwners'
+type API_AddFeatureGroupOwners_Call struct {
+	*mock.Call
+}
+
+// AddFeatureGroupOwners is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+//   - groupIDs []int64
+func (_e *API_Expecter) AddFeatureGroupOwners(feature interface{}, groupIDs interface{}) *API_AddFeatureGroupOwners_Call {
+	return &API_AddFeatureGroupOwners_Call{Call: _e.mock.On("AddFeatureGroupOwners", feature, groupIDs)}
+}
+
+func (_c *API_AddFeatureGroupOwners_Call) Run(run func(feature *flagsmithapi.Feature, groupIDs []int64)) *API_AddFeatureGroupOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *API_AddFeatureGroupOwners_Call) Return(_a0 error) *API_AddFeatureGroupOwners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_AddFeatureGroupOwners_Call) RunAndReturn(run func(*flagsmithapi.Feature, []int64) error) *API_AddFeatureGroupOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddFeatureOwners provides a mock function with given fields: feature, ownerIDs
+func (_m *API) AddFeatureOwners(feature *flagsmithapi.Feature, ownerIDs []int64) error {
+	ret := _m.Called(feature, ownerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFeatureOwners")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature, []int64) error); ok {
+		r0 = rf(feature, ownerIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_AddFeatureOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatureOwners'
+type API_AddFeatureOwners_Call struct {
+	*mock.Call
+}
+
+// AddFeatureOwners is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+//   - ownerIDs []int64
+func (_e *API_Expecter) AddFeatureOwners(feature interface{}, ownerIDs interface{}) *API_AddFeatureOwners_Call {
+	return &API_AddFeatureOwners_Call{Call: _e.mock.On("AddFeatureOwners", feature, ownerIDs)}
+}
+
+func (_c *API_AddFeatureOwners_Call) Run(run func(feature *flagsmithapi.Feature, ownerIDs []int64)) *API_AddFeatureOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *API_AddFeatureOwners_Call) Return(_a0 error) *API_AddFeatureOwners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_AddFeatureOwners_Call) RunAndReturn(run func(*flagsmithapi.Feature, []int64) error) *API_AddFeatureOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloneEnvironment provides a mock function with given fields: sourceKey, name, serverSideKeys
+func (_m *API) CloneEnvironment(sourceKey string, name string, serverSideKeys ...*flagsmithapi.ServerSideEnvKey) (*flagsmithapi.Environment, error) {
+	_va := make([]interface{}, len(serverSideKeys))
+	for _i := range serverSideKeys {
+		_va[_i] = serverSideKeys[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, sourceKey, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneEnvironment")
+	}
+
+	var r0 *flagsmithapi.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...*flagsmithapi.ServerSideEnvKey) (*flagsmithapi.Environment, error)); ok {
+		return rf(sourceKey, name, serverSideKeys...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...*flagsmithapi.ServerSideEnvKey) *flagsmithapi.Environment); ok {
+		r0 = rf(sourceKey, name, serverSideKeys...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...*flagsmithapi.ServerSideEnvKey) error); ok {
+		r1 = rf(sourceKey, name, serverSideKeys...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_CloneEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneEnvironment'
+type API_CloneEnvironment_Call struct {
+	*mock.Call
+}
+
+// CloneEnvironment is a helper method to define mock.On call
+//   - sourceKey string
+//   - name string
+//   - serverSideKeys ...*flagsmithapi.ServerSideEnvKey
+func (_e *API_Expecter) CloneEnvironment(sourceKey interface{}, name interface{}, serverSideKeys ...interface{}) *API_CloneEnvironment_Call {
+	return &API_CloneEnvironment_Call{Call: _e.mock.On("CloneEnvironment",
+		append([]interface{}{sourceKey, name}, serverSideKeys...)...)}
+}
+
+func (_c *API_CloneEnvironment_Call) Run(run func(sourceKey string, name string, serverSideKeys ...*flagsmithapi.ServerSideEnvKey)) *API_CloneEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*flagsmithapi.ServerSideEnvKey, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(*flagsmithapi.ServerSideEnvKey)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *API_CloneEnvironment_Call) Return(_a0 *flagsmithapi.Environment, _a1 error) *API_CloneEnvironment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_CloneEnvironment_Call) RunAndReturn(run func(string, string, ...*flagsmithapi.ServerSideEnvKey) (*flagsmithapi.Environment, error)) *API_CloneEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompareEnvironments provides a mock function with given fields: environmentKeys
+func (_m *API) CompareEnvironments(environmentKeys ...string) (*flagsmithapi.EnvironmentComparison, error) {
+	_va := make([]interface{}, len(environmentKeys))
+	for _i := range environmentKeys {
+		_va[_i] = environmentKeys[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompareEnvironments")
+	}
+
+	var r0 *flagsmithapi.EnvironmentComparison
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...string) (*flagsmithapi.EnvironmentComparison, error)); ok {
+		return rf(environmentKeys...)
+	}
+	if rf, ok := ret.Get(0).(func(...string) *flagsmithapi.EnvironmentComparison); ok {
+		r0 = rf(environmentKeys...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.EnvironmentComparison)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(environmentKeys...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_CompareEnvironments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompareEnvironments'
+type API_CompareEnvironments_Call struct {
+	*mock.Call
+}
+
+// CompareEnvironments is a helper method to define mock.On call
+//   - environmentKeys ...string
+func (_e *API_Expecter) CompareEnvironments(environmentKeys ...interface{}) *API_CompareEnvironments_Call {
+	return &API_CompareEnvironments_Call{Call: _e.mock.On("CompareEnvironments",
+		append([]interface{}{}, environmentKeys...)...)}
+}
+
+func (_c *API_CompareEnvironments_Call) Run(run func(environmentKeys ...string)) *API_CompareEnvironments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *API_CompareEnvironments_Call) Return(_a0 *flagsmithapi.EnvironmentComparison, _a1 error) *API_CompareEnvironments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_CompareEnvironments_Call) RunAndReturn(run func(...string) (*flagsmithapi.EnvironmentComparison, error)) *API_CompareEnvironments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateChangeRequest provides a mock function with given fields: changeRequest
+func (_m *API) CreateChangeRequest(changeRequest *flagsmithapi.ChangeRequest) error {
+	ret := _m.Called(changeRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChangeRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.ChangeRequest) error); ok {
+		r0 = rf(changeRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChangeRequest'
+type API_CreateChangeRequest_Call struct {
+	*mock.Call
+}
+
+// CreateChangeRequest is a helper method to define mock.On call
+//   - changeRequest *flagsmithapi.ChangeRequest
+func (_e *API_Expecter) CreateChangeRequest(changeRequest interface{}) *API_CreateChangeRequest_Call {
+	return &API_CreateChangeRequest_Call{Call: _e.mock.On("CreateChangeRequest", changeRequest)}
+}
+
+func (_c *API_CreateChangeRequest_Call) Run(run func(changeRequest *flagsmithapi.ChangeRequest)) *API_CreateChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.ChangeRequest))
+	})
+	return _c
+}
+
+func (_c *API_CreateChangeRequest_Call) Return(_a0 error) *API_CreateChangeRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateChangeRequest_Call) RunAndReturn(run func(*flagsmithapi.ChangeRequest) error) *API_CreateChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEnvironment provides a mock function with given fields: environment
+func (_m *API) CreateEnvironment(environment *flagsmithapi.Environment) error {
+	ret := _m.Called(environment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEnvironment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Environment) error); ok {
+		r0 = rf(environment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEnvironment'
+type API_CreateEnvironment_Call struct {
+	*mock.Call
+}
+
+// CreateEnvironment is a helper method to define mock.On call
+//   - environment *flagsmithapi.Environment
+func (_e *API_Expecter) CreateEnvironment(environment interface{}) *API_CreateEnvironment_Call {
+	return &API_CreateEnvironment_Call{Call: _e.mock.On("CreateEnvironment", environment)}
+}
+
+func (_c *API_CreateEnvironment_Call) Run(run func(environment *flagsmithapi.Environment)) *API_CreateEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Environment))
+	})
+	return _c
+}
+
+func (_c *API_CreateEnvironment_Call) Return(_a0 error) *API_CreateEnvironment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateEnvironment_Call) RunAndReturn(run func(*flagsmithapi.Environment) error) *API_CreateEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFeature provides a mock function with given fields: feature
+func (_m *API) CreateFeature(feature *flagsmithapi.Feature) error {
+	ret := _m.Called(feature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature) error); ok {
+		r0 = rf(feature)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFeature'
+type API_CreateFeature_Call struct {
+	*mock.Call
+}
+
+// CreateFeature is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+func (_e *API_Expecter) CreateFeature(feature interface{}) *API_CreateFeature_Call {
+	return &API_CreateFeature_Call{Call: _e.mock.On("CreateFeature", feature)}
+}
+
+func (_c *API_CreateFeature_Call) Run(run func(feature *flagsmithapi.Feature)) *API_CreateFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature))
+	})
+	return _c
+}
+
+func (_c *API_CreateFeature_Call) Return(_a0 error) *API_CreateFeature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateFeature_Call) RunAndReturn(run func(*flagsmithapi.Feature) error) *API_CreateFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFeatureMVOption provides a mock function with given fields: featureMVOption
+func (_m *API) CreateFeatureMVOption(featureMVOption *flagsmithapi.FeatureMultivariateOption) error {
+	ret := _m.Called(featureMVOption)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeatureMVOption")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.FeatureMultivariateOption) error); ok {
+		r0 = rf(featureMVOption)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateFeatureMVOption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFeatureMVOption'
+type API_CreateFeatureMVOption_Call struct {
+	*mock.Call
+}
+
+// CreateFeatureMVOption is a helper method to define mock.On call
+//   - featureMVOption *flagsmithapi.FeatureMultivariateOption
+func (_e *API_Expecter) CreateFeatureMVOption(featureMVOption interface{}) *API_CreateFeatureMVOption_Call {
+	return &API_CreateFeatureMVOption_Call{Call: _e.mock.On("CreateFeatureMVOption", featureMVOption)}
+}
+
+func (_c *API_CreateFeatureMVOption_Call) Run(run func(featureMVOption *flagsmithapi.FeatureMultivariateOption)) *API_CreateFeatureMVOption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.FeatureMultivariateOption))
+	})
+	return _c
+}
+
+func (_c *API_CreateFeatureMVOption_Call) Return(_a0 error) *API_CreateFeatureMVOption_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateFeatureMVOption_Call) RunAndReturn(run func(*flagsmithapi.FeatureMultivariateOption) error) *API_CreateFeatureMVOption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFeatureSegment provides a mock function with given fields: featureSegment
+func (_m *API) CreateFeatureSegment(featureSegment *flagsmithapi.FeatureSegment) error {
+	ret := _m.Called(featureSegment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeatureSegment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.FeatureSegment) error); ok {
+		r0 = rf(featureSegment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateFeatureSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFeatureSegment'
+type API_CreateFeatureSegment_Call struct {
+	*mock.Call
+}
+
+// CreateFeatureSegment is a helper method to define mock.On call
+//   - featureSegment *flagsmithapi.FeatureSegment
+func (_e *API_Expecter) CreateFeatureSegment(featureSegment interface{}) *API_CreateFeatureSegment_Call {
+	return &API_CreateFeatureSegment_Call{Call: _e.mock.On("CreateFeatureSegment", featureSegment)}
+}
+
+func (_c *API_CreateFeatureSegment_Call) Run(run func(featureSegment *flagsmithapi.FeatureSegment)) *API_CreateFeatureSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.FeatureSegment))
+	})
+	return _c
+}
+
+func (_c *API_CreateFeatureSegment_Call) Return(_a0 error) *API_CreateFeatureSegment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateFeatureSegment_Call) RunAndReturn(run func(*flagsmithapi.FeatureSegment) error) *API_CreateFeatureSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateIdentity provides a mock function with given fields: environmentKey, identity
+func (_m *API) CreateIdentity(environmentKey string, identity *flagsmithapi.Identity) error {
+	ret := _m.Called(environmentKey, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *flagsmithapi.Identity) error); ok {
+		r0 = rf(environmentKey, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIdentity'
+type API_CreateIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateIdentity is a helper method to define mock.On call
+//   - environmentKey string
+//   - identity *flagsmithapi.Identity
+func (_e *API_Expecter) CreateIdentity(environmentKey interface{}, identity interface{}) *API_CreateIdentity_Call {
+	return &API_CreateIdentity_Call{Call: _e.mock.On("CreateIdentity", environmentKey, identity)}
+}
+
+func (_c *API_CreateIdentity_Call) Run(run func(environmentKey string, identity *flagsmithapi.Identity)) *API_CreateIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*flagsmithapi.Identity))
+	})
+	return _c
+}
+
+func (_c *API_CreateIdentity_Call) Return(_a0 error) *API_CreateIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateIdentity_Call) RunAndReturn(run func(string, *flagsmithapi.Identity) error) *API_CreateIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateIdentityOverride provides a mock function with given fields: environmentKey, identityID, featureState
+func (_m *API) CreateIdentityOverride(environmentKey string, identityID int64, featureState *flagsmithapi.FeatureState) error {
+	ret := _m.Called(environmentKey, identityID, featureState)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIdentityOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, *flagsmithapi.FeatureState) error); ok {
+		r0 = rf(environmentKey, identityID, featureState)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateIdentityOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIdentityOverride'
+type API_CreateIdentityOverride_Call struct {
+	*mock.Call
+}
+
+// CreateIdentityOverride is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - featureState *flagsmithapi.FeatureState
+func (_e *API_Expecter) CreateIdentityOverride(environmentKey interface{}, identityID interface{}, featureState interface{}) *API_CreateIdentityOverride_Call {
+	return &API_CreateIdentityOverride_Call{Call: _e.mock.On("CreateIdentityOverride", environmentKey, identityID, featureState)}
+}
+
+func (_c *API_CreateIdentityOverride_Call) Run(run func(environmentKey string, identityID int64, featureState *flagsmithapi.FeatureState)) *API_CreateIdentityOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(*flagsmithapi.FeatureState))
+	})
+	return _c
+}
+
+func (_c *API_CreateIdentityOverride_Call) Return(_a0 error) *API_CreateIdentityOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateIdentityOverride_Call) RunAndReturn(run func(string, int64, *flagsmithapi.FeatureState) error) *API_CreateIdentityOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateProject provides a mock function with given fields: project
+func (_m *API) CreateProject(project *flagsmithapi.Project) error {
+	ret := _m.Called(project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Project) error); ok {
+		r0 = rf(project)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProject'
+type API_CreateProject_Call struct {
+	*mock.Call
+}
+
+// CreateProject is a helper method to define mock.On call
+//   - project *flagsmithapi.Project
+func (_e *API_Expecter) CreateProject(project interface{}) *API_CreateProject_Call {
+	return &API_CreateProject_Call{Call: _e.mock.On("CreateProject", project)}
+}
+
+func (_c *API_CreateProject_Call) Run(run func(project *flagsmithapi.Project)) *API_CreateProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Project))
+	})
+	return _c
+}
+
+func (_c *API_CreateProject_Call) Return(_a0 error) *API_CreateProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateProject_Call) RunAndReturn(run func(*flagsmithapi.Project) error) *API_CreateProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSegment provides a mock function with given fields: segment
+func (_m *API) CreateSegment(segment *flagsmithapi.Segment) error {
+	ret := _m.Called(segment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSegment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Segment) error); ok {
+		r0 = rf(segment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSegment'
+type API_CreateSegment_Call struct {
+	*mock.Call
+}
+
+// CreateSegment is a helper method to define mock.On call
+//   - segment *flagsmithapi.Segment
+func (_e *API_Expecter) CreateSegment(segment interface{}) *API_CreateSegment_Call {
+	return &API_CreateSegment_Call{Call: _e.mock.On("CreateSegment", segment)}
+}
+
+func (_c *API_CreateSegment_Call) Run(run func(segment *flagsmithapi.Segment)) *API_CreateSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Segment))
+	})
+	return _c
+}
+
+func (_c *API_CreateSegment_Call) Return(_a0 error) *API_CreateSegment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateSegment_Call) RunAndReturn(run func(*flagsmithapi.Segment) error) *API_CreateSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSegmentOverride provides a mock function with given fields: featureState
+func (_m *API) CreateSegmentOverride(featureState *flagsmithapi.FeatureState) error {
+	ret := _m.Called(featureState)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSegmentOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.FeatureState) error); ok {
+		r0 = rf(featureState)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateSegmentOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSegmentOverride'
+type API_CreateSegmentOverride_Call struct {
+	*mock.Call
+}
+
+// CreateSegmentOverride is a helper method to define mock.On call
+//   - featureState *flagsmithapi.FeatureState
+func (_e *API_Expecter) CreateSegmentOverride(featureState interface{}) *API_CreateSegmentOverride_Call {
+	return &API_CreateSegmentOverride_Call{Call: _e.mock.On("CreateSegmentOverride", featureState)}
+}
+
+func (_c *API_CreateSegmentOverride_Call) Run(run func(featureState *flagsmithapi.FeatureState)) *API_CreateSegmentOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.FeatureState))
+	})
+	return _c
+}
+
+func (_c *API_CreateSegmentOverride_Call) Return(_a0 error) *API_CreateSegmentOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateSegmentOverride_Call) RunAndReturn(run func(*flagsmithapi.FeatureState) error) *API_CreateSegmentOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateServerSideEnvKey provides a mock function with given fields: environmentKey, key
+func (_m *API) CreateServerSideEnvKey(environmentKey string, key *flagsmithapi.ServerSideEnvKey) error {
+	ret := _m.Called(environmentKey, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateServerSideEnvKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *flagsmithapi.ServerSideEnvKey) error); ok {
+		r0 = rf(environmentKey, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateServerSideEnvKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateServerSideEnvKey'
+type API_CreateServerSideEnvKey_Call struct {
+	*mock.Call
+}
+
+// CreateServerSideEnvKey is a helper method to define mock.On call
+//   - environmentKey string
+//   - key *flagsmithapi.ServerSideEnvKey
+func (_e *API_Expecter) CreateServerSideEnvKey(environmentKey interface{}, key interface{}) *API_CreateServerSideEnvKey_Call {
+	return &API_CreateServerSideEnvKey_Call{Call: _e.mock.On("CreateServerSideEnvKey", environmentKey, key)}
+}
+
+func (_c *API_CreateServerSideEnvKey_Call) Run(run func(environmentKey string, key *flagsmithapi.ServerSideEnvKey)) *API_CreateServerSideEnvKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*flagsmithapi.ServerSideEnvKey))
+	})
+	return _c
+}
+
+func (_c *API_CreateServerSideEnvKey_Call) Return(_a0 error) *API_CreateServerSideEnvKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateServerSideEnvKey_Call) RunAndReturn(run func(string, *flagsmithapi.ServerSideEnvKey) error) *API_CreateServerSideEnvKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTag provides a mock function with given fields: tag
+func (_m *API) CreateTag(tag *flagsmithapi.Tag) error {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Tag) error); ok {
+		r0 = rf(tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type API_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - tag *flagsmithapi.Tag
+func (_e *API_Expecter) CreateTag(tag interface{}) *API_CreateTag_Call {
+	return &API_CreateTag_Call{Call: _e.mock.On("CreateTag", tag)}
+}
+
+func (_c *API_CreateTag_Call) Run(run func(tag *flagsmithapi.Tag)) *API_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Tag))
+	})
+	return _c
+}
+
+func (_c *API_CreateTag_Call) Return(_a0 error) *API_CreateTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateTag_Call) RunAndReturn(run func(*flagsmithapi.Tag) error) *API_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTrait provides a mock function with given fields: environmentKey, identityID, trait
+func (_m *API) CreateTrait(environmentKey string, identityID int64, trait *flagsmithapi.Trait) error {
+	ret := _m.Called(environmentKey, identityID, trait)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTrait")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, *flagsmithapi.Trait) error); ok {
+		r0 = rf(environmentKey, identityID, trait)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_CreateTrait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTrait'
+type API_CreateTrait_Call struct {
+	*mock.Call
+}
+
+// CreateTrait is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - trait *flagsmithapi.Trait
+func (_e *API_Expecter) CreateTrait(environmentKey interface{}, identityID interface{}, trait interface{}) *API_CreateTrait_Call {
+	return &API_CreateTrait_Call{Call: _e.mock.On("CreateTrait", environmentKey, identityID, trait)}
+}
+
+func (_c *API_CreateTrait_Call) Run(run func(environmentKey string, identityID int64, trait *flagsmithapi.Trait)) *API_CreateTrait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(*flagsmithapi.Trait))
+	})
+	return _c
+}
+
+func (_c *API_CreateTrait_Call) Return(_a0 error) *API_CreateTrait_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_CreateTrait_Call) RunAndReturn(run func(string, int64, *flagsmithapi.Trait) error) *API_CreateTrait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEnvironment provides a mock function with given fields: apiKey
+func (_m *API) DeleteEnvironment(apiKey string) error {
+	ret := _m.Called(apiKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEnvironment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(apiKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_DeleteEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEnvironment'
+type API_DeleteEnvironment_Call struct {
+	*mock.Call
+}
+
+// DeleteEnvironment is a helper method to define mock.On call
+//   - apiKey string
+func (_e *API_Expecter) DeleteEnvironment(apiKey interface{}) *API_DeleteEnvironment_Call {
+	return &API_DeleteEnvironment_Call{Call: _e.mock.On("DeleteEnvironment", apiKey)}
+}
+
+func (_c *API_DeleteEnvironment_Call) Run(run func(apiKey string)) *API_DeleteEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *API_DeleteEnvironment_Call) Return(_a0 error) *API_DeleteEnvironment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_DeleteEnvironment_Call) RunAndReturn(run func(string) error) *API_DeleteEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFeature provides a mock function with given fields: projectID, featureID
+func (_m *API) DeleteFeature(projectID int64, featureID int64) error {
+	ret := _m.Called(projectID, featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(projectID, featureID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_DeleteFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFeature'
+type API_DeleteFeature_Call struct {
+	*mock.Call
+}
+
+// DeleteFeature is a helper method to define mock.On call
+//   - projectID int64
+//   - featureID int64
+func (_e *API_Expecter) DeleteFeature(projectID interface{}, featureID interface{}) *API_DeleteFeature_Call {
+	return &API_DeleteFeature_Call{Call: _e.mock.On("DeleteFeature", projectID, featureID)}
+}
+
+func (_c *API_DeleteFeature_Call) Run(run func(projectID int64, featureID int64)) *API_DeleteFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_DeleteFeature_Call) Return(_a0 error) *API_DeleteFeature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_DeleteFeature_Call) RunAndReturn(run func(int64, int64) error) *API_DeleteFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFeatureMVOption provides a mock function with given fields: projectID, featureID, mvOptionID
+func (_m *API) DeleteFeatureMVOption(projectID int64, featureID int64, mvOptionID int64) error {
+	ret := _m.Called(projectID, featureID, mvOptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeatureMVOption")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) error); ok {
+		r0 = rf(projectID, featureID, mvOptionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_DeleteFeatureMVOption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFeatureMVOption'
+type API_DeleteFeatureMVOption_Call struct {
+	*mock.Call
+}
+
+// DeleteFeatureMVOption is a helper method to define mock.On call
+//   - projectID int64
+//   - featureID int64
+//   - mvOptionID int64
+func (_e *API_Expecter) DeleteFeatureMVOption(projectID interface{}, featureID interface{}, mvOptionID interface{}) *API_DeleteFeatureMVOption_Call {
+	return &API_DeleteFeatureMVOption_Call{Call: _e.mock.On("DeleteFeatureMVOption", projectID, featureID, mvOptionID)}
+}
+
+func (_c *API_DeleteFeatureMVOption_Call) Run(run func(projectID int64, featureID int64, mvOptionID int64)) *API_DeleteFeatureMVOption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *API_DeleteFeatureMVOption_Call) Return(_a0 error) *API_DeleteFeatureMVOption_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_DeleteFeatureMVOption_Call) RunAndReturn(run func(int64, int64, int64) error) *API_DeleteFeatureMVOption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFeatureSegment provides a mock function with given fields: featureSegmentID
+func (_m *API) DeleteFeatureSegment(featureSegmentID int64) error {
+	ret := _m.Called(featureSegmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeatureSegment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(featureSegmentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_DeleteFeatureSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFeatureSegment'
+type API_DeleteFeatureSegment_Call struct {
+	*mock.Call
+}
+
+// DeleteFeatureSegment is a helper method to define mock.On call
+//   - featureSegmentID int64
+func (_e *API_Expecter) DeleteFeatureSegment(featureSegmentID interface{}) *API_DeleteFeatureSegment_Call {
+	return &API_DeleteFeatureSegment_Call{Call: _e.mock.On("DeleteFeatureSegment", featureSegmentID)}
+}
+
+func (_c *API_DeleteFeatureSegment_Call) Run(run func(featureSegmentID int64)) *API_DeleteFeatureSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *API_DeleteFeatureSegment_Call) Return(_a0 error) *API_DeleteFeatureSegment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_DeleteFeatureSegment_Call) RunAndReturn(run func(int64) error) *API_DeleteFeatureSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteIdentity provides a mock function with given fields: environmentKey, identityID
+func (_m *API) DeleteIdentity(environmentKey string, identityID int64) error {
+	ret := _m.Called(environmentKey, identityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(environmentKey, identityID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_DeleteIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIdentity'
+type API_DeleteIdentity_Call struct {
+	*mock.Call
+}
+
+// DeleteIdentity is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+func (_e *API_Expecter) DeleteIdentity(environmentKey interface{}, identityID interface{}) *API_DeleteIdentity_Call {
+	return &API_DeleteIdentity_Call{Call: _e.mock.On("DeleteIdentity", environmentKey, identityID)}
+}
+
+func (_c *API_DeleteIdentity_Call) Run(run func(environmentKey string, identityID int64)) *API_DeleteIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_DeleteIdentity_Call) Return(_a0 error) *API_DeleteIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_DeleteIdentity_Call) RunAndReturn(run func(string, int64) error) *API_DeleteIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteIdentityOverride provides a mock function with given fields: environmentKey, identityID, featureStateID
+func (_m *API) DeleteIdentityOverride(environmentKey string, identityID int64, featureStateID int64) error {
+	ret := _m.Called(environmentKey, identityID, featureStateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIdentityOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64) error); ok {
+		r0 = rf(environmentKey, identityID, featureStateID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_DeleteIdentityOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIdentityOverride'
+type API_DeleteIdentityOverride_Call struct {
+	*mock.Call
+}
+
+// DeleteIdentityOverride is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - featureStateID int64
+func (_e *API_Expecter) DeleteIdentityOverride(environmentKey interface{}, identityID interface{}, featureStateID interface{}) *API_DeleteIdentityOverride_Call {
+	return &API_DeleteIdentityOverride_Call{Call: _e.mock.On("DeleteIdentityOverride", environmentKey, identityID, featureStateID)}
+}
+
+func (_c *API_DeleteIdentityOverride_Call) Run(run func(environmentKey string, identityID int64, featureStateID int64)) *API_DeleteIdentityOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *API_DeleteIdentityOverride_Call) Return(_a0 error) *API_DeleteIdentityOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_DeleteIdentityOverride_Call) RunAndReturn(run func(string, int64, int64) error) *API_DeleteIdentityOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProject provides a mock function with given fields: projectID
+func (_m *API) DeleteProject(projectID int64) error {
+	ret := _m.Called(projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(projectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_DeleteProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProject'
+type API_DeleteProject_Call struct {
+	*mock.Call
+}
+
+// DeleteProject is a helper method to define mock.On call
+//   - projectID int64
+func (_e *API_Expecter) DeleteProject(projectID interface{}) *API_DeleteProject_Call {
+	return &API_DeleteProject_Call{Call: _e.mock.On("DeleteProject", projectID)}
+}
+
+func (_c *API_DeleteProject_Call) Run(run func(projectID int64)) *API_DeleteProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *API_DeleteProject_Call) Return(_a0 error) *API_DeleteProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_DeleteProject_Call) RunAndReturn(run func(int64) error) *API_DeleteProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSegment provides a mock function with given fields: projectID, segmentID
+func (_m *API) DeleteSegment(projectID int64, segmentID int64) error {
+	ret := _m.Called(projectID, segmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSegment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(projectID, segmentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_DeleteSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSegment'
+type API_DeleteSegment_Call struct {
+	*mock.Call
+}
+
+// DeleteSegment is a helper method to define mock.On call
+//   - projectID int64
+//   - segmentID int64
+func (_e *API_Expecter) DeleteSegment(projectID interface{}, segmentID interface{}) *API_DeleteSegment_Call {
+	return &API_DeleteSegment_Call{Call: _e.mock.On("DeleteSegment", projectID, segmentID)}
+}
+
+func (_c *API_DeleteSegment_Call) Run(run func(projectID int64, segmentID int64)) *API_DeleteSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_DeleteSegment_Call) Return(_a0 error) *API_DeleteSegment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_DeleteSegment_Call) RunAndReturn(run func(int64, int64) error) *API_DeleteSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServerSideEnvKey provides a mock function with given fields: environmentKey, keyID
+func (_m *API) DeleteServerSideEnvKey(environmentKey string, keyID int64) error {
+	ret := _m.Called(environmentKey, keyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServerSideEnvKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(environmentKey, keyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_DeleteServerSideEnvKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServerSideEnvKey'
+type API_DeleteServerSideEnvKey_Call struct {
+	*mock.Call
+}
+
+// DeleteServerSideEnvKey is a helper method to define mock.On call
+//   - environmentKey string
+//   - keyID int64
+func (_e *API_Expecter) DeleteServerSideEnvKey(environmentKey interface{}, keyID interface{}) *API_DeleteServerSideEnvKey_Call {
+	return &API_DeleteServerSideEnvKey_Call{Call: _e.mock.On("DeleteServerSideEnvKey", environmentKey, keyID)}
+}
+
+func (_c *API_DeleteServerSideEnvKey_Call) Run(run func(environmentKey string, keyID int64)) *API_DeleteServerSideEnvKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_DeleteServerSideEnvKey_Call) Return(_a0 error) *API_DeleteServerSideEnvKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_DeleteServerSideEnvKey_Call) RunAndReturn(run func(string, int64) error) *API_DeleteServerSideEnvKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: projectID, tagID
+func (_m *API) DeleteTag(projectID int64, tagID int64) error {
+	ret := _m.Called(projectID, tagID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(projectID, tagID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type API_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - projectID int64
+//   - tagID int64
+func (_e *API_Expecter) DeleteTag(projectID interface{}, tagID interface{}) *API_DeleteTag_Call {
+	return &API_DeleteTag_Call{Call: _e.mock.On("DeleteTag", projectID, tagID)}
+}
+
+func (_c *API_DeleteTag_Call) Run(run func(projectID int64, tagID int64)) *API_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_DeleteTag_Call) Return(_a0 error) *API_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_DeleteTag_Call) RunAndReturn(run func(int64, int64) error) *API_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTrait provides a mock function with given fields: environmentKey, identityID, traitID
+func (_m *API) DeleteTrait(environmentKey string, identityID int64, traitID int64) error {
+	ret := _m.Called(environmentKey, identityID, traitID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTrait")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64) error); ok {
+		r0 = rf(environmentKey, identityID, traitID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_DeleteTrait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrait'
+type API_DeleteTrait_Call struct {
+	*mock.Call
+}
+
+// DeleteTrait is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - traitID int64
+func (_e *API_Expecter) DeleteTrait(environmentKey interface{}, identityID interface{}, traitID interface{}) *API_DeleteTrait_Call {
+	return &API_DeleteTrait_Call{Call: _e.mock.On("DeleteTrait", environmentKey, identityID, traitID)}
+}
+
+func (_c *API_DeleteTrait_Call) Run(run func(environmentKey string, identityID int64, traitID int64)) *API_DeleteTrait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *API_DeleteTrait_Call) Return(_a0 error) *API_DeleteTrait_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_DeleteTrait_Call) RunAndReturn(run func(string, int64, int64) error) *API_DeleteTrait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnvironment provides a mock function with given fields: apiKey
+func (_m *API) GetEnvironment(apiKey string) (*flagsmithapi.Environment, error) {
+	ret := _m.Called(apiKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvironment")
+	}
+
+	var r0 *flagsmithapi.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.Environment, error)); ok {
+		return rf(apiKey)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.Environment); ok {
+		r0 = rf(apiKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(apiKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironment'
+type API_GetEnvironment_Call struct {
+	*mock.Call
+}
+
+// GetEnvironment is a helper method to define mock.On call
+//   - apiKey string
+func (_e *API_Expecter) GetEnvironment(apiKey interface{}) *API_GetEnvironment_Call {
+	return &API_GetEnvironment_Call{Call: _e.mock.On("GetEnvironment", apiKey)}
+}
+
+func (_c *API_GetEnvironment_Call) Run(run func(apiKey string)) *API_GetEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetEnvironment_Call) Return(_a0 *flagsmithapi.Environment, _a1 error) *API_GetEnvironment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetEnvironment_Call) RunAndReturn(run func(string) (*flagsmithapi.Environment, error)) *API_GetEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnvironmentByUUID provides a mock function with given fields: uuid
+func (_m *API) GetEnvironmentByUUID(uuid string) (*flagsmithapi.Environment, error) {
+	ret := _m.Called(uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvironmentByUUID")
+	}
+
+	var r0 *flagsmithapi.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.Environment, error)); ok {
+		return rf(uuid)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.Environment); ok {
+		r0 = rf(uuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetEnvironmentByUUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironmentByUUID'
+type API_GetEnvironmentByUUID_Call struct {
+	*mock.Call
+}
+
+// GetEnvironmentByUUID is a helper method to define mock.On call
+//   - uuid string
+func (_e *API_Expecter) GetEnvironmentByUUID(uuid interface{}) *API_GetEnvironmentByUUID_Call {
+	return &API_GetEnvironmentByUUID_Call{Call: _e.mock.On("GetEnvironmentByUUID", uuid)}
+}
+
+func (_c *API_GetEnvironmentByUUID_Call) Run(run func(uuid string)) *API_GetEnvironmentByUUID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetEnvironmentByUUID_Call) Return(_a0 *flagsmithapi.Environment, _a1 error) *API_GetEnvironmentByUUID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetEnvironmentByUUID_Call) RunAndReturn(run func(string) (*flagsmithapi.Environment, error)) *API_GetEnvironmentByUUID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnvironmentDocument provides a mock function with given fields: environmentKey
+func (_m *API) GetEnvironmentDocument(environmentKey string) (*flagsmithapi.EnvironmentDocument, error) {
+	ret := _m.Called(environmentKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvironmentDocument")
+	}
+
+	var r0 *flagsmithapi.EnvironmentDocument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.EnvironmentDocument, error)); ok {
+		return rf(environmentKey)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.EnvironmentDocument); ok {
+		r0 = rf(environmentKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.EnvironmentDocument)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(environmentKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetEnvironmentDocument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironmentDocument'
+type API_GetEnvironmentDocument_Call struct {
+	*mock.Call
+}
+
+// GetEnvironmentDocument is a helper method to define mock.On call
+//   - environmentKey string
+func (_e *API_Expecter) GetEnvironmentDocument(environmentKey interface{}) *API_GetEnvironmentDocument_Call {
+	return &API_GetEnvironmentDocument_Call{Call: _e.mock.On("GetEnvironmentDocument", environmentKey)}
+}
+
+func (_c *API_GetEnvironmentDocument_Call) Run(run func(environmentKey string)) *API_GetEnvironmentDocument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetEnvironmentDocument_Call) Return(_a0 *flagsmithapi.EnvironmentDocument, _a1 error) *API_GetEnvironmentDocument_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetEnvironmentDocument_Call) RunAndReturn(run func(string) (*flagsmithapi.EnvironmentDocument, error)) *API_GetEnvironmentDocument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnvironmentFeatureState provides a mock function with given fields: environmentKey, featureID
+func (_m *API) GetEnvironmentFeatureState(environmentKey string, featureID int64) (*flagsmithapi.FeatureState, error) {
+	ret := _m.Called(environmentKey, featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvironmentFeatureState")
+	}
+
+	var r0 *flagsmithapi.FeatureState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) (*flagsmithapi.FeatureState, error)); ok {
+		return rf(environmentKey, featureID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) *flagsmithapi.FeatureState); ok {
+		r0 = rf(environmentKey, featureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.FeatureState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(environmentKey, featureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetEnvironmentFeatureState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironmentFeatureState'
+type API_GetEnvironmentFeatureState_Call struct {
+	*mock.Call
+}
+
+// GetEnvironmentFeatureState is a helper method to define mock.On call
+//   - environmentKey string
+//   - featureID int64
+func (_e *API_Expecter) GetEnvironmentFeatureState(environmentKey interface{}, featureID interface{}) *API_GetEnvironmentFeatureState_Call {
+	return &API_GetEnvironmentFeatureState_Call{Call: _e.mock.On("GetEnvironmentFeatureState", environmentKey, featureID)}
+}
+
+func (_c *API_GetEnvironmentFeatureState_Call) Run(run func(environmentKey string, featureID int64)) *API_GetEnvironmentFeatureState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_GetEnvironmentFeatureState_Call) Return(_a0 *flagsmithapi.FeatureState, _a1 error) *API_GetEnvironmentFeatureState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetEnvironmentFeatureState_Call) RunAndReturn(run func(string, int64) (*flagsmithapi.FeatureState, error)) *API_GetEnvironmentFeatureState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeature provides a mock function with given fields: featureUUID
+func (_m *API) GetFeature(featureUUID string) (*flagsmithapi.Feature, error) {
+	ret := _m.Called(featureUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeature")
+	}
+
+	var r0 *flagsmithapi.Feature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.Feature, error)); ok {
+		return rf(featureUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.Feature); ok {
+		r0 = rf(featureUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Feature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(featureUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeature'
+type API_GetFeature_Call struct {
+	*mock.Call
+}
+
+// GetFeature is a helper method to define mock.On call
+//   - featureUUID string
+func (_e *API_Expecter) GetFeature(featureUUID interface{}) *API_GetFeature_Call {
+	return &API_GetFeature_Call{Call: _e.mock.On("GetFeature", featureUUID)}
+}
+
+func (_c *API_GetFeature_Call) Run(run func(featureUUID string)) *API_GetFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetFeature_Call) Return(_a0 *flagsmithapi.Feature, _a1 error) *API_GetFeature_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetFeature_Call) RunAndReturn(run func(string) (*flagsmithapi.Feature, error)) *API_GetFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeatureMVOption provides a mock function with given fields: featureUUID, mvOptionUUID
+func (_m *API) GetFeatureMVOption(featureUUID string, mvOptionUUID string) (*flagsmithapi.FeatureMultivariateOption, error) {
+	ret := _m.Called(featureUUID, mvOptionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeatureMVOption")
+	}
+
+	var r0 *flagsmithapi.FeatureMultivariateOption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*flagsmithapi.FeatureMultivariateOption, error)); ok {
+		return rf(featureUUID, mvOptionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *flagsmithapi.FeatureMultivariateOption); ok {
+		r0 = rf(featureUUID, mvOptionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.FeatureMultivariateOption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(featureUUID, mvOptionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetFeatureMVOption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureMVOption'
+type API_GetFeatureMVOption_Call struct {
+	*mock.Call
+}
+
+// GetFeatureMVOption is a helper method to define mock.On call
+//   - featureUUID string
+//   - mvOptionUUID string
+func (_e *API_Expecter) GetFeatureMVOption(featureUUID interface{}, mvOptionUUID interface{}) *API_GetFeatureMVOption_Call {
+	return &API_GetFeatureMVOption_Call{Call: _e.mock.On("GetFeatureMVOption", featureUUID, mvOptionUUID)}
+}
+
+func (_c *API_GetFeatureMVOption_Call) Run(run func(featureUUID string, mvOptionUUID string)) *API_GetFeatureMVOption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetFeatureMVOption_Call) Return(_a0 *flagsmithapi.FeatureMultivariateOption, _a1 error) *API_GetFeatureMVOption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetFeatureMVOption_Call) RunAndReturn(run func(string, string) (*flagsmithapi.FeatureMultivariateOption, error)) *API_GetFeatureMVOption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeatureSegmentByID provides a mock function with given fields: featureSegmentID
+func (_m *API) GetFeatureSegmentByID(featureSegmentID int64) (*flagsmithapi.FeatureSegment, error) {
+	ret := _m.Called(featureSegmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeatureSegmentByID")
+	}
+
+	var r0 *flagsmithapi.FeatureSegment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (*flagsmithapi.FeatureSegment, error)); ok {
+		return rf(featureSegmentID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) *flagsmithapi.FeatureSegment); ok {
+		r0 = rf(featureSegmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.FeatureSegment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(featureSegmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetFeatureSegmentByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureSegmentByID'
+type API_GetFeatureSegmentByID_Call struct {
+	*mock.Call
+}
+
+// GetFeatureSegmentByID is a helper method to define mock.On call
+//   - featureSegmentID int64
+func (_e *API_Expecter) GetFeatureSegmentByID(featureSegmentID interface{}) *API_GetFeatureSegmentByID_Call {
+	return &API_GetFeatureSegmentByID_Call{Call: _e.mock.On("GetFeatureSegmentByID", featureSegmentID)}
+}
+
+func (_c *API_GetFeatureSegmentByID_Call) Run(run func(featureSegmentID int64)) *API_GetFeatureSegmentByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *API_GetFeatureSegmentByID_Call) Return(_a0 *flagsmithapi.FeatureSegment, _a1 error) *API_GetFeatureSegmentByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetFeatureSegmentByID_Call) RunAndReturn(run func(int64) (*flagsmithapi.FeatureSegment, error)) *API_GetFeatureSegmentByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeatureState provides a mock function with given fields: featureStateUUID
+func (_m *API) GetFeatureState(featureStateUUID string) (*flagsmithapi.FeatureState, error) {
+	ret := _m.Called(featureStateUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeatureState")
+	}
+
+	var r0 *flagsmithapi.FeatureState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.FeatureState, error)); ok {
+		return rf(featureStateUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.FeatureState); ok {
+		r0 = rf(featureStateUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.FeatureState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(featureStateUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetFeatureState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureState'
+type API_GetFeatureState_Call struct {
+	*mock.Call
+}
+
+// GetFeatureState is a helper method to define mock.On call
+//   - featureStateUUID string
+func (_e *API_Expecter) GetFeatureState(featureStateUUID interface{}) *API_GetFeatureState_Call {
+	return &API_GetFeatureState_Call{Call: _e.mock.On("GetFeatureState", featureStateUUID)}
+}
+
+func (_c *API_GetFeatureState_Call) Run(run func(featureStateUUID string)) *API_GetFeatureState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetFeatureState_Call) Return(_a0 *flagsmithapi.FeatureState, _a1 error) *API_GetFeatureState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetFeatureState_Call) RunAndReturn(run func(string) (*flagsmithapi.FeatureState, error)) *API_GetFeatureState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIdentity provides a mock function with given fields: environmentKey, identityID
+func (_m *API) GetIdentity(environmentKey string, identityID int64) (*flagsmithapi.Identity, error) {
+	ret := _m.Called(environmentKey, identityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIdentity")
+	}
+
+	var r0 *flagsmithapi.Identity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) (*flagsmithapi.Identity, error)); ok {
+		return rf(environmentKey, identityID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) *flagsmithapi.Identity); ok {
+		r0 = rf(environmentKey, identityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Identity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(environmentKey, identityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdentity'
+type API_GetIdentity_Call struct {
+	*mock.Call
+}
+
+// GetIdentity is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+func (_e *API_Expecter) GetIdentity(environmentKey interface{}, identityID interface{}) *API_GetIdentity_Call {
+	return &API_GetIdentity_Call{Call: _e.mock.On("GetIdentity", environmentKey, identityID)}
+}
+
+func (_c *API_GetIdentity_Call) Run(run func(environmentKey string, identityID int64)) *API_GetIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_GetIdentity_Call) Return(_a0 *flagsmithapi.Identity, _a1 error) *API_GetIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetIdentity_Call) RunAndReturn(run func(string, int64) (*flagsmithapi.Identity, error)) *API_GetIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganisationByUUID provides a mock function with given fields: orgUUID
+func (_m *API) GetOrganisationByUUID(orgUUID string) (*flagsmithapi.Organisation, error) {
+	ret := _m.Called(orgUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganisationByUUID")
+	}
+
+	var r0 *flagsmithapi.Organisation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.Organisation, error)); ok {
+		return rf(orgUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.Organisation); ok {
+		r0 = rf(orgUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Organisation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(orgUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetOrganisationByUUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganisationByUUID'
+type API_GetOrganisationByUUID_Call struct {
+	*mock.Call
+}
+
+// GetOrganisationByUUID is a helper method to define mock.On call
+//   - orgUUID string
+func (_e *API_Expecter) GetOrganisationByUUID(orgUUID interface{}) *API_GetOrganisationByUUID_Call {
+	return &API_GetOrganisationByUUID_Call{Call: _e.mock.On("GetOrganisationByUUID", orgUUID)}
+}
+
+func (_c *API_GetOrganisationByUUID_Call) Run(run func(orgUUID string)) *API_GetOrganisationByUUID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetOrganisationByUUID_Call) Return(_a0 *flagsmithapi.Organisation, _a1 error) *API_GetOrganisationByUUID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetOrganisationByUUID_Call) RunAndReturn(run func(string) (*flagsmithapi.Organisation, error)) *API_GetOrganisationByUUID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganisationUserByEmail provides a mock function with given fields: orgID, email
+func (_m *API) GetOrganisationUserByEmail(orgID int64, email string) (*flagsmithapi.User, error) {
+	ret := _m.Called(orgID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganisationUserByEmail")
+	}
+
+	var r0 *flagsmithapi.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, string) (*flagsmithapi.User, error)); ok {
+		return rf(orgID, email)
+	}
+	if rf, ok := ret.Get(0).(func(int64, string) *flagsmithapi.User); ok {
+		r0 = rf(orgID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, string) error); ok {
+		r1 = rf(orgID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetOrganisationUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganisationUserByEmail'
+type API_GetOrganisationUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetOrganisationUserByEmail is a helper method to define mock.On call
+//   - orgID int64
+//   - email string
+func (_e *API_Expecter) GetOrganisationUserByEmail(orgID interface{}, email interface{}) *API_GetOrganisationUserByEmail_Call {
+	return &API_GetOrganisationUserByEmail_Call{Call: _e.mock.On("GetOrganisationUserByEmail", orgID, email)}
+}
+
+func (_c *API_GetOrganisationUserByEmail_Call) Run(run func(orgID int64, email string)) *API_GetOrganisationUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetOrganisationUserByEmail_Call) Return(_a0 *flagsmithapi.User, _a1 error) *API_GetOrganisationUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetOrganisationUserByEmail_Call) RunAndReturn(run func(int64, string) (*flagsmithapi.User, error)) *API_GetOrganisationUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganisationUsers provides a mock function with given fields: orgID
+func (_m *API) GetOrganisationUsers(orgID int64) ([]flagsmithapi.User, error) {
+	ret := _m.Called(orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganisationUsers")
+	}
+
+	var r0 []flagsmithapi.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]flagsmithapi.User, error)); ok {
+		return rf(orgID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []flagsmithapi.User); ok {
+		r0 = rf(orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetOrganisationUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganisationUsers'
+type API_GetOrganisationUsers_Call struct {
+	*mock.Call
+}
+
+// GetOrganisationUsers is a helper method to define mock.On call
+//   - orgID int64
+func (_e *API_Expecter) GetOrganisationUsers(orgID interface{}) *API_GetOrganisationUsers_Call {
+	return &API_GetOrganisationUsers_Call{Call: _e.mock.On("GetOrganisationUsers", orgID)}
+}
+
+func (_c *API_GetOrganisationUsers_Call) Run(run func(orgID int64)) *API_GetOrganisationUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *API_GetOrganisationUsers_Call) Return(_a0 []flagsmithapi.User, _a1 error) *API_GetOrganisationUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetOrganisationUsers_Call) RunAndReturn(run func(int64) ([]flagsmithapi.User, error)) *API_GetOrganisationUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProject provides a mock function with given fields: projectUUID
+func (_m *API) GetProject(projectUUID string) (*flagsmithapi.Project, error) {
+	ret := _m.Called(projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProject")
+	}
+
+	var r0 *flagsmithapi.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.Project, error)); ok {
+		return rf(projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.Project); ok {
+		r0 = rf(projectUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProject'
+type API_GetProject_Call struct {
+	*mock.Call
+}
+
+// GetProject is a helper method to define mock.On call
+//   - projectUUID string
+func (_e *API_Expecter) GetProject(projectUUID interface{}) *API_GetProject_Call {
+	return &API_GetProject_Call{Call: _e.mock.On("GetProject", projectUUID)}
+}
+
+func (_c *API_GetProject_Call) Run(run func(projectUUID string)) *API_GetProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetProject_Call) Return(_a0 *flagsmithapi.Project, _a1 error) *API_GetProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetProject_Call) RunAndReturn(run func(string) (*flagsmithapi.Project, error)) *API_GetProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectByID provides a mock function with given fields: projectID
+func (_m *API) GetProjectByID(projectID int64) (*flagsmithapi.Project, error) {
+	ret := _m.Called(projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectByID")
+	}
+
+	var r0 *flagsmithapi.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (*flagsmithapi.Project, error)); ok {
+		return rf(projectID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) *flagsmithapi.Project); ok {
+		r0 = rf(projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetProjectByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectByID'
+type API_GetProjectByID_Call struct {
+	*mock.Call
+}
+
+// GetProjectByID is a helper method to define mock.On call
+//   - projectID int64
+func (_e *API_Expecter) GetProjectByID(projectID interface{}) *API_GetProjectByID_Call {
+	return &API_GetProjectByID_Call{Call: _e.mock.On("GetProjectByID", projectID)}
+}
+
+func (_c *API_GetProjectByID_Call) Run(run func(projectID int64)) *API_GetProjectByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *API_GetProjectByID_Call) Return(_a0 *flagsmithapi.Project, _a1 error) *API_GetProjectByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetProjectByID_Call) RunAndReturn(run func(int64) (*flagsmithapi.Project, error)) *API_GetProjectByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegment provides a mock function with given fields: segmentUUID
+func (_m *API) GetSegment(segmentUUID string) (*flagsmithapi.Segment, error) {
+	ret := _m.Called(segmentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSegment")
+	}
+
+	var r0 *flagsmithapi.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.Segment, error)); ok {
+		return rf(segmentUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.Segment); ok {
+		r0 = rf(segmentUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(segmentUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegment'
+type API_GetSegment_Call struct {
+	*mock.Call
+}
+
+// GetSegment is a helper method to define mock.On call
+//   - segmentUUID string
+func (_e *API_Expecter) GetSegment(segmentUUID interface{}) *API_GetSegment_Call {
+	return &API_GetSegment_Call{Call: _e.mock.On("GetSegment", segmentUUID)}
+}
+
+func (_c *API_GetSegment_Call) Run(run func(segmentUUID string)) *API_GetSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetSegment_Call) Return(_a0 *flagsmithapi.Segment, _a1 error) *API_GetSegment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetSegment_Call) RunAndReturn(run func(string) (*flagsmithapi.Segment, error)) *API_GetSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServerSideEnvKeys provides a mock function with given fields: environmentKey
+func (_m *API) GetServerSideEnvKeys(environmentKey string) ([]flagsmithapi.ServerSideEnvKey, error) {
+	ret := _m.Called(environmentKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServerSideEnvKeys")
+	}
+
+	var r0 []flagsmithapi.ServerSideEnvKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]flagsmithapi.ServerSideEnvKey, error)); ok {
+		return rf(environmentKey)
+	}
+	if rf, ok := ret.Get(0).(func(string) []flagsmithapi.ServerSideEnvKey); ok {
+		r0 = rf(environmentKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.ServerSideEnvKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(environmentKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetServerSideEnvKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServerSideEnvKeys'
+type API_GetServerSideEnvKeys_Call struct {
+	*mock.Call
+}
+
+// GetServerSideEnvKeys is a helper method to define mock.On call
+//   - environmentKey string
+func (_e *API_Expecter) GetServerSideEnvKeys(environmentKey interface{}) *API_GetServerSideEnvKeys_Call {
+	return &API_GetServerSideEnvKeys_Call{Call: _e.mock.On("GetServerSideEnvKeys", environmentKey)}
+}
+
+func (_c *API_GetServerSideEnvKeys_Call) Run(run func(environmentKey string)) *API_GetServerSideEnvKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetServerSideEnvKeys_Call) Return(_a0 []flagsmithapi.ServerSideEnvKey, _a1 error) *API_GetServerSideEnvKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetServerSideEnvKeys_Call) RunAndReturn(run func(string) ([]flagsmithapi.ServerSideEnvKey, error)) *API_GetServerSideEnvKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTag provides a mock function with given fields: projectUUID, tagUUID
+func (_m *API) GetTag(projectUUID string, tagUUID string) (*flagsmithapi.Tag, error) {
+	ret := _m.Called(projectUUID, tagUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTag")
+	}
+
+	var r0 *flagsmithapi.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*flagsmithapi.Tag, error)); ok {
+		return rf(projectUUID, tagUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *flagsmithapi.Tag); ok {
+		r0 = rf(projectUUID, tagUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(projectUUID, tagUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTag'
+type API_GetTag_Call struct {
+	*mock.Call
+}
+
+// GetTag is a helper method to define mock.On call
+//   - projectUUID string
+//   - tagUUID string
+func (_e *API_Expecter) GetTag(projectUUID interface{}, tagUUID interface{}) *API_GetTag_Call {
+	return &API_GetTag_Call{Call: _e.mock.On("GetTag", projectUUID, tagUUID)}
+}
+
+func (_c *API_GetTag_Call) Run(run func(projectUUID string, tagUUID string)) *API_GetTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *API_GetTag_Call) Return(_a0 *flagsmithapi.Tag, _a1 error) *API_GetTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetTag_Call) RunAndReturn(run func(string, string) (*flagsmithapi.Tag, error)) *API_GetTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTraits provides a mock function with given fields: environmentKey, identityID
+func (_m *API) GetTraits(environmentKey string, identityID int64) ([]flagsmithapi.Trait, error) {
+	ret := _m.Called(environmentKey, identityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTraits")
+	}
+
+	var r0 []flagsmithapi.Trait
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) ([]flagsmithapi.Trait, error)); ok {
+		return rf(environmentKey, identityID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) []flagsmithapi.Trait); ok {
+		r0 = rf(environmentKey, identityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Trait)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(environmentKey, identityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_GetTraits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTraits'
+type API_GetTraits_Call struct {
+	*mock.Call
+}
+
+// GetTraits is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+func (_e *API_Expecter) GetTraits(environmentKey interface{}, identityID interface{}) *API_GetTraits_Call {
+	return &API_GetTraits_Call{Call: _e.mock.On("GetTraits", environmentKey, identityID)}
+}
+
+func (_c *API_GetTraits_Call) Run(run func(environmentKey string, identityID int64)) *API_GetTraits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_GetTraits_Call) Return(_a0 []flagsmithapi.Trait, _a1 error) *API_GetTraits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_GetTraits_Call) RunAndReturn(run func(string, int64) ([]flagsmithapi.Trait, error)) *API_GetTraits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KillFeature provides a mock function with given fields: featureUUID, opts
+func (_m *API) KillFeature(featureUUID string, opts flagsmithapi.KillOptions) (*flagsmithapi.FeatureSnapshot, error) {
+	ret := _m.Called(featureUUID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KillFeature")
+	}
+
+	var r0 *flagsmithapi.FeatureSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, flagsmithapi.KillOptions) (*flagsmithapi.FeatureSnapshot, error)); ok {
+		return rf(featureUUID, opts)
+	}
+	if rf, ok := ret.Get(0).(func(string, flagsmithapi.KillOptions) *flagsmithapi.FeatureSnapshot); ok {
+		r0 = rf(featureUUID, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.FeatureSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, flagsmithapi.KillOptions) error); ok {
+		r1 = rf(featureUUID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_KillFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KillFeature'
+type API_KillFeature_Call struct {
+	*mock.Call
+}
+
+// KillFeature is a helper method to define mock.On call
+//   - featureUUID string
+//   - opts flagsmithapi.KillOptions
+func (_e *API_Expecter) KillFeature(featureUUID interface{}, opts interface{}) *API_KillFeature_Call {
+	return &API_KillFeature_Call{Call: _e.mock.On("KillFeature", featureUUID, opts)}
+}
+
+func (_c *API_KillFeature_Call) Run(run func(featureUUID string, opts flagsmithapi.KillOptions)) *API_KillFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(flagsmithapi.KillOptions))
+	})
+	return _c
+}
+
+func (_c *API_KillFeature_Call) Return(_a0 *flagsmithapi.FeatureSnapshot, _a1 error) *API_KillFeature_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_KillFeature_Call) RunAndReturn(run func(string, flagsmithapi.KillOptions) (*flagsmithapi.FeatureSnapshot, error)) *API_KillFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnvironmentFeatureStates provides a mock function with given fields: environmentKey
+func (_m *API) ListEnvironmentFeatureStates(environmentKey string) ([]flagsmithapi.FeatureState, error) {
+	ret := _m.Called(environmentKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnvironmentFeatureStates")
+	}
+
+	var r0 []flagsmithapi.FeatureState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]flagsmithapi.FeatureState, error)); ok {
+		return rf(environmentKey)
+	}
+	if rf, ok := ret.Get(0).(func(string) []flagsmithapi.FeatureState); ok {
+		r0 = rf(environmentKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.FeatureState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(environmentKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ListEnvironmentFeatureStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnvironmentFeatureStates'
+type API_ListEnvironmentFeatureStates_Call struct {
+	*mock.Call
+}
+
+// ListEnvironmentFeatureStates is a helper method to define mock.On call
+//   - environmentKey string
+func (_e *API_Expecter) ListEnvironmentFeatureStates(environmentKey interface{}) *API_ListEnvironmentFeatureStates_Call {
+	return &API_ListEnvironmentFeatureStates_Call{Call: _e.mock.On("ListEnvironmentFeatureStates", environmentKey)}
+}
+
+func (_c *API_ListEnvironmentFeatureStates_Call) Run(run func(environmentKey string)) *API_ListEnvironmentFeatureStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *API_ListEnvironmentFeatureStates_Call) Return(_a0 []flagsmithapi.FeatureState, _a1 error) *API_ListEnvironmentFeatureStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ListEnvironmentFeatureStates_Call) RunAndReturn(run func(string) ([]flagsmithapi.FeatureState, error)) *API_ListEnvironmentFeatureStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnvironments provides a mock function with given fields: projectID
+func (_m *API) ListEnvironments(projectID int64) ([]flagsmithapi.Environment, error) {
+	ret := _m.Called(projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnvironments")
+	}
+
+	var r0 []flagsmithapi.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]flagsmithapi.Environment, error)); ok {
+		return rf(projectID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []flagsmithapi.Environment); ok {
+		r0 = rf(projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ListEnvironments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnvironments'
+type API_ListEnvironments_Call struct {
+	*mock.Call
+}
+
+// ListEnvironments is a helper method to define mock.On call
+//   - projectID int64
+func (_e *API_Expecter) ListEnvironments(projectID interface{}) *API_ListEnvironments_Call {
+	return &API_ListEnvironments_Call{Call: _e.mock.On("ListEnvironments", projectID)}
+}
+
+func (_c *API_ListEnvironments_Call) Run(run func(projectID int64)) *API_ListEnvironments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *API_ListEnvironments_Call) Return(_a0 []flagsmithapi.Environment, _a1 error) *API_ListEnvironments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ListEnvironments_Call) RunAndReturn(run func(int64) ([]flagsmithapi.Environment, error)) *API_ListEnvironments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFeatureMVOptions provides a mock function with given fields: projectID, featureID
+func (_m *API) ListFeatureMVOptions(projectID int64, featureID int64) ([]flagsmithapi.FeatureMultivariateOption, error) {
+	ret := _m.Called(projectID, featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeatureMVOptions")
+	}
+
+	var r0 []flagsmithapi.FeatureMultivariateOption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) ([]flagsmithapi.FeatureMultivariateOption, error)); ok {
+		return rf(projectID, featureID)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) []flagsmithapi.FeatureMultivariateOption); ok {
+		r0 = rf(projectID, featureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.FeatureMultivariateOption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(projectID, featureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ListFeatureMVOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFeatureMVOptions'
+type API_ListFeatureMVOptions_Call struct {
+	*mock.Call
+}
+
+// ListFeatureMVOptions is a helper method to define mock.On call
+//   - projectID int64
+//   - featureID int64
+func (_e *API_Expecter) ListFeatureMVOptions(projectID interface{}, featureID interface{}) *API_ListFeatureMVOptions_Call {
+	return &API_ListFeatureMVOptions_Call{Call: _e.mock.On("ListFeatureMVOptions", projectID, featureID)}
+}
+
+func (_c *API_ListFeatureMVOptions_Call) Run(run func(projectID int64, featureID int64)) *API_ListFeatureMVOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_ListFeatureMVOptions_Call) Return(_a0 []flagsmithapi.FeatureMultivariateOption, _a1 error) *API_ListFeatureMVOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ListFeatureMVOptions_Call) RunAndReturn(run func(int64, int64) ([]flagsmithapi.FeatureMultivariateOption, error)) *API_ListFeatureMVOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFeatureSegments provides a mock function with given fields: environmentID, featureID
+func (_m *API) ListFeatureSegments(environmentID int64, featureID int64) ([]flagsmithapi.FeatureSegment, error) {
+	ret := _m.Called(environmentID, featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeatureSegments")
+	}
+
+	var r0 []flagsmithapi.FeatureSegment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) ([]flagsmithapi.FeatureSegment, error)); ok {
+		return rf(environmentID, featureID)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) []flagsmithapi.FeatureSegment); ok {
+		r0 = rf(environmentID, featureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.FeatureSegment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(environmentID, featureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ListFeatureSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFeatureSegments'
+type API_ListFeatureSegments_Call struct {
+	*mock.Call
+}
+
+// ListFeatureSegments is a helper method to define mock.On call
+//   - environmentID int64
+//   - featureID int64
+func (_e *API_Expecter) ListFeatureSegments(environmentID interface{}, featureID interface{}) *API_ListFeatureSegments_Call {
+	return &API_ListFeatureSegments_Call{Call: _e.mock.On("ListFeatureSegments", environmentID, featureID)}
+}
+
+func (_c *API_ListFeatureSegments_Call) Run(run func(environmentID int64, featureID int64)) *API_ListFeatureSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_ListFeatureSegments_Call) Return(_a0 []flagsmithapi.FeatureSegment, _a1 error) *API_ListFeatureSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ListFeatureSegments_Call) RunAndReturn(run func(int64, int64) ([]flagsmithapi.FeatureSegment, error)) *API_ListFeatureSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFeatures provides a mock function with given fields: projectID
+func (_m *API) ListFeatures(projectID int64) ([]flagsmithapi.Feature, error) {
+	ret := _m.Called(projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeatures")
+	}
+
+	var r0 []flagsmithapi.Feature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]flagsmithapi.Feature, error)); ok {
+		return rf(projectID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []flagsmithapi.Feature); ok {
+		r0 = rf(projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Feature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ListFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFeatures'
+type API_ListFeatures_Call struct {
+	*mock.Call
+}
+
+// ListFeatures is a helper method to define mock.On call
+//   - projectID int64
+func (_e *API_Expecter) ListFeatures(projectID interface{}) *API_ListFeatures_Call {
+	return &API_ListFeatures_Call{Call: _e.mock.On("ListFeatures", projectID)}
+}
+
+func (_c *API_ListFeatures_Call) Run(run func(projectID int64)) *API_ListFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *API_ListFeatures_Call) Return(_a0 []flagsmithapi.Feature, _a1 error) *API_ListFeatures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ListFeatures_Call) RunAndReturn(run func(int64) ([]flagsmithapi.Feature, error)) *API_ListFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIdentities provides a mock function with given fields: environmentKey, query
+func (_m *API) ListIdentities(environmentKey string, query string) ([]flagsmithapi.Identity, error) {
+	ret := _m.Called(environmentKey, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIdentities")
+	}
+
+	var r0 []flagsmithapi.Identity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]flagsmithapi.Identity, error)); ok {
+		return rf(environmentKey, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []flagsmithapi.Identity); ok {
+		r0 = rf(environmentKey, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Identity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(environmentKey, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ListIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIdentities'
+type API_ListIdentities_Call struct {
+	*mock.Call
+}
+
+// ListIdentities is a helper method to define mock.On call
+//   - environmentKey string
+//   - query string
+func (_e *API_Expecter) ListIdentities(environmentKey interface{}, query interface{}) *API_ListIdentities_Call {
+	return &API_ListIdentities_Call{Call: _e.mock.On("ListIdentities", environmentKey, query)}
+}
+
+func (_c *API_ListIdentities_Call) Run(run func(environmentKey string, query string)) *API_ListIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *API_ListIdentities_Call) Return(_a0 []flagsmithapi.Identity, _a1 error) *API_ListIdentities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ListIdentities_Call) RunAndReturn(run func(string, string) ([]flagsmithapi.Identity, error)) *API_ListIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIdentityOverrides provides a mock function with given fields: environmentKey, identityID
+func (_m *API) ListIdentityOverrides(environmentKey string, identityID int64) ([]flagsmithapi.FeatureState, error) {
+	ret := _m.Called(environmentKey, identityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIdentityOverrides")
+	}
+
+	var r0 []flagsmithapi.FeatureState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) ([]flagsmithapi.FeatureState, error)); ok {
+		return rf(environmentKey, identityID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) []flagsmithapi.FeatureState); ok {
+		r0 = rf(environmentKey, identityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.FeatureState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(environmentKey, identityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ListIdentityOverrides_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIdentityOverrides'
+type API_ListIdentityOverrides_Call struct {
+	*mock.Call
+}
+
+// ListIdentityOverrides is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+func (_e *API_Expecter) ListIdentityOverrides(environmentKey interface{}, identityID interface{}) *API_ListIdentityOverrides_Call {
+	return &API_ListIdentityOverrides_Call{Call: _e.mock.On("ListIdentityOverrides", environmentKey, identityID)}
+}
+
+func (_c *API_ListIdentityOverrides_Call) Run(run func(environmentKey string, identityID int64)) *API_ListIdentityOverrides_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_ListIdentityOverrides_Call) Return(_a0 []flagsmithapi.FeatureState, _a1 error) *API_ListIdentityOverrides_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ListIdentityOverrides_Call) RunAndReturn(run func(string, int64) ([]flagsmithapi.FeatureState, error)) *API_ListIdentityOverrides_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProjects provides a mock function with given fields: organisationID
+func (_m *API) ListProjects(organisationID int64) ([]flagsmithapi.Project, error) {
+	ret := _m.Called(organisationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjects")
+	}
+
+	var r0 []flagsmithapi.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]flagsmithapi.Project, error)); ok {
+		return rf(organisationID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []flagsmithapi.Project); ok {
+		r0 = rf(organisationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(organisationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ListProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjects'
+type API_ListProjects_Call struct {
+	*mock.Call
+}
+
+// ListProjects is a helper method to define mock.On call
+//   - organisationID int64
+func (_e *API_Expecter) ListProjects(organisationID interface{}) *API_ListProjects_Call {
+	return &API_ListProjects_Call{Call: _e.mock.On("ListProjects", organisationID)}
+}
+
+func (_c *API_ListProjects_Call) Run(run func(organisationID int64)) *API_ListProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *API_ListProjects_Call) Return(_a0 []flagsmithapi.Project, _a1 error) *API_ListProjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ListProjects_Call) RunAndReturn(run func(int64) ([]flagsmithapi.Project, error)) *API_ListProjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSegmentOverrides provides a mock function with given fields: environmentKey, featureID
+func (_m *API) ListSegmentOverrides(environmentKey string, featureID int64) ([]flagsmithapi.FeatureState, error) {
+	ret := _m.Called(environmentKey, featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSegmentOverrides")
+	}
+
+	var r0 []flagsmithapi.FeatureState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) ([]flagsmithapi.FeatureState, error)); ok {
+		return rf(environmentKey, featureID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) []flagsmithapi.FeatureState); ok {
+		r0 = rf(environmentKey, featureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.FeatureState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(environmentKey, featureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ListSegmentOverrides_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSegmentOverrides'
+type API_ListSegmentOverrides_Call struct {
+	*mock.Call
+}
+
+// ListSegmentOverrides is a helper method to define mock.On call
+//   - environmentKey string
+//   - featureID int64
+func (_e *API_Expecter) ListSegmentOverrides(environmentKey interface{}, featureID interface{}) *API_ListSegmentOverrides_Call {
+	return &API_ListSegmentOverrides_Call{Call: _e.mock.On("ListSegmentOverrides", environmentKey, featureID)}
+}
+
+func (_c *API_ListSegmentOverrides_Call) Run(run func(environmentKey string, featureID int64)) *API_ListSegmentOverrides_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_ListSegmentOverrides_Call) Return(_a0 []flagsmithapi.FeatureState, _a1 error) *API_ListSegmentOverrides_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ListSegmentOverrides_Call) RunAndReturn(run func(string, int64) ([]flagsmithapi.FeatureState, error)) *API_ListSegmentOverrides_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSegments provides a mock function with given fields: projectID
+func (_m *API) ListSegments(projectID int64) ([]flagsmithapi.Segment, error) {
+	ret := _m.Called(projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSegments")
+	}
+
+	var r0 []flagsmithapi.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]flagsmithapi.Segment, error)); ok {
+		return rf(projectID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []flagsmithapi.Segment); ok {
+		r0 = rf(projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ListSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSegments'
+type API_ListSegments_Call struct {
+	*mock.Call
+}
+
+// ListSegments is a helper method to define mock.On call
+//   - projectID int64
+func (_e *API_Expecter) ListSegments(projectID interface{}) *API_ListSegments_Call {
+	return &API_ListSegments_Call{Call: _e.mock.On("ListSegments", projectID)}
+}
+
+func (_c *API_ListSegments_Call) Run(run func(projectID int64)) *API_ListSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *API_ListSegments_Call) Return(_a0 []flagsmithapi.Segment, _a1 error) *API_ListSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ListSegments_Call) RunAndReturn(run func(int64) ([]flagsmithapi.Segment, error)) *API_ListSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTags provides a mock function with given fields: projectID
+func (_m *API) ListTags(projectID int64) ([]flagsmithapi.Tag, error) {
+	ret := _m.Called(projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 []flagsmithapi.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]flagsmithapi.Tag, error)); ok {
+		return rf(projectID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []flagsmithapi.Tag); ok {
+		r0 = rf(projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type API_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - projectID int64
+func (_e *API_Expecter) ListTags(projectID interface{}) *API_ListTags_Call {
+	return &API_ListTags_Call{Call: _e.mock.On("ListTags", projectID)}
+}
+
+func (_c *API_ListTags_Call) Run(run func(projectID int64)) *API_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *API_ListTags_Call) Return(_a0 []flagsmithapi.Tag, _a1 error) *API_ListTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_ListTags_Call) RunAndReturn(run func(int64) ([]flagsmithapi.Tag, error)) *API_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteEnvironment provides a mock function with given fields: sourceKey, destinationKey, opts
+func (_m *API) PromoteEnvironment(sourceKey string, destinationKey string, opts flagsmithapi.PromoteOptions) (*flagsmithapi.Promotion, error) {
+	ret := _m.Called(sourceKey, destinationKey, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteEnvironment")
+	}
+
+	var r0 *flagsmithapi.Promotion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, flagsmithapi.PromoteOptions) (*flagsmithapi.Promotion, error)); ok {
+		return rf(sourceKey, destinationKey, opts)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, flagsmithapi.PromoteOptions) *flagsmithapi.Promotion); ok {
+		r0 = rf(sourceKey, destinationKey, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Promotion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, flagsmithapi.PromoteOptions) error); ok {
+		r1 = rf(sourceKey, destinationKey, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_PromoteEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteEnvironment'
+type API_PromoteEnvironment_Call struct {
+	*mock.Call
+}
+
+// PromoteEnvironment is a helper method to define mock.On call
+//   - sourceKey string
+//   - destinationKey string
+//   - opts flagsmithapi.PromoteOptions
+func (_e *API_Expecter) PromoteEnvironment(sourceKey interface{}, destinationKey interface{}, opts interface{}) *API_PromoteEnvironment_Call {
+	return &API_PromoteEnvironment_Call{Call: _e.mock.On("PromoteEnvironment", sourceKey, destinationKey, opts)}
+}
+
+func (_c *API_PromoteEnvironment_Call) Run(run func(sourceKey string, destinationKey string, opts flagsmithapi.PromoteOptions)) *API_PromoteEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(flagsmithapi.PromoteOptions))
+	})
+	return _c
+}
+
+func (_c *API_PromoteEnvironment_Call) Return(_a0 *flagsmithapi.Promotion, _a1 error) *API_PromoteEnvironment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_PromoteEnvironment_Call) RunAndReturn(run func(string, string, flagsmithapi.PromoteOptions) (*flagsmithapi.Promotion, error)) *API_PromoteEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFeatureGroupOwners provides a mock function with given fields: feature, groupIDs
+func (_m *API) RemoveFeatureGroupOwners(feature *flagsmithapi.Feature, groupIDs []int64) error {
+	ret := _m.Called(feature, groupIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFeatureGroupOwners")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature, []int64) error); ok {
+		r0 = rf(feature, groupIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_RemoveFeatureGroupOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFeatureGroupOwners'
+type API_RemoveFeatureGroupOwners_Call struct {
+	*mock.Call
+}
+
+// RemoveFeatureGroupOwners is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+//   - groupIDs []int64
+func (_e *API_Expecter) RemoveFeatureGroupOwners(feature interface{}, groupIDs interface{}) *API_RemoveFeatureGroupOwners_Call {
+	return &API_RemoveFeatureGroupOwners_Call{Call: _e.mock.On("RemoveFeatureGroupOwners", feature, groupIDs)}
+}
+
+func (_c *API_RemoveFeatureGroupOwners_Call) Run(run func(feature *flagsmithapi.Feature, groupIDs []int64)) *API_RemoveFeatureGroupOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *API_RemoveFeatureGroupOwners_Call) Return(_a0 error) *API_RemoveFeatureGroupOwners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_RemoveFeatureGroupOwners_Call) RunAndReturn(run func(*flagsmithapi.Feature, []int64) error) *API_RemoveFeatureGroupOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFeatureOwners provides a mock function with given fields: feature, ownerIDs
+func (_m *API) RemoveFeatureOwners(feature *flagsmithapi.Feature, ownerIDs []int64) error {
+	ret := _m.Called(feature, ownerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFeatureOwners")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature, []int64) error); ok {
+		r0 = rf(feature, ownerIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_RemoveFeatureOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFeatureOwners'
+type API_RemoveFeatureOwners_Call struct {
+	*mock.Call
+}
+
+// RemoveFeatureOwners is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+//   - ownerIDs []int64
+func (_e *API_Expecter) RemoveFeatureOwners(feature interface{}, ownerIDs interface{}) *API_RemoveFeatureOwners_Call {
+	return &API_RemoveFeatureOwners_Call{Call: _e.mock.On("RemoveFeatureOwners", feature, ownerIDs)}
+}
+
+func (_c *API_RemoveFeatureOwners_Call) Run(run func(feature *flagsmithapi.Feature, ownerIDs []int64)) *API_RemoveFeatureOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *API_RemoveFeatureOwners_Call) Return(_a0 error) *API_RemoveFeatureOwners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_RemoveFeatureOwners_Call) RunAndReturn(run func(*flagsmithapi.Feature, []int64) error) *API_RemoveFeatureOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreFeature provides a mock function with given fields: snapshot
+func (_m *API) RestoreFeature(snapshot *flagsmithapi.FeatureSnapshot) error {
+	ret := _m.Called(snapshot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreFeature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.FeatureSnapshot) error); ok {
+		r0 = rf(snapshot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_RestoreFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreFeature'
+type API_RestoreFeature_Call struct {
+	*mock.Call
+}
+
+// RestoreFeature is a helper method to define mock.On call
+//   - snapshot *flagsmithapi.FeatureSnapshot
+func (_e *API_Expecter) RestoreFeature(snapshot interface{}) *API_RestoreFeature_Call {
+	return &API_RestoreFeature_Call{Call: _e.mock.On("RestoreFeature", snapshot)}
+}
+
+func (_c *API_RestoreFeature_Call) Run(run func(snapshot *flagsmithapi.FeatureSnapshot)) *API_RestoreFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.FeatureSnapshot))
+	})
+	return _c
+}
+
+func (_c *API_RestoreFeature_Call) Return(_a0 error) *API_RestoreFeature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_RestoreFeature_Call) RunAndReturn(run func(*flagsmithapi.FeatureSnapshot) error) *API_RestoreFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEnvironment provides a mock function with given fields: environment
+func (_m *API) UpdateEnvironment(environment *flagsmithapi.Environment) error {
+	ret := _m.Called(environment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEnvironment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Environment) error); ok {
+		r0 = rf(environment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_UpdateEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnvironment'
+type API_UpdateEnvironment_Call struct {
+	*mock.Call
+}
+
+// UpdateEnvironment is a helper method to define mock.On call
+//   - environment *flagsmithapi.Environment
+func (_e *API_Expecter) UpdateEnvironment(environment interface{}) *API_UpdateEnvironment_Call {
+	return &API_UpdateEnvironment_Call{Call: _e.mock.On("UpdateEnvironment", environment)}
+}
+
+func (_c *API_UpdateEnvironment_Call) Run(run func(environment *flagsmithapi.Environment)) *API_UpdateEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Environment))
+	})
+	return _c
+}
+
+func (_c *API_UpdateEnvironment_Call) Return(_a0 error) *API_UpdateEnvironment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_UpdateEnvironment_Call) RunAndReturn(run func(*flagsmithapi.Environment) error) *API_UpdateEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeature provides a mock function with given fields: feature
+func (_m *API) UpdateFeature(feature *flagsmithapi.Feature) error {
+	ret := _m.Called(feature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature) error); ok {
+		r0 = rf(feature)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_UpdateFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeature'
+type API_UpdateFeature_Call struct {
+	*mock.Call
+}
+
+// UpdateFeature is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+func (_e *API_Expecter) UpdateFeature(feature interface{}) *API_UpdateFeature_Call {
+	return &API_UpdateFeature_Call{Call: _e.mock.On("UpdateFeature", feature)}
+}
+
+func (_c *API_UpdateFeature_Call) Run(run func(feature *flagsmithapi.Feature)) *API_UpdateFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature))
+	})
+	return _c
+}
+
+func (_c *API_UpdateFeature_Call) Return(_a0 error) *API_UpdateFeature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_UpdateFeature_Call) RunAndReturn(run func(*flagsmithapi.Feature) error) *API_UpdateFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeatureMVOption provides a mock function with given fields: featureMVOption
+func (_m *API) UpdateFeatureMVOption(featureMVOption *flagsmithapi.FeatureMultivariateOption) error {
+	ret := _m.Called(featureMVOption)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeatureMVOption")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.FeatureMultivariateOption) error); ok {
+		r0 = rf(featureMVOption)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_UpdateFeatureMVOption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeatureMVOption'
+type API_UpdateFeatureMVOption_Call struct {
+	*mock.Call
+}
+
+// UpdateFeatureMVOption is a helper method to define mock.On call
+//   - featureMVOption *flagsmithapi.FeatureMultivariateOption
+func (_e *API_Expecter) UpdateFeatureMVOption(featureMVOption interface{}) *API_UpdateFeatureMVOption_Call {
+	return &API_UpdateFeatureMVOption_Call{Call: _e.mock.On("UpdateFeatureMVOption", featureMVOption)}
+}
+
+func (_c *API_UpdateFeatureMVOption_Call) Run(run func(featureMVOption *flagsmithapi.FeatureMultivariateOption)) *API_UpdateFeatureMVOption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.FeatureMultivariateOption))
+	})
+	return _c
+}
+
+func (_c *API_UpdateFeatureMVOption_Call) Return(_a0 error) *API_UpdateFeatureMVOption_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_UpdateFeatureMVOption_Call) RunAndReturn(run func(*flagsmithapi.FeatureMultivariateOption) error) *API_UpdateFeatureMVOption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeatureSegmentPriority provides a mock function with given fields: featureSegmentID, priority
+func (_m *API) UpdateFeatureSegmentPriority(featureSegmentID int64, priority int64) error {
+	ret := _m.Called(featureSegmentID, priority)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeatureSegmentPriority")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(featureSegmentID, priority)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_UpdateFeatureSegmentPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeatureSegmentPriority'
+type API_UpdateFeatureSegmentPriority_Call struct {
+	*mock.Call
+}
+
+// UpdateFeatureSegmentPriority is a helper method to define mock.On call
+//   - featureSegmentID int64
+//   - priority int64
+func (_e *API_Expecter) UpdateFeatureSegmentPriority(featureSegmentID interface{}, priority interface{}) *API_UpdateFeatureSegmentPriority_Call {
+	return &API_UpdateFeatureSegmentPriority_Call{Call: _e.mock.On("UpdateFeatureSegmentPriority", featureSegmentID, priority)}
+}
+
+func (_c *API_UpdateFeatureSegmentPriority_Call) Run(run func(featureSegmentID int64, priority int64)) *API_UpdateFeatureSegmentPriority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *API_UpdateFeatureSegmentPriority_Call) Return(_a0 error) *API_UpdateFeatureSegmentPriority_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_UpdateFeatureSegmentPriority_Call) RunAndReturn(run func(int64, int64) error) *API_UpdateFeatureSegmentPriority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeatureState provides a mock function with given fields: featureState, updateSegmentPriority
+func (_m *API) UpdateFeatureState(featureState *flagsmithapi.FeatureState, updateSegmentPriority bool) error {
+	ret := _m.Called(featureState, updateSegmentPriority)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeatureState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.FeatureState, bool) error); ok {
+		r0 = rf(featureState, updateSegmentPriority)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_UpdateFeatureState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeatureState'
+type API_UpdateFeatureState_Call struct {
+	*mock.Call
+}
+
+// UpdateFeatureState is a helper method to define mock.On call
+//   - featureState *flagsmithapi.FeatureState
+//   - updateSegmentPriority bool
+func (_e *API_Expecter) UpdateFeatureState(featureState interface{}, updateSegmentPriority interface{}) *API_UpdateFeatureState_Call {
+	return &API_UpdateFeatureState_Call{Call: _e.mock.On("UpdateFeatureState", featureState, updateSegmentPriority)}
+}
+
+func (_c *API_UpdateFeatureState_Call) Run(run func(featureState *flagsmithapi.FeatureState, updateSegmentPriority bool)) *API_UpdateFeatureState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.FeatureState), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *API_UpdateFeatureState_Call) Return(_a0 error) *API_UpdateFeatureState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_UpdateFeatureState_Call) RunAndReturn(run func(*flagsmithapi.FeatureState, bool) error) *API_UpdateFeatureState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeatureStates provides a mock function with given fields: changes, opts
+func (_m *API) UpdateFeatureStates(changes []flagsmithapi.FeatureStateChange, opts flagsmithapi.BulkUpdateOptions) (*flagsmithapi.BulkUpdateResult, error) {
+	ret := _m.Called(changes, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeatureStates")
+	}
+
+	var r0 *flagsmithapi.BulkUpdateResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]flagsmithapi.FeatureStateChange, flagsmithapi.BulkUpdateOptions) (*flagsmithapi.BulkUpdateResult, error)); ok {
+		return rf(changes, opts)
+	}
+	if rf, ok := ret.Get(0).(func([]flagsmithapi.FeatureStateChange, flagsmithapi.BulkUpdateOptions) *flagsmithapi.BulkUpdateResult); ok {
+		r0 = rf(changes, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.BulkUpdateResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]flagsmithapi.FeatureStateChange, flagsmithapi.BulkUpdateOptions) error); ok {
+		r1 = rf(changes, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// API_UpdateFeatureStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeatureStates'
+type API_UpdateFeatureStates_Call struct {
+	*mock.Call
+}
+
+// UpdateFeatureStates is a helper method to define mock.On call
+//   - changes []flagsmithapi.FeatureStateChange
+//   - opts flagsmithapi.BulkUpdateOptions
+func (_e *API_Expecter) UpdateFeatureStates(changes interface{}, opts interface{}) *API_UpdateFeatureStates_Call {
+	return &API_UpdateFeatureStates_Call{Call: _e.mock.On("UpdateFeatureStates", changes, opts)}
+}
+
+func (_c *API_UpdateFeatureStates_Call) Run(run func(changes []flagsmithapi.FeatureStateChange, opts flagsmithapi.BulkUpdateOptions)) *API_UpdateFeatureStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]flagsmithapi.FeatureStateChange), args[1].(flagsmithapi.BulkUpdateOptions))
+	})
+	return _c
+}
+
+func (_c *API_UpdateFeatureStates_Call) Return(_a0 *flagsmithapi.BulkUpdateResult, _a1 error) *API_UpdateFeatureStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *API_UpdateFeatureStates_Call) RunAndReturn(run func([]flagsmithapi.FeatureStateChange, flagsmithapi.BulkUpdateOptions) (*flagsmithapi.BulkUpdateResult, error)) *API_UpdateFeatureStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateIdentityOverride provides a mock function with given fields: environmentKey, identityID, featureState
+func (_m *API) UpdateIdentityOverride(environmentKey string, identityID int64, featureState *flagsmithapi.FeatureState) error {
+	ret := _m.Called(environmentKey, identityID, featureState)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateIdentityOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, *flagsmithapi.FeatureState) error); ok {
+		r0 = rf(environmentKey, identityID, featureState)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_UpdateIdentityOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateIdentityOverride'
+type API_UpdateIdentityOverride_Call struct {
+	*mock.Call
+}
+
+// UpdateIdentityOverride is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - featureState *flagsmithapi.FeatureState
+func (_e *API_Expecter) UpdateIdentityOverride(environmentKey interface{}, identityID interface{}, featureState interface{}) *API_UpdateIdentityOverride_Call {
+	return &API_UpdateIdentityOverride_Call{Call: _e.mock.On("UpdateIdentityOverride", environmentKey, identityID, featureState)}
+}
+
+func (_c *API_UpdateIdentityOverride_Call) Run(run func(environmentKey string, identityID int64, featureState *flagsmithapi.FeatureState)) *API_UpdateIdentityOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(*flagsmithapi.FeatureState))
+	})
+	return _c
+}
+
+func (_c *API_UpdateIdentityOverride_Call) Return(_a0 error) *API_UpdateIdentityOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_UpdateIdentityOverride_Call) RunAndReturn(run func(string, int64, *flagsmithapi.FeatureState) error) *API_UpdateIdentityOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProject provides a mock function with given fields: project
+func (_m *API) UpdateProject(project *flagsmithapi.Project) error {
+	ret := _m.Called(project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Project) error); ok {
+		r0 = rf(project)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_UpdateProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProject'
+type API_UpdateProject_Call struct {
+	*mock.Call
+}
+
+// UpdateProject is a helper method to define mock.On call
+//   - project *flagsmithapi.Project
+func (_e *API_Expecter) UpdateProject(project interface{}) *API_UpdateProject_Call {
+	return &API_UpdateProject_Call{Call: _e.mock.On("UpdateProject", project)}
+}
+
+func (_c *API_UpdateProject_Call) Run(run func(project *flagsmithapi.Project)) *API_UpdateProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Project))
+	})
+	return _c
+}
+
+func (_c *API_UpdateProject_Call) Return(_a0 error) *API_UpdateProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_UpdateProject_Call) RunAndReturn(run func(*flagsmithapi.Project) error) *API_UpdateProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSegment provides a mock function with given fields: segment
+func (_m *API) UpdateSegment(segment *flagsmithapi.Segment) error {
+	ret := _m.Called(segment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSegment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Segment) error); ok {
+		r0 = rf(segment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_UpdateSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSegment'
+type API_UpdateSegment_Call struct {
+	*mock.Call
+}
+
+// UpdateSegment is a helper method to define mock.On call
+//   - segment *flagsmithapi.Segment
+func (_e *API_Expecter) UpdateSegment(segment interface{}) *API_UpdateSegment_Call {
+	return &API_UpdateSegment_Call{Call: _e.mock.On("UpdateSegment", segment)}
+}
+
+func (_c *API_UpdateSegment_Call) Run(run func(segment *flagsmithapi.Segment)) *API_UpdateSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Segment))
+	})
+	return _c
+}
+
+func (_c *API_UpdateSegment_Call) Return(_a0 error) *API_UpdateSegment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_UpdateSegment_Call) RunAndReturn(run func(*flagsmithapi.Segment) error) *API_UpdateSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateServerSideEnvKey provides a mock function with given fields: environmentKey, key
+func (_m *API) UpdateServerSideEnvKey(environmentKey string, key *flagsmithapi.ServerSideEnvKey) error {
+	ret := _m.Called(environmentKey, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateServerSideEnvKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *flagsmithapi.ServerSideEnvKey) error); ok {
+		r0 = rf(environmentKey, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_UpdateServerSideEnvKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateServerSideEnvKey'
+type API_UpdateServerSideEnvKey_Call struct {
+	*mock.Call
+}
+
+// UpdateServerSideEnvKey is a helper method to define mock.On call
+//   - environmentKey string
+//   - key *flagsmithapi.ServerSideEnvKey
+func (_e *API_Expecter) UpdateServerSideEnvKey(environmentKey interface{}, key interface{}) *API_UpdateServerSideEnvKey_Call {
+	return &API_UpdateServerSideEnvKey_Call{Call: _e.mock.On("UpdateServerSideEnvKey", environmentKey, key)}
+}
+
+func (_c *API_UpdateServerSideEnvKey_Call) Run(run func(environmentKey string, key *flagsmithapi.ServerSideEnvKey)) *API_UpdateServerSideEnvKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*flagsmithapi.ServerSideEnvKey))
+	})
+	return _c
+}
+
+func (_c *API_UpdateServerSideEnvKey_Call) Return(_a0 error) *API_UpdateServerSideEnvKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_UpdateServerSideEnvKey_Call) RunAndReturn(run func(string, *flagsmithapi.ServerSideEnvKey) error) *API_UpdateServerSideEnvKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: tag
+func (_m *API) UpdateTag(tag *flagsmithapi.Tag) error {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Tag) error); ok {
+		r0 = rf(tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type API_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - tag *flagsmithapi.Tag
+func (_e *API_Expecter) UpdateTag(tag interface{}) *API_UpdateTag_Call {
+	return &API_UpdateTag_Call{Call: _e.mock.On("UpdateTag", tag)}
+}
+
+func (_c *API_UpdateTag_Call) Run(run func(tag *flagsmithapi.Tag)) *API_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Tag))
+	})
+	return _c
+}
+
+func (_c *API_UpdateTag_Call) Return(_a0 error) *API_UpdateTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_UpdateTag_Call) RunAndReturn(run func(*flagsmithapi.Tag) error) *API_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTrait provides a mock function with given fields: environmentKey, identityID, trait
+func (_m *API) UpdateTrait(environmentKey string, identityID int64, trait *flagsmithapi.Trait) error {
+	ret := _m.Called(environmentKey, identityID, trait)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTrait")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, *flagsmithapi.Trait) error); ok {
+		r0 = rf(environmentKey, identityID, trait)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// API_UpdateTrait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTrait'
+type API_UpdateTrait_Call struct {
+	*mock.Call
+}
+
+// UpdateTrait is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - trait *flagsmithapi.Trait
+func (_e *API_Expecter) UpdateTrait(environmentKey interface{}, identityID interface{}, trait interface{}) *API_UpdateTrait_Call {
+	return &API_UpdateTrait_Call{Call: _e.mock.On("UpdateTrait", environmentKey, identityID, trait)}
+}
+
+func (_c *API_UpdateTrait_Call) Run(run func(environmentKey string, identityID int64, trait *flagsmithapi.Trait)) *API_UpdateTrait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(*flagsmithapi.Trait))
+	})
+	return _c
+}
+
+func (_c *API_UpdateTrait_Call) Return(_a0 error) *API_UpdateTrait_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *API_UpdateTrait_Call) RunAndReturn(run func(string, int64, *flagsmithapi.Trait) error) *API_UpdateTrait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAPI creates a new instance of API. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *API {
+	mock := &API{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,749 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EnvironmentAPI is an autogenerated mock type for the EnvironmentAPI type
+type EnvironmentAPI struct {
+	mock.Mock
+}
+
+type EnvironmentAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EnvironmentAPI) EXPECT() *EnvironmentAPI_Expecter {
+	return &EnvironmentAPI_Expecter{mock: &_m.Mock}
+}
+
+// CloneEnvironment provides a mock function with given fields: sourceKey, name, serverSideKeys
+func (_m *EnvironmentAPI) CloneEnvironment(sourceKey string, name string, serverSideKeys ...*flagsmithapi.ServerSideEnvKey) (*flagsmithapi.Environment, error) {
+	_va := make([]interface{}, len(serverSideKeys))
+	for _i := range serverSideKeys {
+		_va[_i] = serverSideKeys[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, sourceKey, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneEnvironment")
+	}
+
+	var r0 *flagsmithapi.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...*flagsmithapi.ServerSideEnvKey) (*flagsmithapi.Environment, error)); ok {
+		return rf(sourceKey, name, serverSideKeys...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...*flagsmithapi.ServerSideEnvKey) *flagsmithapi.Environment); ok {
+		r0 = rf(sourceKey, name, serverSideKeys...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...*flagsmithapi.ServerSideEnvKey) error); ok {
+		r1 = rf(sourceKey, name, serverSideKeys...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnvironmentAPI_CloneEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneEnvironment'
+type EnvironmentAPI_CloneEnvironment_Call struct {
+	*mock.Call
+}
+
+// CloneEnvironment is a helper method to define mock.On call
+//   - sourceKey string
+//   - name string
+//   - serverSideKeys ...*flagsmithapi.ServerSideEnvKey
+func (_e *EnvironmentAPI_Expecter) CloneEnvironment(sourceKey interface{}, name interface{}, serverSideKeys ...interface{}) *EnvironmentAPI_CloneEnvironment_Call {
+	return &EnvironmentAPI_CloneEnvironment_Call{Call: _e.mock.On("CloneEnvironment",
+		append([]interface{}{sourceKey, name}, serverSideKeys...)...)}
+}
+
+func (_c *EnvironmentAPI_CloneEnvironment_Call) Run(run func(sourceKey string, name string, serverSideKeys ...*flagsmithapi.ServerSideEnvKey)) *EnvironmentAPI_CloneEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*flagsmithapi.ServerSideEnvKey, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(*flagsmithapi.ServerSideEnvKey)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_CloneEnvironment_Call) Return(_a0 *flagsmithapi.Environment, _a1 error) *EnvironmentAPI_CloneEnvironment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EnvironmentAPI_CloneEnvironment_Call) RunAndReturn(run func(string, string, ...*flagsmithapi.ServerSideEnvKey) (*flagsmithapi.Environment, error)) *EnvironmentAPI_CloneEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompareEnvironments provides a mock function with given fields: environmentKeys
+func (_m *EnvironmentAPI) CompareEnvironments(environmentKeys ...string) (*flagsmithapi.EnvironmentComparison, error) {
+	_va := make([]interface{}, len(environmentKeys))
+	for _i := range environmentKeys {
+		_va[_i] = environmentKeys[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompareEnvironments")
+	}
+
+	var r0 *flagsmithapi.EnvironmentComparison
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...string) (*flagsmithapi.EnvironmentComparison, error)); ok {
+		return rf(environmentKeys...)
+	}
+	if rf, ok := ret.Get(0).(func(...string) *flagsmithapi.EnvironmentComparison); ok {
+		r0 = rf(environmentKeys...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.EnvironmentComparison)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(environmentKeys...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnvironmentAPI_CompareEnvironments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompareEnvironments'
+type EnvironmentAPI_CompareEnvironments_Call struct {
+	*mock.Call
+}
+
+// CompareEnvironments is a helper method to define mock.On call
+//   - environmentKeys ...string
+func (_e *EnvironmentAPI_Expecter) CompareEnvironments(environmentKeys ...interface{}) *EnvironmentAPI_CompareEnvironments_Call {
+	return &EnvironmentAPI_CompareEnvironments_Call{Call: _e.mock.On("CompareEnvironments",
+		append([]interface{}{}, environmentKeys...)...)}
+}
+
+func (_c *EnvironmentAPI_CompareEnvironments_Call) Run(run func(environmentKeys ...string)) *EnvironmentAPI_CompareEnvironments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_CompareEnvironments_Call) Return(_a0 *flagsmithapi.EnvironmentComparison, _a1 error) *EnvironmentAPI_CompareEnvironments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EnvironmentAPI_CompareEnvironments_Call) RunAndReturn(run func(...string) (*flagsmithapi.EnvironmentComparison, error)) *EnvironmentAPI_CompareEnvironments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEnvironment provides a mock function with given fields: environment
+func (_m *EnvironmentAPI) CreateEnvironment(environment *flagsmithapi.Environment) error {
+	ret := _m.Called(environment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEnvironment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Environment) error); ok {
+		r0 = rf(environment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnvironmentAPI_CreateEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEnvironment'
+type EnvironmentAPI_CreateEnvironment_Call struct {
+	*mock.Call
+}
+
+// CreateEnvironment is a helper method to define mock.On call
+//   - environment *flagsmithapi.Environment
+func (_e *EnvironmentAPI_Expecter) CreateEnvironment(environment interface{}) *EnvironmentAPI_CreateEnvironment_Call {
+	return &EnvironmentAPI_CreateEnvironment_Call{Call: _e.mock.On("CreateEnvironment", environment)}
+}
+
+func (_c *EnvironmentAPI_CreateEnvironment_Call) Run(run func(environment *flagsmithapi.Environment)) *EnvironmentAPI_CreateEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Environment))
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_CreateEnvironment_Call) Return(_a0 error) *EnvironmentAPI_CreateEnvironment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EnvironmentAPI_CreateEnvironment_Call) RunAndReturn(run func(*flagsmithapi.Environment) error) *EnvironmentAPI_CreateEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateServerSideEnvKey provides a mock function with given fields: environmentKey, key
+func (_m *EnvironmentAPI) CreateServerSideEnvKey(environmentKey string, key *flagsmithapi.ServerSideEnvKey) error {
+	ret := _m.Called(environmentKey, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateServerSideEnvKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *flagsmithapi.ServerSideEnvKey) error); ok {
+		r0 = rf(environmentKey, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnvironmentAPI_CreateServerSideEnvKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateServerSideEnvKey'
+type EnvironmentAPI_CreateServerSideEnvKey_Call struct {
+	*mock.Call
+}
+
+// CreateServerSideEnvKey is a helper method to define mock.On call
+//   - environmentKey string
+//   - key *flagsmithapi.ServerSideEnvKey
+func (_e *EnvironmentAPI_Expecter) CreateServerSideEnvKey(environmentKey interface{}, key interface{}) *EnvironmentAPI_CreateServerSideEnvKey_Call {
+	return &EnvironmentAPI_CreateServerSideEnvKey_Call{Call: _e.mock.On("CreateServerSideEnvKey", environmentKey, key)}
+}
+
+func (_c *EnvironmentAPI_CreateServerSideEnvKey_Call) Run(run func(environmentKey string, key *flagsmithapi.ServerSideEnvKey)) *EnvironmentAPI_CreateServerSideEnvKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*flagsmithapi.ServerSideEnvKey))
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_CreateServerSideEnvKey_Call) Return(_a0 error) *EnvironmentAPI_CreateServerSideEnvKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EnvironmentAPI_CreateServerSideEnvKey_Call) RunAndReturn(run func(string, *flagsmithapi.ServerSideEnvKey) error) *EnvironmentAPI_CreateServerSideEnvKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEnvironment provides a mock function with given fields: apiKey
+func (_m *EnvironmentAPI) DeleteEnvironment(apiKey string) error {
+	ret := _m.Called(apiKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEnvironment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(apiKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnvironmentAPI_DeleteEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEnvironment'
+type EnvironmentAPI_DeleteEnvironment_Call struct {
+	*mock.Call
+}
+
+// DeleteEnvironment is a helper method to define mock.On call
+//   - apiKey string
+func (_e *EnvironmentAPI_Expecter) DeleteEnvironment(apiKey interface{}) *EnvironmentAPI_DeleteEnvironment_Call {
+	return &EnvironmentAPI_DeleteEnvironment_Call{Call: _e.mock.On("DeleteEnvironment", apiKey)}
+}
+
+func (_c *EnvironmentAPI_DeleteEnvironment_Call) Run(run func(apiKey string)) *EnvironmentAPI_DeleteEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_DeleteEnvironment_Call) Return(_a0 error) *EnvironmentAPI_DeleteEnvironment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EnvironmentAPI_DeleteEnvironment_Call) RunAndReturn(run func(string) error) *EnvironmentAPI_DeleteEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServerSideEnvKey provides a mock function with given fields: environmentKey, keyID
+func (_m *EnvironmentAPI) DeleteServerSideEnvKey(environmentKey string, keyID int64) error {
+	ret := _m.Called(environmentKey, keyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServerSideEnvKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(environmentKey, keyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnvironmentAPI_DeleteServerSideEnvKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServerSideEnvKey'
+type EnvironmentAPI_DeleteServerSideEnvKey_Call struct {
+	*mock.Call
+}
+
+// DeleteServerSideEnvKey is a helper method to define mock.On call
+//   - environmentKey string
+//   - keyID int64
+func (_e *EnvironmentAPI_Expecter) DeleteServerSideEnvKey(environmentKey interface{}, keyID interface{}) *EnvironmentAPI_DeleteServerSideEnvKey_Call {
+	return &EnvironmentAPI_DeleteServerSideEnvKey_Call{Call: _e.mock.On("DeleteServerSideEnvKey", environmentKey, keyID)}
+}
+
+func (_c *EnvironmentAPI_DeleteServerSideEnvKey_Call) Run(run func(environmentKey string, keyID int64)) *EnvironmentAPI_DeleteServerSideEnvKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_DeleteServerSideEnvKey_Call) Return(_a0 error) *EnvironmentAPI_DeleteServerSideEnvKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EnvironmentAPI_DeleteServerSideEnvKey_Call) RunAndReturn(run func(string, int64) error) *EnvironmentAPI_DeleteServerSideEnvKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnvironment provides a mock function with given fields: apiKey
+func (_m *EnvironmentAPI) GetEnvironment(apiKey string) (*flagsmithapi.Environment, error) {
+	ret := _m.Called(apiKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvironment")
+	}
+
+	var r0 *flagsmithapi.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.Environment, error)); ok {
+		return rf(apiKey)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.Environment); ok {
+		r0 = rf(apiKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(apiKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnvironmentAPI_GetEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironment'
+type EnvironmentAPI_GetEnvironment_Call struct {
+	*mock.Call
+}
+
+// GetEnvironment is a helper method to define mock.On call
+//   - apiKey string
+func (_e *EnvironmentAPI_Expecter) GetEnvironment(apiKey interface{}) *EnvironmentAPI_GetEnvironment_Call {
+	return &EnvironmentAPI_GetEnvironment_Call{Call: _e.mock.On("GetEnvironment", apiKey)}
+}
+
+func (_c *EnvironmentAPI_GetEnvironment_Call) Run(run func(apiKey string)) *EnvironmentAPI_GetEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_GetEnvironment_Call) Return(_a0 *flagsmithapi.Environment, _a1 error) *EnvironmentAPI_GetEnvironment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EnvironmentAPI_GetEnvironment_Call) RunAndReturn(run func(string) (*flagsmithapi.Environment, error)) *EnvironmentAPI_GetEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnvironmentByUUID provides a mock function with given fields: uuid
+func (_m *EnvironmentAPI) GetEnvironmentByUUID(uuid string) (*flagsmithapi.Environment, error) {
+	ret := _m.Called(uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvironmentByUUID")
+	}
+
+	var r0 *flagsmithapi.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.Environment, error)); ok {
+		return rf(uuid)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.Environment); ok {
+		r0 = rf(uuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnvironmentAPI_GetEnvironmentByUUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironmentByUUID'
+type EnvironmentAPI_GetEnvironmentByUUID_Call struct {
+	*mock.Call
+}
+
+// GetEnvironmentByUUID is a helper method to define mock.On call
+//   - uuid string
+func (_e *EnvironmentAPI_Expecter) GetEnvironmentByUUID(uuid interface{}) *EnvironmentAPI_GetEnvironmentByUUID_Call {
+	return &EnvironmentAPI_GetEnvironmentByUUID_Call{Call: _e.mock.On("GetEnvironmentByUUID", uuid)}
+}
+
+func (_c *EnvironmentAPI_GetEnvironmentByUUID_Call) Run(run func(uuid string)) *EnvironmentAPI_GetEnvironmentByUUID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_GetEnvironmentByUUID_Call) Return(_a0 *flagsmithapi.Environment, _a1 error) *EnvironmentAPI_GetEnvironmentByUUID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EnvironmentAPI_GetEnvironmentByUUID_Call) RunAndReturn(run func(string) (*flagsmithapi.Environment, error)) *EnvironmentAPI_GetEnvironmentByUUID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnvironmentDocument provides a mock function with given fields: environmentKey
+func (_m *EnvironmentAPI) GetEnvironmentDocument(environmentKey string) (*flagsmithapi.EnvironmentDocument, error) {
+	ret := _m.Called(environmentKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvironmentDocument")
+	}
+
+	var r0 *flagsmithapi.EnvironmentDocument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.EnvironmentDocument, error)); ok {
+		return rf(environmentKey)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.EnvironmentDocument); ok {
+		r0 = rf(environmentKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.EnvironmentDocument)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(environmentKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnvironmentAPI_GetEnvironmentDocument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironmentDocument'
+type EnvironmentAPI_GetEnvironmentDocument_Call struct {
+	*mock.Call
+}
+
+// GetEnvironmentDocument is a helper method to define mock.On call
+//   - environmentKey string
+func (_e *EnvironmentAPI_Expecter) GetEnvironmentDocument(environmentKey interface{}) *EnvironmentAPI_GetEnvironmentDocument_Call {
+	return &EnvironmentAPI_GetEnvironmentDocument_Call{Call: _e.mock.On("GetEnvironmentDocument", environmentKey)}
+}
+
+func (_c *EnvironmentAPI_GetEnvironmentDocument_Call) Run(run func(environmentKey string)) *EnvironmentAPI_GetEnvironmentDocument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_GetEnvironmentDocument_Call) Return(_a0 *flagsmithapi.EnvironmentDocument, _a1 error) *EnvironmentAPI_GetEnvironmentDocument_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EnvironmentAPI_GetEnvironmentDocument_Call) RunAndReturn(run func(string) (*flagsmithapi.EnvironmentDocument, error)) *EnvironmentAPI_GetEnvironmentDocument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServerSideEnvKeys provides a mock function with given fields: environmentKey
+func (_m *EnvironmentAPI) GetServerSideEnvKeys(environmentKey string) ([]flagsmithapi.ServerSideEnvKey, error) {
+	ret := _m.Called(environmentKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServerSideEnvKeys")
+	}
+
+	var r0 []flagsmithapi.ServerSideEnvKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]flagsmithapi.ServerSideEnvKey, error)); ok {
+		return rf(environmentKey)
+	}
+	if rf, ok := ret.Get(0).(func(string) []flagsmithapi.ServerSideEnvKey); ok {
+		r0 = rf(environmentKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.ServerSideEnvKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(environmentKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnvironmentAPI_GetServerSideEnvKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServerSideEnvKeys'
+type EnvironmentAPI_GetServerSideEnvKeys_Call struct {
+	*mock.Call
+}
+
+// GetServerSideEnvKeys is a helper method to define mock.On call
+//   - environmentKey string
+func (_e *EnvironmentAPI_Expecter) GetServerSideEnvKeys(environmentKey interface{}) *EnvironmentAPI_GetServerSideEnvKeys_Call {
+	return &EnvironmentAPI_GetServerSideEnvKeys_Call{Call: _e.mock.On("GetServerSideEnvKeys", environmentKey)}
+}
+
+func (_c *EnvironmentAPI_GetServerSideEnvKeys_Call) Run(run func(environmentKey string)) *EnvironmentAPI_GetServerSideEnvKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_GetServerSideEnvKeys_Call) Return(_a0 []flagsmithapi.ServerSideEnvKey, _a1 error) *EnvironmentAPI_GetServerSideEnvKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EnvironmentAPI_GetServerSideEnvKeys_Call) RunAndReturn(run func(string) ([]flagsmithapi.ServerSideEnvKey, error)) *EnvironmentAPI_GetServerSideEnvKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnvironments provides a mock function with given fields: projectID
+func (_m *EnvironmentAPI) ListEnvironments(projectID int64) ([]flagsmithapi.Environment, error) {
+	ret := _m.Called(projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnvironments")
+	}
+
+	var r0 []flagsmithapi.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]flagsmithapi.Environment, error)); ok {
+		return rf(projectID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []flagsmithapi.Environment); ok {
+		r0 = rf(projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnvironmentAPI_ListEnvironments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnvironments'
+type EnvironmentAPI_ListEnvironments_Call struct {
+	*mock.Call
+}
+
+// ListEnvironments is a helper method to define mock.On call
+//   - projectID int64
+func (_e *EnvironmentAPI_Expecter) ListEnvironments(projectID interface{}) *EnvironmentAPI_ListEnvironments_Call {
+	return &EnvironmentAPI_ListEnvironments_Call{Call: _e.mock.On("ListEnvironments", projectID)}
+}
+
+func (_c *EnvironmentAPI_ListEnvironments_Call) Run(run func(projectID int64)) *EnvironmentAPI_ListEnvironments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_ListEnvironments_Call) Return(_a0 []flagsmithapi.Environment, _a1 error) *EnvironmentAPI_ListEnvironments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EnvironmentAPI_ListEnvironments_Call) RunAndReturn(run func(int64) ([]flagsmithapi.Environment, error)) *EnvironmentAPI_ListEnvironments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEnvironment provides a mock function with given fields: environment
+func (_m *EnvironmentAPI) UpdateEnvironment(environment *flagsmithapi.Environment) error {
+	ret := _m.Called(environment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEnvironment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Environment) error); ok {
+		r0 = rf(environment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnvironmentAPI_UpdateEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnvironment'
+type EnvironmentAPI_UpdateEnvironment_Call struct {
+	*mock.Call
+}
+
+// UpdateEnvironment is a helper method to define mock.On call
+//   - environment *flagsmithapi.Environment
+func (_e *EnvironmentAPI_Expecter) UpdateEnvironment(environment interface{}) *EnvironmentAPI_UpdateEnvironment_Call {
+	return &EnvironmentAPI_UpdateEnvironment_Call{Call: _e.mock.On("UpdateEnvironment", environment)}
+}
+
+func (_c *EnvironmentAPI_UpdateEnvironment_Call) Run(run func(environment *flagsmithapi.Environment)) *EnvironmentAPI_UpdateEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Environment))
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_UpdateEnvironment_Call) Return(_a0 error) *EnvironmentAPI_UpdateEnvironment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EnvironmentAPI_UpdateEnvironment_Call) RunAndReturn(run func(*flagsmithapi.Environment) error) *EnvironmentAPI_UpdateEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateServerSideEnvKey provides a mock function with given fields: environmentKey, key
+func (_m *EnvironmentAPI) UpdateServerSideEnvKey(environmentKey string, key *flagsmithapi.ServerSideEnvKey) error {
+	ret := _m.Called(environmentKey, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateServerSideEnvKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *flagsmithapi.ServerSideEnvKey) error); ok {
+		r0 = rf(environmentKey, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnvironmentAPI_UpdateServerSideEnvKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateServerSideEnvKey'
+type EnvironmentAPI_UpdateServerSideEnvKey_Call struct {
+	*mock.Call
+}
+
+// UpdateServerSideEnvKey is a helper method to define mock.On call
+//   - environmentKey string
+//   - key *flagsmithapi.ServerSideEnvKey
+func (_e *EnvironmentAPI_Expecter) UpdateServerSideEnvKey(environmentKey interface{}, key interface{}) *EnvironmentAPI_UpdateServerSideEnvKey_Call {
+	return &EnvironmentAPI_UpdateServerSideEnvKey_Call{Call: _e.mock.On("UpdateServerSideEnvKey", environmentKey, key)}
+}
+
+func (_c *EnvironmentAPI_UpdateServerSideEnvKey_Call) Run(run func(environmentKey string, key *flagsmithapi.ServerSideEnvKey)) *EnvironmentAPI_UpdateServerSideEnvKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*flagsmithapi.ServerSideEnvKey))
+	})
+	return _c
+}
+
+func (_c *EnvironmentAPI_UpdateServerSideEnvKey_Call) Return(_a0 error) *EnvironmentAPI_UpdateServerSideEnvKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EnvironmentAPI_UpdateServerSideEnvKey_Call) RunAndReturn(run func(string, *flagsmithapi.ServerSideEnvKey) error) *EnvironmentAPI_UpdateServerSideEnvKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEnvironmentAPI creates a new instance of EnvironmentAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEnvironmentAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EnvironmentAPI {
+	mock := &EnvironmentAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
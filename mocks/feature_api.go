@@ -0,0 +1,736 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FeatureAPI is an autogenerated mock type for the FeatureAPI type
+type FeatureAPI struct {
+	mock.Mock
+}
+
+type FeatureAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FeatureAPI) EXPECT() *FeatureAPI_Expecter {
+	return &FeatureAPI_Expecter{mock: &_m.Mock}
+}
+
+// AddFeatureGroupOwners provides a mock function with given fields: feature, groupIDs
+func (_m *FeatureAPI) AddFeatureGroupOwners(feature *flagsmithapi.Feature, groupIDs []int64) error {
+	ret := _m.Called(feature, groupIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFeatureGroupOwners")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature, []int64) error); ok {
+		r0 = rf(feature, groupIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureAPI_AddFeatureGroupOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatureGroupOwners'
+type FeatureAPI_AddFeatureGroupOwners_Call struct {
+	*mock.Call
+}
+
+// AddFeatureGroupOwners is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+//   - groupIDs []int64
+func (_e *FeatureAPI_Expecter) AddFeatureGroupOwners(feature interface{}, groupIDs interface{}) *FeatureAPI_AddFeatureGroupOwners_Call {
+	return &FeatureAPI_AddFeatureGroupOwners_Call{Call: _e.mock.On("AddFeatureGroupOwners", feature, groupIDs)}
+}
+
+func (_c *FeatureAPI_AddFeatureGroupOwners_Call) Run(run func(feature *flagsmithapi.Feature, groupIDs []int64)) *FeatureAPI_AddFeatureGroupOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_AddFeatureGroupOwners_Call) Return(_a0 error) *FeatureAPI_AddFeatureGroupOwners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureAPI_AddFeatureGroupOwners_Call) RunAndReturn(run func(*flagsmithapi.Feature, []int64) error) *FeatureAPI_AddFeatureGroupOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddFeatureOwners provides a mock function with given fields: feature, ownerIDs
+func (_m *FeatureAPI) AddFeatureOwners(feature *flagsmithapi.Feature, ownerIDs []int64) error {
+	ret := _m.Called(feature, ownerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFeatureOwners")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature, []int64) error); ok {
+		r0 = rf(feature, ownerIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureAPI_AddFeatureOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeatureOwners'
+type FeatureAPI_AddFeatureOwners_Call struct {
+	*mock.Call
+}
+
+// AddFeatureOwners is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+//   - ownerIDs []int64
+func (_e *FeatureAPI_Expecter) AddFeatureOwners(feature interface{}, ownerIDs interface{}) *FeatureAPI_AddFeatureOwners_Call {
+	return &FeatureAPI_AddFeatureOwners_Call{Call: _e.mock.On("AddFeatureOwners", feature, ownerIDs)}
+}
+
+func (_c *FeatureAPI_AddFeatureOwners_Call) Run(run func(feature *flagsmithapi.Feature, ownerIDs []int64)) *FeatureAPI_AddFeatureOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_AddFeatureOwners_Call) Return(_a0 error) *FeatureAPI_AddFeatureOwners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureAPI_AddFeatureOwners_Call) RunAndReturn(run func(*flagsmithapi.Feature, []int64) error) *FeatureAPI_AddFeatureOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFeature provides a mock function with given fields: feature
+func (_m *FeatureAPI) CreateFeature(feature *flagsmithapi.Feature) error {
+	ret := _m.Called(feature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature) error); ok {
+		r0 = rf(feature)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureAPI_CreateFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFeature'
+type FeatureAPI_CreateFeature_Call struct {
+	*mock.Call
+}
+
+// CreateFeature is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+func (_e *FeatureAPI_Expecter) CreateFeature(feature interface{}) *FeatureAPI_CreateFeature_Call {
+	return &FeatureAPI_CreateFeature_Call{Call: _e.mock.On("CreateFeature", feature)}
+}
+
+func (_c *FeatureAPI_CreateFeature_Call) Run(run func(feature *flagsmithapi.Feature)) *FeatureAPI_CreateFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_CreateFeature_Call) Return(_a0 error) *FeatureAPI_CreateFeature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureAPI_CreateFeature_Call) RunAndReturn(run func(*flagsmithapi.Feature) error) *FeatureAPI_CreateFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFeatureMVOption provides a mock function with given fields: featureMVOption
+func (_m *FeatureAPI) CreateFeatureMVOption(featureMVOption *flagsmithapi.FeatureMultivariateOption) error {
+	ret := _m.Called(featureMVOption)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFeatureMVOption")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.FeatureMultivariateOption) error); ok {
+		r0 = rf(featureMVOption)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureAPI_CreateFeatureMVOption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFeatureMVOption'
+type FeatureAPI_CreateFeatureMVOption_Call struct {
+	*mock.Call
+}
+
+// CreateFeatureMVOption is a helper method to define mock.On call
+//   - featureMVOption *flagsmithapi.FeatureMultivariateOption
+func (_e *FeatureAPI_Expecter) CreateFeatureMVOption(featureMVOption interface{}) *FeatureAPI_CreateFeatureMVOption_Call {
+	return &FeatureAPI_CreateFeatureMVOption_Call{Call: _e.mock.On("CreateFeatureMVOption", featureMVOption)}
+}
+
+func (_c *FeatureAPI_CreateFeatureMVOption_Call) Run(run func(featureMVOption *flagsmithapi.FeatureMultivariateOption)) *FeatureAPI_CreateFeatureMVOption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.FeatureMultivariateOption))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_CreateFeatureMVOption_Call) Return(_a0 error) *FeatureAPI_CreateFeatureMVOption_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureAPI_CreateFeatureMVOption_Call) RunAndReturn(run func(*flagsmithapi.FeatureMultivariateOption) error) *FeatureAPI_CreateFeatureMVOption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFeature provides a mock function with given fields: projectID, featureID
+func (_m *FeatureAPI) DeleteFeature(projectID int64, featureID int64) error {
+	ret := _m.Called(projectID, featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(projectID, featureID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureAPI_DeleteFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFeature'
+type FeatureAPI_DeleteFeature_Call struct {
+	*mock.Call
+}
+
+// DeleteFeature is a helper method to define mock.On call
+//   - projectID int64
+//   - featureID int64
+func (_e *FeatureAPI_Expecter) DeleteFeature(projectID interface{}, featureID interface{}) *FeatureAPI_DeleteFeature_Call {
+	return &FeatureAPI_DeleteFeature_Call{Call: _e.mock.On("DeleteFeature", projectID, featureID)}
+}
+
+func (_c *FeatureAPI_DeleteFeature_Call) Run(run func(projectID int64, featureID int64)) *FeatureAPI_DeleteFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_DeleteFeature_Call) Return(_a0 error) *FeatureAPI_DeleteFeature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureAPI_DeleteFeature_Call) RunAndReturn(run func(int64, int64) error) *FeatureAPI_DeleteFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFeatureMVOption provides a mock function with given fields: projectID, featureID, mvOptionID
+func (_m *FeatureAPI) DeleteFeatureMVOption(projectID int64, featureID int64, mvOptionID int64) error {
+	ret := _m.Called(projectID, featureID, mvOptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeatureMVOption")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) error); ok {
+		r0 = rf(projectID, featureID, mvOptionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureAPI_DeleteFeatureMVOption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFeatureMVOption'
+type FeatureAPI_DeleteFeatureMVOption_Call struct {
+	*mock.Call
+}
+
+// DeleteFeatureMVOption is a helper method to define mock.On call
+//   - projectID int64
+//   - featureID int64
+//   - mvOptionID int64
+func (_e *FeatureAPI_Expecter) DeleteFeatureMVOption(projectID interface{}, featureID interface{}, mvOptionID interface{}) *FeatureAPI_DeleteFeatureMVOption_Call {
+	return &FeatureAPI_DeleteFeatureMVOption_Call{Call: _e.mock.On("DeleteFeatureMVOption", projectID, featureID, mvOptionID)}
+}
+
+func (_c *FeatureAPI_DeleteFeatureMVOption_Call) Run(run func(projectID int64, featureID int64, mvOptionID int64)) *FeatureAPI_DeleteFeatureMVOption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_DeleteFeatureMVOption_Call) Return(_a0 error) *FeatureAPI_DeleteFeatureMVOption_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureAPI_DeleteFeatureMVOption_Call) RunAndReturn(run func(int64, int64, int64) error) *FeatureAPI_DeleteFeatureMVOption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeature provides a mock function with given fields: featureUUID
+func (_m *FeatureAPI) GetFeature(featureUUID string) (*flagsmithapi.Feature, error) {
+	ret := _m.Called(featureUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeature")
+	}
+
+	var r0 *flagsmithapi.Feature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.Feature, error)); ok {
+		return rf(featureUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.Feature); ok {
+		r0 = rf(featureUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Feature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(featureUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeatureAPI_GetFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeature'
+type FeatureAPI_GetFeature_Call struct {
+	*mock.Call
+}
+
+// GetFeature is a helper method to define mock.On call
+//   - featureUUID string
+func (_e *FeatureAPI_Expecter) GetFeature(featureUUID interface{}) *FeatureAPI_GetFeature_Call {
+	return &FeatureAPI_GetFeature_Call{Call: _e.mock.On("GetFeature", featureUUID)}
+}
+
+func (_c *FeatureAPI_GetFeature_Call) Run(run func(featureUUID string)) *FeatureAPI_GetFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_GetFeature_Call) Return(_a0 *flagsmithapi.Feature, _a1 error) *FeatureAPI_GetFeature_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeatureAPI_GetFeature_Call) RunAndReturn(run func(string) (*flagsmithapi.Feature, error)) *FeatureAPI_GetFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeatureMVOption provides a mock function with given fields: featureUUID, mvOptionUUID
+func (_m *FeatureAPI) GetFeatureMVOption(featureUUID string, mvOptionUUID string) (*flagsmithapi.FeatureMultivariateOption, error) {
+	ret := _m.Called(featureUUID, mvOptionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeatureMVOption")
+	}
+
+	var r0 *flagsmithapi.FeatureMultivariateOption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*flagsmithapi.FeatureMultivariateOption, error)); ok {
+		return rf(featureUUID, mvOptionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *flagsmithapi.FeatureMultivariateOption); ok {
+		r0 = rf(featureUUID, mvOptionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.FeatureMultivariateOption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(featureUUID, mvOptionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeatureAPI_GetFeatureMVOption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureMVOption'
+type FeatureAPI_GetFeatureMVOption_Call struct {
+	*mock.Call
+}
+
+// GetFeatureMVOption is a helper method to define mock.On call
+//   - featureUUID string
+//   - mvOptionUUID string
+func (_e *FeatureAPI_Expecter) GetFeatureMVOption(featureUUID interface{}, mvOptionUUID interface{}) *FeatureAPI_GetFeatureMVOption_Call {
+	return &FeatureAPI_GetFeatureMVOption_Call{Call: _e.mock.On("GetFeatureMVOption", featureUUID, mvOptionUUID)}
+}
+
+func (_c *FeatureAPI_GetFeatureMVOption_Call) Run(run func(featureUUID string, mvOptionUUID string)) *FeatureAPI_GetFeatureMVOption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_GetFeatureMVOption_Call) Return(_a0 *flagsmithapi.FeatureMultivariateOption, _a1 error) *FeatureAPI_GetFeatureMVOption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeatureAPI_GetFeatureMVOption_Call) RunAndReturn(run func(string, string) (*flagsmithapi.FeatureMultivariateOption, error)) *FeatureAPI_GetFeatureMVOption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFeatureMVOptions provides a mock function with given fields: projectID, featureID
+func (_m *FeatureAPI) ListFeatureMVOptions(projectID int64, featureID int64) ([]flagsmithapi.FeatureMultivariateOption, error) {
+	ret := _m.Called(projectID, featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeatureMVOptions")
+	}
+
+	var r0 []flagsmithapi.FeatureMultivariateOption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int64) ([]flagsmithapi.FeatureMultivariateOption, error)); ok {
+		return rf(projectID, featureID)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) []flagsmithapi.FeatureMultivariateOption); ok {
+		r0 = rf(projectID, featureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.FeatureMultivariateOption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(projectID, featureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeatureAPI_ListFeatureMVOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFeatureMVOptions'
+type FeatureAPI_ListFeatureMVOptions_Call struct {
+	*mock.Call
+}
+
+// ListFeatureMVOptions is a helper method to define mock.On call
+//   - projectID int64
+//   - featureID int64
+func (_e *FeatureAPI_Expecter) ListFeatureMVOptions(projectID interface{}, featureID interface{}) *FeatureAPI_ListFeatureMVOptions_Call {
+	return &FeatureAPI_ListFeatureMVOptions_Call{Call: _e.mock.On("ListFeatureMVOptions", projectID, featureID)}
+}
+
+func (_c *FeatureAPI_ListFeatureMVOptions_Call) Run(run func(projectID int64, featureID int64)) *FeatureAPI_ListFeatureMVOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_ListFeatureMVOptions_Call) Return(_a0 []flagsmithapi.FeatureMultivariateOption, _a1 error) *FeatureAPI_ListFeatureMVOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeatureAPI_ListFeatureMVOptions_Call) RunAndReturn(run func(int64, int64) ([]flagsmithapi.FeatureMultivariateOption, error)) *FeatureAPI_ListFeatureMVOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFeatures provides a mock function with given fields: projectID
+func (_m *FeatureAPI) ListFeatures(projectID int64) ([]flagsmithapi.Feature, error) {
+	ret := _m.Called(projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeatures")
+	}
+
+	var r0 []flagsmithapi.Feature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]flagsmithapi.Feature, error)); ok {
+		return rf(projectID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []flagsmithapi.Feature); ok {
+		r0 = rf(projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Feature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeatureAPI_ListFeatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFeatures'
+type FeatureAPI_ListFeatures_Call struct {
+	*mock.Call
+}
+
+// ListFeatures is a helper method to define mock.On call
+//   - projectID int64
+func (_e *FeatureAPI_Expecter) ListFeatures(projectID interface{}) *FeatureAPI_ListFeatures_Call {
+	return &FeatureAPI_ListFeatures_Call{Call: _e.mock.On("ListFeatures", projectID)}
+}
+
+func (_c *FeatureAPI_ListFeatures_Call) Run(run func(projectID int64)) *FeatureAPI_ListFeatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_ListFeatures_Call) Return(_a0 []flagsmithapi.Feature, _a1 error) *FeatureAPI_ListFeatures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeatureAPI_ListFeatures_Call) RunAndReturn(run func(int64) ([]flagsmithapi.Feature, error)) *FeatureAPI_ListFeatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFeatureGroupOwners provides a mock function with given fields: feature, groupIDs
+func (_m *FeatureAPI) RemoveFeatureGroupOwners(feature *flagsmithapi.Feature, groupIDs []int64) error {
+	ret := _m.Called(feature, groupIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFeatureGroupOwners")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature, []int64) error); ok {
+		r0 = rf(feature, groupIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureAPI_RemoveFeatureGroupOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFeatureGroupOwners'
+type FeatureAPI_RemoveFeatureGroupOwners_Call struct {
+	*mock.Call
+}
+
+// RemoveFeatureGroupOwners is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+//   - groupIDs []int64
+func (_e *FeatureAPI_Expecter) RemoveFeatureGroupOwners(feature interface{}, groupIDs interface{}) *FeatureAPI_RemoveFeatureGroupOwners_Call {
+	return &FeatureAPI_RemoveFeatureGroupOwners_Call{Call: _e.mock.On("RemoveFeatureGroupOwners", feature, groupIDs)}
+}
+
+func (_c *FeatureAPI_RemoveFeatureGroupOwners_Call) Run(run func(feature *flagsmithapi.Feature, groupIDs []int64)) *FeatureAPI_RemoveFeatureGroupOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_RemoveFeatureGroupOwners_Call) Return(_a0 error) *FeatureAPI_RemoveFeatureGroupOwners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureAPI_RemoveFeatureGroupOwners_Call) RunAndReturn(run func(*flagsmithapi.Feature, []int64) error) *FeatureAPI_RemoveFeatureGroupOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFeatureOwners provides a mock function with given fields: feature, ownerIDs
+func (_m *FeatureAPI) RemoveFeatureOwners(feature *flagsmithapi.Feature, ownerIDs []int64) error {
+	ret := _m.Called(feature, ownerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFeatureOwners")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature, []int64) error); ok {
+		r0 = rf(feature, ownerIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureAPI_RemoveFeatureOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFeatureOwners'
+type FeatureAPI_RemoveFeatureOwners_Call struct {
+	*mock.Call
+}
+
+// RemoveFeatureOwners is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+//   - ownerIDs []int64
+func (_e *FeatureAPI_Expecter) RemoveFeatureOwners(feature interface{}, ownerIDs interface{}) *FeatureAPI_RemoveFeatureOwners_Call {
+	return &FeatureAPI_RemoveFeatureOwners_Call{Call: _e.mock.On("RemoveFeatureOwners", feature, ownerIDs)}
+}
+
+func (_c *FeatureAPI_RemoveFeatureOwners_Call) Run(run func(feature *flagsmithapi.Feature, ownerIDs []int64)) *FeatureAPI_RemoveFeatureOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_RemoveFeatureOwners_Call) Return(_a0 error) *FeatureAPI_RemoveFeatureOwners_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureAPI_RemoveFeatureOwners_Call) RunAndReturn(run func(*flagsmithapi.Feature, []int64) error) *FeatureAPI_RemoveFeatureOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeature provides a mock function with given fields: feature
+func (_m *FeatureAPI) UpdateFeature(feature *flagsmithapi.Feature) error {
+	ret := _m.Called(feature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Feature) error); ok {
+		r0 = rf(feature)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureAPI_UpdateFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeature'
+type FeatureAPI_UpdateFeature_Call struct {
+	*mock.Call
+}
+
+// UpdateFeature is a helper method to define mock.On call
+//   - feature *flagsmithapi.Feature
+func (_e *FeatureAPI_Expecter) UpdateFeature(feature interface{}) *FeatureAPI_UpdateFeature_Call {
+	return &FeatureAPI_UpdateFeature_Call{Call: _e.mock.On("UpdateFeature", feature)}
+}
+
+func (_c *FeatureAPI_UpdateFeature_Call) Run(run func(feature *flagsmithapi.Feature)) *FeatureAPI_UpdateFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Feature))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_UpdateFeature_Call) Return(_a0 error) *FeatureAPI_UpdateFeature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureAPI_UpdateFeature_Call) RunAndReturn(run func(*flagsmithapi.Feature) error) *FeatureAPI_UpdateFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeatureMVOption provides a mock function with given fields: featureMVOption
+func (_m *FeatureAPI) UpdateFeatureMVOption(featureMVOption *flagsmithapi.FeatureMultivariateOption) error {
+	ret := _m.Called(featureMVOption)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeatureMVOption")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.FeatureMultivariateOption) error); ok {
+		r0 = rf(featureMVOption)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureAPI_UpdateFeatureMVOption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeatureMVOption'
+type FeatureAPI_UpdateFeatureMVOption_Call struct {
+	*mock.Call
+}
+
+// UpdateFeatureMVOption is a helper method to define mock.On call
+//   - featureMVOption *flagsmithapi.FeatureMultivariateOption
+func (_e *FeatureAPI_Expecter) UpdateFeatureMVOption(featureMVOption interface{}) *FeatureAPI_UpdateFeatureMVOption_Call {
+	return &FeatureAPI_UpdateFeatureMVOption_Call{Call: _e.mock.On("UpdateFeatureMVOption", featureMVOption)}
+}
+
+func (_c *FeatureAPI_UpdateFeatureMVOption_Call) Run(run func(featureMVOption *flagsmithapi.FeatureMultivariateOption)) *FeatureAPI_UpdateFeatureMVOption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.FeatureMultivariateOption))
+	})
+	return _c
+}
+
+func (_c *FeatureAPI_UpdateFeatureMVOption_Call) Return(_a0 error) *FeatureAPI_UpdateFeatureMVOption_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureAPI_UpdateFeatureMVOption_Call) RunAndReturn(run func(*flagsmithapi.FeatureMultivariateOption) error) *FeatureAPI_UpdateFeatureMVOption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFeatureAPI creates a new instance of FeatureAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeatureAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FeatureAPI {
+	mock := &FeatureAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,632 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FeatureStateAPI is an autogenerated mock type for the FeatureStateAPI type
+type FeatureStateAPI struct {
+	mock.Mock
+}
+
+type FeatureStateAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FeatureStateAPI) EXPECT() *FeatureStateAPI_Expecter {
+	return &FeatureStateAPI_Expecter{mock: &_m.Mock}
+}
+
+// CreateChangeRequest provides a mock function with given fields: changeRequest
+func (_m *FeatureStateAPI) CreateChangeRequest(changeRequest *flagsmithapi.ChangeRequest) error {
+	ret := _m.Called(changeRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChangeRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.ChangeRequest) error); ok {
+		r0 = rf(changeRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureStateAPI_CreateChangeRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChangeRequest'
+type FeatureStateAPI_CreateChangeRequest_Call struct {
+	*mock.Call
+}
+
+// CreateChangeRequest is a helper method to define mock.On call
+//   - changeRequest *flagsmithapi.ChangeRequest
+func (_e *FeatureStateAPI_Expecter) CreateChangeRequest(changeRequest interface{}) *FeatureStateAPI_CreateChangeRequest_Call {
+	return &FeatureStateAPI_CreateChangeRequest_Call{Call: _e.mock.On("CreateChangeRequest", changeRequest)}
+}
+
+func (_c *FeatureStateAPI_CreateChangeRequest_Call) Run(run func(changeRequest *flagsmithapi.ChangeRequest)) *FeatureStateAPI_CreateChangeRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.ChangeRequest))
+	})
+	return _c
+}
+
+func (_c *FeatureStateAPI_CreateChangeRequest_Call) Return(_a0 error) *FeatureStateAPI_CreateChangeRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureStateAPI_CreateChangeRequest_Call) RunAndReturn(run func(*flagsmithapi.ChangeRequest) error) *FeatureStateAPI_CreateChangeRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSegmentOverride provides a mock function with given fields: featureState
+func (_m *FeatureStateAPI) CreateSegmentOverride(featureState *flagsmithapi.FeatureState) error {
+	ret := _m.Called(featureState)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSegmentOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.FeatureState) error); ok {
+		r0 = rf(featureState)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureStateAPI_CreateSegmentOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSegmentOverride'
+type FeatureStateAPI_CreateSegmentOverride_Call struct {
+	*mock.Call
+}
+
+// CreateSegmentOverride is a helper method to define mock.On call
+//   - featureState *flagsmithapi.FeatureState
+func (_e *FeatureStateAPI_Expecter) CreateSegmentOverride(featureState interface{}) *FeatureStateAPI_CreateSegmentOverride_Call {
+	return &FeatureStateAPI_CreateSegmentOverride_Call{Call: _e.mock.On("CreateSegmentOverride", featureState)}
+}
+
+func (_c *FeatureStateAPI_CreateSegmentOverride_Call) Run(run func(featureState *flagsmithapi.FeatureState)) *FeatureStateAPI_CreateSegmentOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.FeatureState))
+	})
+	return _c
+}
+
+func (_c *FeatureStateAPI_CreateSegmentOverride_Call) Return(_a0 error) *FeatureStateAPI_CreateSegmentOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureStateAPI_CreateSegmentOverride_Call) RunAndReturn(run func(*flagsmithapi.FeatureState) error) *FeatureStateAPI_CreateSegmentOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnvironmentFeatureState provides a mock function with given fields: environmentKey, featureID
+func (_m *FeatureStateAPI) GetEnvironmentFeatureState(environmentKey string, featureID int64) (*flagsmithapi.FeatureState, error) {
+	ret := _m.Called(environmentKey, featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvironmentFeatureState")
+	}
+
+	var r0 *flagsmithapi.FeatureState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) (*flagsmithapi.FeatureState, error)); ok {
+		return rf(environmentKey, featureID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) *flagsmithapi.FeatureState); ok {
+		r0 = rf(environmentKey, featureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.FeatureState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(environmentKey, featureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeatureStateAPI_GetEnvironmentFeatureState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironmentFeatureState'
+type FeatureStateAPI_GetEnvironmentFeatureState_Call struct {
+	*mock.Call
+}
+
+// GetEnvironmentFeatureState is a helper method to define mock.On call
+//   - environmentKey string
+//   - featureID int64
+func (_e *FeatureStateAPI_Expecter) GetEnvironmentFeatureState(environmentKey interface{}, featureID interface{}) *FeatureStateAPI_GetEnvironmentFeatureState_Call {
+	return &FeatureStateAPI_GetEnvironmentFeatureState_Call{Call: _e.mock.On("GetEnvironmentFeatureState", environmentKey, featureID)}
+}
+
+func (_c *FeatureStateAPI_GetEnvironmentFeatureState_Call) Run(run func(environmentKey string, featureID int64)) *FeatureStateAPI_GetEnvironmentFeatureState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FeatureStateAPI_GetEnvironmentFeatureState_Call) Return(_a0 *flagsmithapi.FeatureState, _a1 error) *FeatureStateAPI_GetEnvironmentFeatureState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeatureStateAPI_GetEnvironmentFeatureState_Call) RunAndReturn(run func(string, int64) (*flagsmithapi.FeatureState, error)) *FeatureStateAPI_GetEnvironmentFeatureState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFeatureState provides a mock function with given fields: featureStateUUID
+func (_m *FeatureStateAPI) GetFeatureState(featureStateUUID string) (*flagsmithapi.FeatureState, error) {
+	ret := _m.Called(featureStateUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeatureState")
+	}
+
+	var r0 *flagsmithapi.FeatureState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.FeatureState, error)); ok {
+		return rf(featureStateUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.FeatureState); ok {
+		r0 = rf(featureStateUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.FeatureState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(featureStateUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeatureStateAPI_GetFeatureState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeatureState'
+type FeatureStateAPI_GetFeatureState_Call struct {
+	*mock.Call
+}
+
+// GetFeatureState is a helper method to define mock.On call
+//   - featureStateUUID string
+func (_e *FeatureStateAPI_Expecter) GetFeatureState(featureStateUUID interface{}) *FeatureStateAPI_GetFeatureState_Call {
+	return &FeatureStateAPI_GetFeatureState_Call{Call: _e.mock.On("GetFeatureState", featureStateUUID)}
+}
+
+func (_c *FeatureStateAPI_GetFeatureState_Call) Run(run func(featureStateUUID string)) *FeatureStateAPI_GetFeatureState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *FeatureStateAPI_GetFeatureState_Call) Return(_a0 *flagsmithapi.FeatureState, _a1 error) *FeatureStateAPI_GetFeatureState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeatureStateAPI_GetFeatureState_Call) RunAndReturn(run func(string) (*flagsmithapi.FeatureState, error)) *FeatureStateAPI_GetFeatureState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KillFeature provides a mock function with given fields: featureUUID, opts
+func (_m *FeatureStateAPI) KillFeature(featureUUID string, opts flagsmithapi.KillOptions) (*flagsmithapi.FeatureSnapshot, error) {
+	ret := _m.Called(featureUUID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KillFeature")
+	}
+
+	var r0 *flagsmithapi.FeatureSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, flagsmithapi.KillOptions) (*flagsmithapi.FeatureSnapshot, error)); ok {
+		return rf(featureUUID, opts)
+	}
+	if rf, ok := ret.Get(0).(func(string, flagsmithapi.KillOptions) *flagsmithapi.FeatureSnapshot); ok {
+		r0 = rf(featureUUID, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.FeatureSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, flagsmithapi.KillOptions) error); ok {
+		r1 = rf(featureUUID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeatureStateAPI_KillFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KillFeature'
+type FeatureStateAPI_KillFeature_Call struct {
+	*mock.Call
+}
+
+// KillFeature is a helper method to define mock.On call
+//   - featureUUID string
+//   - opts flagsmithapi.KillOptions
+func (_e *FeatureStateAPI_Expecter) KillFeature(featureUUID interface{}, opts interface{}) *FeatureStateAPI_KillFeature_Call {
+	return &FeatureStateAPI_KillFeature_Call{Call: _e.mock.On("KillFeature", featureUUID, opts)}
+}
+
+func (_c *FeatureStateAPI_KillFeature_Call) Run(run func(featureUUID string, opts flagsmithapi.KillOptions)) *FeatureStateAPI_KillFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(flagsmithapi.KillOptions))
+	})
+	return _c
+}
+
+func (_c *FeatureStateAPI_KillFeature_Call) Return(_a0 *flagsmithapi.FeatureSnapshot, _a1 error) *FeatureStateAPI_KillFeature_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeatureStateAPI_KillFeature_Call) RunAndReturn(run func(string, flagsmithapi.KillOptions) (*flagsmithapi.FeatureSnapshot, error)) *FeatureStateAPI_KillFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnvironmentFeatureStates provides a mock function with given fields: environmentKey
+func (_m *FeatureStateAPI) ListEnvironmentFeatureStates(environmentKey string) ([]flagsmithapi.FeatureState, error) {
+	ret := _m.Called(environmentKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnvironmentFeatureStates")
+	}
+
+	var r0 []flagsmithapi.FeatureState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]flagsmithapi.FeatureState, error)); ok {
+		return rf(environmentKey)
+	}
+	if rf, ok := ret.Get(0).(func(string) []flagsmithapi.FeatureState); ok {
+		r0 = rf(environmentKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.FeatureState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(environmentKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeatureStateAPI_ListEnvironmentFeatureStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnvironmentFeatureStates'
+type FeatureStateAPI_ListEnvironmentFeatureStates_Call struct {
+	*mock.Call
+}
+
+// ListEnvironmentFeatureStates is a helper method to define mock.On call
+//   - environmentKey string
+func (_e *FeatureStateAPI_Expecter) ListEnvironmentFeatureStates(environmentKey interface{}) *FeatureStateAPI_ListEnvironmentFeatureStates_Call {
+	return &FeatureStateAPI_ListEnvironmentFeatureStates_Call{Call: _e.mock.On("ListEnvironmentFeatureStates", environmentKey)}
+}
+
+func (_c *FeatureStateAPI_ListEnvironmentFeatureStates_Call) Run(run func(environmentKey string)) *FeatureStateAPI_ListEnvironmentFeatureStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *FeatureStateAPI_ListEnvironmentFeatureStates_Call) Return(_a0 []flagsmithapi.FeatureState, _a1 error) *FeatureStateAPI_ListEnvironmentFeatureStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeatureStateAPI_ListEnvironmentFeatureStates_Call) RunAndReturn(run func(string) ([]flagsmithapi.FeatureState, error)) *FeatureStateAPI_ListEnvironmentFeatureStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSegmentOverrides provides a mock function with given fields: environmentKey, featureID
+func (_m *FeatureStateAPI) ListSegmentOverrides(environmentKey string, featureID int64) ([]flagsmithapi.FeatureState, error) {
+	ret := _m.Called(environmentKey, featureID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSegmentOverrides")
+	}
+
+	var r0 []flagsmithapi.FeatureState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) ([]flagsmithapi.FeatureState, error)); ok {
+		return rf(environmentKey, featureID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) []flagsmithapi.FeatureState); ok {
+		r0 = rf(environmentKey, featureID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.FeatureState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(environmentKey, featureID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeatureStateAPI_ListSegmentOverrides_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSegmentOverrides'
+type FeatureStateAPI_ListSegmentOverrides_Call struct {
+	*mock.Call
+}
+
+// ListSegmentOverrides is a helper method to define mock.On call
+//   - environmentKey string
+//   - featureID int64
+func (_e *FeatureStateAPI_Expecter) ListSegmentOverrides(environmentKey interface{}, featureID interface{}) *FeatureStateAPI_ListSegmentOverrides_Call {
+	return &FeatureStateAPI_ListSegmentOverrides_Call{Call: _e.mock.On("ListSegmentOverrides", environmentKey, featureID)}
+}
+
+func (_c *FeatureStateAPI_ListSegmentOverrides_Call) Run(run func(environmentKey string, featureID int64)) *FeatureStateAPI_ListSegmentOverrides_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FeatureStateAPI_ListSegmentOverrides_Call) Return(_a0 []flagsmithapi.FeatureState, _a1 error) *FeatureStateAPI_ListSegmentOverrides_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeatureStateAPI_ListSegmentOverrides_Call) RunAndReturn(run func(string, int64) ([]flagsmithapi.FeatureState, error)) *FeatureStateAPI_ListSegmentOverrides_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteEnvironment provides a mock function with given fields: sourceKey, destinationKey, opts
+func (_m *FeatureStateAPI) PromoteEnvironment(sourceKey string, destinationKey string, opts flagsmithapi.PromoteOptions) (*flagsmithapi.Promotion, error) {
+	ret := _m.Called(sourceKey, destinationKey, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteEnvironment")
+	}
+
+	var r0 *flagsmithapi.Promotion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, flagsmithapi.PromoteOptions) (*flagsmithapi.Promotion, error)); ok {
+		return rf(sourceKey, destinationKey, opts)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, flagsmithapi.PromoteOptions) *flagsmithapi.Promotion); ok {
+		r0 = rf(sourceKey, destinationKey, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Promotion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, flagsmithapi.PromoteOptions) error); ok {
+		r1 = rf(sourceKey, destinationKey, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeatureStateAPI_PromoteEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteEnvironment'
+type FeatureStateAPI_PromoteEnvironment_Call struct {
+	*mock.Call
+}
+
+// PromoteEnvironment is a helper method to define mock.On call
+//   - sourceKey string
+//   - destinationKey string
+//   - opts flagsmithapi.PromoteOptions
+func (_e *FeatureStateAPI_Expecter) PromoteEnvironment(sourceKey interface{}, destinationKey interface{}, opts interface{}) *FeatureStateAPI_PromoteEnvironment_Call {
+	return &FeatureStateAPI_PromoteEnvironment_Call{Call: _e.mock.On("PromoteEnvironment", sourceKey, destinationKey, opts)}
+}
+
+func (_c *FeatureStateAPI_PromoteEnvironment_Call) Run(run func(sourceKey string, destinationKey string, opts flagsmithapi.PromoteOptions)) *FeatureStateAPI_PromoteEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(flagsmithapi.PromoteOptions))
+	})
+	return _c
+}
+
+func (_c *FeatureStateAPI_PromoteEnvironment_Call) Return(_a0 *flagsmithapi.Promotion, _a1 error) *FeatureStateAPI_PromoteEnvironment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeatureStateAPI_PromoteEnvironment_Call) RunAndReturn(run func(string, string, flagsmithapi.PromoteOptions) (*flagsmithapi.Promotion, error)) *FeatureStateAPI_PromoteEnvironment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreFeature provides a mock function with given fields: snapshot
+func (_m *FeatureStateAPI) RestoreFeature(snapshot *flagsmithapi.FeatureSnapshot) error {
+	ret := _m.Called(snapshot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreFeature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.FeatureSnapshot) error); ok {
+		r0 = rf(snapshot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureStateAPI_RestoreFeature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreFeature'
+type FeatureStateAPI_RestoreFeature_Call struct {
+	*mock.Call
+}
+
+// RestoreFeature is a helper method to define mock.On call
+//   - snapshot *flagsmithapi.FeatureSnapshot
+func (_e *FeatureStateAPI_Expecter) RestoreFeature(snapshot interface{}) *FeatureStateAPI_RestoreFeature_Call {
+	return &FeatureStateAPI_RestoreFeature_Call{Call: _e.mock.On("RestoreFeature", snapshot)}
+}
+
+func (_c *FeatureStateAPI_RestoreFeature_Call) Run(run func(snapshot *flagsmithapi.FeatureSnapshot)) *FeatureStateAPI_RestoreFeature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.FeatureSnapshot))
+	})
+	return _c
+}
+
+func (_c *FeatureStateAPI_RestoreFeature_Call) Return(_a0 error) *FeatureStateAPI_RestoreFeature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureStateAPI_RestoreFeature_Call) RunAndReturn(run func(*flagsmithapi.FeatureSnapshot) error) *FeatureStateAPI_RestoreFeature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeatureState provides a mock function with given fields: featureState, updateSegmentPriority
+func (_m *FeatureStateAPI) UpdateFeatureState(featureState *flagsmithapi.FeatureState, updateSegmentPriority bool) error {
+	ret := _m.Called(featureState, updateSegmentPriority)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeatureState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.FeatureState, bool) error); ok {
+		r0 = rf(featureState, updateSegmentPriority)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FeatureStateAPI_UpdateFeatureState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeatureState'
+type FeatureStateAPI_UpdateFeatureState_Call struct {
+	*mock.Call
+}
+
+// UpdateFeatureState is a helper method to define mock.On call
+//   - featureState *flagsmithapi.FeatureState
+//   - updateSegmentPriority bool
+func (_e *FeatureStateAPI_Expecter) UpdateFeatureState(featureState interface{}, updateSegmentPriority interface{}) *FeatureStateAPI_UpdateFeatureState_Call {
+	return &FeatureStateAPI_UpdateFeatureState_Call{Call: _e.mock.On("UpdateFeatureState", featureState, updateSegmentPriority)}
+}
+
+func (_c *FeatureStateAPI_UpdateFeatureState_Call) Run(run func(featureState *flagsmithapi.FeatureState, updateSegmentPriority bool)) *FeatureStateAPI_UpdateFeatureState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.FeatureState), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *FeatureStateAPI_UpdateFeatureState_Call) Return(_a0 error) *FeatureStateAPI_UpdateFeatureState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FeatureStateAPI_UpdateFeatureState_Call) RunAndReturn(run func(*flagsmithapi.FeatureState, bool) error) *FeatureStateAPI_UpdateFeatureState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeatureStates provides a mock function with given fields: changes, opts
+func (_m *FeatureStateAPI) UpdateFeatureStates(changes []flagsmithapi.FeatureStateChange, opts flagsmithapi.BulkUpdateOptions) (*flagsmithapi.BulkUpdateResult, error) {
+	ret := _m.Called(changes, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeatureStates")
+	}
+
+	var r0 *flagsmithapi.BulkUpdateResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]flagsmithapi.FeatureStateChange, flagsmithapi.BulkUpdateOptions) (*flagsmithapi.BulkUpdateResult, error)); ok {
+		return rf(changes, opts)
+	}
+	if rf, ok := ret.Get(0).(func([]flagsmithapi.FeatureStateChange, flagsmithapi.BulkUpdateOptions) *flagsmithapi.BulkUpdateResult); ok {
+		r0 = rf(changes, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.BulkUpdateResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]flagsmithapi.FeatureStateChange, flagsmithapi.BulkUpdateOptions) error); ok {
+		r1 = rf(changes, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeatureStateAPI_UpdateFeatureStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeatureStates'
+type FeatureStateAPI_UpdateFeatureStates_Call struct {
+	*mock.Call
+}
+
+// UpdateFeatureStates is a helper method to define mock.On call
+//   - changes []flagsmithapi.FeatureStateChange
+//   - opts flagsmithapi.BulkUpdateOptions
+func (_e *FeatureStateAPI_Expecter) UpdateFeatureStates(changes interface{}, opts interface{}) *FeatureStateAPI_UpdateFeatureStates_Call {
+	return &FeatureStateAPI_UpdateFeatureStates_Call{Call: _e.mock.On("UpdateFeatureStates", changes, opts)}
+}
+
+func (_c *FeatureStateAPI_UpdateFeatureStates_Call) Run(run func(changes []flagsmithapi.FeatureStateChange, opts flagsmithapi.BulkUpdateOptions)) *FeatureStateAPI_UpdateFeatureStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]flagsmithapi.FeatureStateChange), args[1].(flagsmithapi.BulkUpdateOptions))
+	})
+	return _c
+}
+
+func (_c *FeatureStateAPI_UpdateFeatureStates_Call) Return(_a0 *flagsmithapi.BulkUpdateResult, _a1 error) *FeatureStateAPI_UpdateFeatureStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeatureStateAPI_UpdateFeatureStates_Call) RunAndReturn(run func([]flagsmithapi.FeatureStateChange, flagsmithapi.BulkUpdateOptions) (*flagsmithapi.BulkUpdateResult, error)) *FeatureStateAPI_UpdateFeatureStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFeatureStateAPI creates a new instance of FeatureStateAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeatureStateAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FeatureStateAPI {
+	mock := &FeatureStateAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,653 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IdentityAPI is an autogenerated mock type for the IdentityAPI type
+type IdentityAPI struct {
+	mock.Mock
+}
+
+type IdentityAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdentityAPI) EXPECT() *IdentityAPI_Expecter {
+	return &IdentityAPI_Expecter{mock: &_m.Mock}
+}
+
+// CreateIdentity provides a mock function with given fields: environmentKey, identity
+func (_m *IdentityAPI) CreateIdentity(environmentKey string, identity *flagsmithapi.Identity) error {
+	ret := _m.Called(environmentKey, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *flagsmithapi.Identity) error); ok {
+		r0 = rf(environmentKey, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdentityAPI_CreateIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIdentity'
+type IdentityAPI_CreateIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateIdentity is a helper method to define mock.On call
+//   - environmentKey string
+//   - identity *flagsmithapi.Identity
+func (_e *IdentityAPI_Expecter) CreateIdentity(environmentKey interface{}, identity interface{}) *IdentityAPI_CreateIdentity_Call {
+	return &IdentityAPI_CreateIdentity_Call{Call: _e.mock.On("CreateIdentity", environmentKey, identity)}
+}
+
+func (_c *IdentityAPI_CreateIdentity_Call) Run(run func(environmentKey string, identity *flagsmithapi.Identity)) *IdentityAPI_CreateIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*flagsmithapi.Identity))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_CreateIdentity_Call) Return(_a0 error) *IdentityAPI_CreateIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentityAPI_CreateIdentity_Call) RunAndReturn(run func(string, *flagsmithapi.Identity) error) *IdentityAPI_CreateIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateIdentityOverride provides a mock function with given fields: environmentKey, identityID, featureState
+func (_m *IdentityAPI) CreateIdentityOverride(environmentKey string, identityID int64, featureState *flagsmithapi.FeatureState) error {
+	ret := _m.Called(environmentKey, identityID, featureState)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIdentityOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, *flagsmithapi.FeatureState) error); ok {
+		r0 = rf(environmentKey, identityID, featureState)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdentityAPI_CreateIdentityOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIdentityOverride'
+type IdentityAPI_CreateIdentityOverride_Call struct {
+	*mock.Call
+}
+
+// CreateIdentityOverride is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - featureState *flagsmithapi.FeatureState
+func (_e *IdentityAPI_Expecter) CreateIdentityOverride(environmentKey interface{}, identityID interface{}, featureState interface{}) *IdentityAPI_CreateIdentityOverride_Call {
+	return &IdentityAPI_CreateIdentityOverride_Call{Call: _e.mock.On("CreateIdentityOverride", environmentKey, identityID, featureState)}
+}
+
+func (_c *IdentityAPI_CreateIdentityOverride_Call) Run(run func(environmentKey string, identityID int64, featureState *flagsmithapi.FeatureState)) *IdentityAPI_CreateIdentityOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(*flagsmithapi.FeatureState))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_CreateIdentityOverride_Call) Return(_a0 error) *IdentityAPI_CreateIdentityOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentityAPI_CreateIdentityOverride_Call) RunAndReturn(run func(string, int64, *flagsmithapi.FeatureState) error) *IdentityAPI_CreateIdentityOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTrait provides a mock function with given fields: environmentKey, identityID, trait
+func (_m *IdentityAPI) CreateTrait(environmentKey string, identityID int64, trait *flagsmithapi.Trait) error {
+	ret := _m.Called(environmentKey, identityID, trait)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTrait")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, *flagsmithapi.Trait) error); ok {
+		r0 = rf(environmentKey, identityID, trait)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdentityAPI_CreateTrait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTrait'
+type IdentityAPI_CreateTrait_Call struct {
+	*mock.Call
+}
+
+// CreateTrait is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - trait *flagsmithapi.Trait
+func (_e *IdentityAPI_Expecter) CreateTrait(environmentKey interface{}, identityID interface{}, trait interface{}) *IdentityAPI_CreateTrait_Call {
+	return &IdentityAPI_CreateTrait_Call{Call: _e.mock.On("CreateTrait", environmentKey, identityID, trait)}
+}
+
+func (_c *IdentityAPI_CreateTrait_Call) Run(run func(environmentKey string, identityID int64, trait *flagsmithapi.Trait)) *IdentityAPI_CreateTrait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(*flagsmithapi.Trait))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_CreateTrait_Call) Return(_a0 error) *IdentityAPI_CreateTrait_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentityAPI_CreateTrait_Call) RunAndReturn(run func(string, int64, *flagsmithapi.Trait) error) *IdentityAPI_CreateTrait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteIdentity provides a mock function with given fields: environmentKey, identityID
+func (_m *IdentityAPI) DeleteIdentity(environmentKey string, identityID int64) error {
+	ret := _m.Called(environmentKey, identityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(environmentKey, identityID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdentityAPI_DeleteIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIdentity'
+type IdentityAPI_DeleteIdentity_Call struct {
+	*mock.Call
+}
+
+// DeleteIdentity is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+func (_e *IdentityAPI_Expecter) DeleteIdentity(environmentKey interface{}, identityID interface{}) *IdentityAPI_DeleteIdentity_Call {
+	return &IdentityAPI_DeleteIdentity_Call{Call: _e.mock.On("DeleteIdentity", environmentKey, identityID)}
+}
+
+func (_c *IdentityAPI_DeleteIdentity_Call) Run(run func(environmentKey string, identityID int64)) *IdentityAPI_DeleteIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_DeleteIdentity_Call) Return(_a0 error) *IdentityAPI_DeleteIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentityAPI_DeleteIdentity_Call) RunAndReturn(run func(string, int64) error) *IdentityAPI_DeleteIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteIdentityOverride provides a mock function with given fields: environmentKey, identityID, featureStateID
+func (_m *IdentityAPI) DeleteIdentityOverride(environmentKey string, identityID int64, featureStateID int64) error {
+	ret := _m.Called(environmentKey, identityID, featureStateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIdentityOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64) error); ok {
+		r0 = rf(environmentKey, identityID, featureStateID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdentityAPI_DeleteIdentityOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIdentityOverride'
+type IdentityAPI_DeleteIdentityOverride_Call struct {
+	*mock.Call
+}
+
+// DeleteIdentityOverride is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - featureStateID int64
+func (_e *IdentityAPI_Expecter) DeleteIdentityOverride(environmentKey interface{}, identityID interface{}, featureStateID interface{}) *IdentityAPI_DeleteIdentityOverride_Call {
+	return &IdentityAPI_DeleteIdentityOverride_Call{Call: _e.mock.On("DeleteIdentityOverride", environmentKey, identityID, featureStateID)}
+}
+
+func (_c *IdentityAPI_DeleteIdentityOverride_Call) Run(run func(environmentKey string, identityID int64, featureStateID int64)) *IdentityAPI_DeleteIdentityOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_DeleteIdentityOverride_Call) Return(_a0 error) *IdentityAPI_DeleteIdentityOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentityAPI_DeleteIdentityOverride_Call) RunAndReturn(run func(string, int64, int64) error) *IdentityAPI_DeleteIdentityOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTrait provides a mock function with given fields: environmentKey, identityID, traitID
+func (_m *IdentityAPI) DeleteTrait(environmentKey string, identityID int64, traitID int64) error {
+	ret := _m.Called(environmentKey, identityID, traitID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTrait")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64) error); ok {
+		r0 = rf(environmentKey, identityID, traitID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdentityAPI_DeleteTrait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrait'
+type IdentityAPI_DeleteTrait_Call struct {
+	*mock.Call
+}
+
+// DeleteTrait is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - traitID int64
+func (_e *IdentityAPI_Expecter) DeleteTrait(environmentKey interface{}, identityID interface{}, traitID interface{}) *IdentityAPI_DeleteTrait_Call {
+	return &IdentityAPI_DeleteTrait_Call{Call: _e.mock.On("DeleteTrait", environmentKey, identityID, traitID)}
+}
+
+func (_c *IdentityAPI_DeleteTrait_Call) Run(run func(environmentKey string, identityID int64, traitID int64)) *IdentityAPI_DeleteTrait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_DeleteTrait_Call) Return(_a0 error) *IdentityAPI_DeleteTrait_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentityAPI_DeleteTrait_Call) RunAndReturn(run func(string, int64, int64) error) *IdentityAPI_DeleteTrait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIdentity provides a mock function with given fields: environmentKey, identityID
+func (_m *IdentityAPI) GetIdentity(environmentKey string, identityID int64) (*flagsmithapi.Identity, error) {
+	ret := _m.Called(environmentKey, identityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIdentity")
+	}
+
+	var r0 *flagsmithapi.Identity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) (*flagsmithapi.Identity, error)); ok {
+		return rf(environmentKey, identityID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) *flagsmithapi.Identity); ok {
+		r0 = rf(environmentKey, identityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Identity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(environmentKey, identityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdentityAPI_GetIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdentity'
+type IdentityAPI_GetIdentity_Call struct {
+	*mock.Call
+}
+
+// GetIdentity is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+func (_e *IdentityAPI_Expecter) GetIdentity(environmentKey interface{}, identityID interface{}) *IdentityAPI_GetIdentity_Call {
+	return &IdentityAPI_GetIdentity_Call{Call: _e.mock.On("GetIdentity", environmentKey, identityID)}
+}
+
+func (_c *IdentityAPI_GetIdentity_Call) Run(run func(environmentKey string, identityID int64)) *IdentityAPI_GetIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_GetIdentity_Call) Return(_a0 *flagsmithapi.Identity, _a1 error) *IdentityAPI_GetIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdentityAPI_GetIdentity_Call) RunAndReturn(run func(string, int64) (*flagsmithapi.Identity, error)) *IdentityAPI_GetIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTraits provides a mock function with given fields: environmentKey, identityID
+func (_m *IdentityAPI) GetTraits(environmentKey string, identityID int64) ([]flagsmithapi.Trait, error) {
+	ret := _m.Called(environmentKey, identityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTraits")
+	}
+
+	var r0 []flagsmithapi.Trait
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) ([]flagsmithapi.Trait, error)); ok {
+		return rf(environmentKey, identityID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) []flagsmithapi.Trait); ok {
+		r0 = rf(environmentKey, identityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Trait)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(environmentKey, identityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdentityAPI_GetTraits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTraits'
+type IdentityAPI_GetTraits_Call struct {
+	*mock.Call
+}
+
+// GetTraits is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+func (_e *IdentityAPI_Expecter) GetTraits(environmentKey interface{}, identityID interface{}) *IdentityAPI_GetTraits_Call {
+	return &IdentityAPI_GetTraits_Call{Call: _e.mock.On("GetTraits", environmentKey, identityID)}
+}
+
+func (_c *IdentityAPI_GetTraits_Call) Run(run func(environmentKey string, identityID int64)) *IdentityAPI_GetTraits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_GetTraits_Call) Return(_a0 []flagsmithapi.Trait, _a1 error) *IdentityAPI_GetTraits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdentityAPI_GetTraits_Call) RunAndReturn(run func(string, int64) ([]flagsmithapi.Trait, error)) *IdentityAPI_GetTraits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIdentities provides a mock function with given fields: environmentKey, query
+func (_m *IdentityAPI) ListIdentities(environmentKey string, query string) ([]flagsmithapi.Identity, error) {
+	ret := _m.Called(environmentKey, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIdentities")
+	}
+
+	var r0 []flagsmithapi.Identity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]flagsmithapi.Identity, error)); ok {
+		return rf(environmentKey, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []flagsmithapi.Identity); ok {
+		r0 = rf(environmentKey, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Identity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(environmentKey, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdentityAPI_ListIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIdentities'
+type IdentityAPI_ListIdentities_Call struct {
+	*mock.Call
+}
+
+// ListIdentities is a helper method to define mock.On call
+//   - environmentKey string
+//   - query string
+func (_e *IdentityAPI_Expecter) ListIdentities(environmentKey interface{}, query interface{}) *IdentityAPI_ListIdentities_Call {
+	return &IdentityAPI_ListIdentities_Call{Call: _e.mock.On("ListIdentities", environmentKey, query)}
+}
+
+func (_c *IdentityAPI_ListIdentities_Call) Run(run func(environmentKey string, query string)) *IdentityAPI_ListIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_ListIdentities_Call) Return(_a0 []flagsmithapi.Identity, _a1 error) *IdentityAPI_ListIdentities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdentityAPI_ListIdentities_Call) RunAndReturn(run func(string, string) ([]flagsmithapi.Identity, error)) *IdentityAPI_ListIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIdentityOverrides provides a mock function with given fields: environmentKey, identityID
+func (_m *IdentityAPI) ListIdentityOverrides(environmentKey string, identityID int64) ([]flagsmithapi.FeatureState, error) {
+	ret := _m.Called(environmentKey, identityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIdentityOverrides")
+	}
+
+	var r0 []flagsmithapi.FeatureState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) ([]flagsmithapi.FeatureState, error)); ok {
+		return rf(environmentKey, identityID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) []flagsmithapi.FeatureState); ok {
+		r0 = rf(environmentKey, identityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.FeatureState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(environmentKey, identityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdentityAPI_ListIdentityOverrides_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIdentityOverrides'
+type IdentityAPI_ListIdentityOverrides_Call struct {
+	*mock.Call
+}
+
+// ListIdentityOverrides is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+func (_e *IdentityAPI_Expecter) ListIdentityOverrides(environmentKey interface{}, identityID interface{}) *IdentityAPI_ListIdentityOverrides_Call {
+	return &IdentityAPI_ListIdentityOverrides_Call{Call: _e.mock.On("ListIdentityOverrides", environmentKey, identityID)}
+}
+
+func (_c *IdentityAPI_ListIdentityOverrides_Call) Run(run func(environmentKey string, identityID int64)) *IdentityAPI_ListIdentityOverrides_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_ListIdentityOverrides_Call) Return(_a0 []flagsmithapi.FeatureState, _a1 error) *IdentityAPI_ListIdentityOverrides_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdentityAPI_ListIdentityOverrides_Call) RunAndReturn(run func(string, int64) ([]flagsmithapi.FeatureState, error)) *IdentityAPI_ListIdentityOverrides_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateIdentityOverride provides a mock function with given fields: environmentKey, identityID, featureState
+func (_m *IdentityAPI) UpdateIdentityOverride(environmentKey string, identityID int64, featureState *flagsmithapi.FeatureState) error {
+	ret := _m.Called(environmentKey, identityID, featureState)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateIdentityOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, *flagsmithapi.FeatureState) error); ok {
+		r0 = rf(environmentKey, identityID, featureState)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdentityAPI_UpdateIdentityOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateIdentityOverride'
+type IdentityAPI_UpdateIdentityOverride_Call struct {
+	*mock.Call
+}
+
+// UpdateIdentityOverride is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - featureState *flagsmithapi.FeatureState
+func (_e *IdentityAPI_Expecter) UpdateIdentityOverride(environmentKey interface{}, identityID interface{}, featureState interface{}) *IdentityAPI_UpdateIdentityOverride_Call {
+	return &IdentityAPI_UpdateIdentityOverride_Call{Call: _e.mock.On("UpdateIdentityOverride", environmentKey, identityID, featureState)}
+}
+
+func (_c *IdentityAPI_UpdateIdentityOverride_Call) Run(run func(environmentKey string, identityID int64, featureState *flagsmithapi.FeatureState)) *IdentityAPI_UpdateIdentityOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(*flagsmithapi.FeatureState))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_UpdateIdentityOverride_Call) Return(_a0 error) *IdentityAPI_UpdateIdentityOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentityAPI_UpdateIdentityOverride_Call) RunAndReturn(run func(string, int64, *flagsmithapi.FeatureState) error) *IdentityAPI_UpdateIdentityOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTrait provides a mock function with given fields: environmentKey, identityID, trait
+func (_m *IdentityAPI) UpdateTrait(environmentKey string, identityID int64, trait *flagsmithapi.Trait) error {
+	ret := _m.Called(environmentKey, identityID, trait)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTrait")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64, *flagsmithapi.Trait) error); ok {
+		r0 = rf(environmentKey, identityID, trait)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdentityAPI_UpdateTrait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTrait'
+type IdentityAPI_UpdateTrait_Call struct {
+	*mock.Call
+}
+
+// UpdateTrait is a helper method to define mock.On call
+//   - environmentKey string
+//   - identityID int64
+//   - trait *flagsmithapi.Trait
+func (_e *IdentityAPI_Expecter) UpdateTrait(environmentKey interface{}, identityID interface{}, trait interface{}) *IdentityAPI_UpdateTrait_Call {
+	return &IdentityAPI_UpdateTrait_Call{Call: _e.mock.On("UpdateTrait", environmentKey, identityID, trait)}
+}
+
+func (_c *IdentityAPI_UpdateTrait_Call) Run(run func(environmentKey string, identityID int64, trait *flagsmithapi.Trait)) *IdentityAPI_UpdateTrait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(*flagsmithapi.Trait))
+	})
+	return _c
+}
+
+func (_c *IdentityAPI_UpdateTrait_Call) Return(_a0 error) *IdentityAPI_UpdateTrait_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentityAPI_UpdateTrait_Call) RunAndReturn(run func(string, int64, *flagsmithapi.Trait) error) *IdentityAPI_UpdateTrait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIdentityAPI creates a new instance of IdentityAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdentityAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdentityAPI {
+	mock := &IdentityAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,210 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrganisationAPI is an autogenerated mock type for the OrganisationAPI type
+type OrganisationAPI struct {
+	mock.Mock
+}
+
+type OrganisationAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrganisationAPI) EXPECT() *OrganisationAPI_Expecter {
+	return &OrganisationAPI_Expecter{mock: &_m.Mock}
+}
+
+// GetOrganisationByUUID provides a mock function with given fields: orgUUID
+func (_m *OrganisationAPI) GetOrganisationByUUID(orgUUID string) (*flagsmithapi.Organisation, error) {
+	ret := _m.Called(orgUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganisationByUUID")
+	}
+
+	var r0 *flagsmithapi.Organisation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.Organisation, error)); ok {
+		return rf(orgUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.Organisation); ok {
+		r0 = rf(orgUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Organisation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(orgUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganisationAPI_GetOrganisationByUUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganisationByUUID'
+type OrganisationAPI_GetOrganisationByUUID_Call struct {
+	*mock.Call
+}
+
+// GetOrganisationByUUID is a helper method to define mock.On call
+//   - orgUUID string
+func (_e *OrganisationAPI_Expecter) GetOrganisationByUUID(orgUUID interface{}) *OrganisationAPI_GetOrganisationByUUID_Call {
+	return &OrganisationAPI_GetOrganisationByUUID_Call{Call: _e.mock.On("GetOrganisationByUUID", orgUUID)}
+}
+
+func (_c *OrganisationAPI_GetOrganisationByUUID_Call) Run(run func(orgUUID string)) *OrganisationAPI_GetOrganisationByUUID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *OrganisationAPI_GetOrganisationByUUID_Call) Return(_a0 *flagsmithapi.Organisation, _a1 error) *OrganisationAPI_GetOrganisationByUUID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganisationAPI_GetOrganisationByUUID_Call) RunAndReturn(run func(string) (*flagsmithapi.Organisation, error)) *OrganisationAPI_GetOrganisationByUUID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganisationUserByEmail provides a mock function with given fields: orgID, email
+func (_m *OrganisationAPI) GetOrganisationUserByEmail(orgID int64, email string) (*flagsmithapi.User, error) {
+	ret := _m.Called(orgID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganisationUserByEmail")
+	}
+
+	var r0 *flagsmithapi.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, string) (*flagsmithapi.User, error)); ok {
+		return rf(orgID, email)
+	}
+	if rf, ok := ret.Get(0).(func(int64, string) *flagsmithapi.User); ok {
+		r0 = rf(orgID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, string) error); ok {
+		r1 = rf(orgID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganisationAPI_GetOrganisationUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganisationUserByEmail'
+type OrganisationAPI_GetOrganisationUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetOrganisationUserByEmail is a helper method to define mock.On call
+//   - orgID int64
+//   - email string
+func (_e *OrganisationAPI_Expecter) GetOrganisationUserByEmail(orgID interface{}, email interface{}) *OrganisationAPI_GetOrganisationUserByEmail_Call {
+	return &OrganisationAPI_GetOrganisationUserByEmail_Call{Call: _e.mock.On("GetOrganisationUserByEmail", orgID, email)}
+}
+
+func (_c *OrganisationAPI_GetOrganisationUserByEmail_Call) Run(run func(orgID int64, email string)) *OrganisationAPI_GetOrganisationUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrganisationAPI_GetOrganisationUserByEmail_Call) Return(_a0 *flagsmithapi.User, _a1 error) *OrganisationAPI_GetOrganisationUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganisationAPI_GetOrganisationUserByEmail_Call) RunAndReturn(run func(int64, string) (*flagsmithapi.User, error)) *OrganisationAPI_GetOrganisationUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganisationUsers provides a mock function with given fields: orgID
+func (_m *OrganisationAPI) GetOrganisationUsers(orgID int64) ([]flagsmithapi.User, error) {
+	ret := _m.Called(orgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganisationUsers")
+	}
+
+	var r0 []flagsmithapi.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]flagsmithapi.User, error)); ok {
+		return rf(orgID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []flagsmithapi.User); ok {
+		r0 = rf(orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganisationAPI_GetOrganisationUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganisationUsers'
+type OrganisationAPI_GetOrganisationUsers_Call struct {
+	*mock.Call
+}
+
+// GetOrganisationUsers is a helper method to define mock.On call
+//   - orgID int64
+func (_e *OrganisationAPI_Expecter) GetOrganisationUsers(orgID interface{}) *OrganisationAPI_GetOrganisationUsers_Call {
+	return &OrganisationAPI_GetOrganisationUsers_Call{Call: _e.mock.On("GetOrganisationUsers", orgID)}
+}
+
+func (_c *OrganisationAPI_GetOrganisationUsers_Call) Run(run func(orgID int64)) *OrganisationAPI_GetOrganisationUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *OrganisationAPI_GetOrganisationUsers_Call) Return(_a0 []flagsmithapi.User, _a1 error) *OrganisationAPI_GetOrganisationUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrganisationAPI_GetOrganisationUsers_Call) RunAndReturn(run func(int64) ([]flagsmithapi.User, error)) *OrganisationAPI_GetOrganisationUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrganisationAPI creates a new instance of OrganisationAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrganisationAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrganisationAPI {
+	mock := &OrganisationAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
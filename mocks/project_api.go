@@ -0,0 +1,347 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ProjectAPI is an autogenerated mock type for the ProjectAPI type
+type ProjectAPI struct {
+	mock.Mock
+}
+
+type ProjectAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ProjectAPI) EXPECT() *ProjectAPI_Expecter {
+	return &ProjectAPI_Expecter{mock: &_m.Mock}
+}
+
+// CreateProject provides a mock function with given fields: project
+func (_m *ProjectAPI) CreateProject(project *flagsmithapi.Project) error {
+	ret := _m.Called(project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Project) error); ok {
+		r0 = rf(project)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProjectAPI_CreateProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProject'
+type ProjectAPI_CreateProject_Call struct {
+	*mock.Call
+}
+
+// CreateProject is a helper method to define mock.On call
+//   - project *flagsmithapi.Project
+func (_e *ProjectAPI_Expecter) CreateProject(project interface{}) *ProjectAPI_CreateProject_Call {
+	return &ProjectAPI_CreateProject_Call{Call: _e.mock.On("CreateProject", project)}
+}
+
+func (_c *ProjectAPI_CreateProject_Call) Run(run func(project *flagsmithapi.Project)) *ProjectAPI_CreateProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Project))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_CreateProject_Call) Return(_a0 error) *ProjectAPI_CreateProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProjectAPI_CreateProject_Call) RunAndReturn(run func(*flagsmithapi.Project) error) *ProjectAPI_CreateProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProject provides a mock function with given fields: projectID
+func (_m *ProjectAPI) DeleteProject(projectID int64) error {
+	ret := _m.Called(projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(projectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProjectAPI_DeleteProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProject'
+type ProjectAPI_DeleteProject_Call struct {
+	*mock.Call
+}
+
+// DeleteProject is a helper method to define mock.On call
+//   - projectID int64
+func (_e *ProjectAPI_Expecter) DeleteProject(projectID interface{}) *ProjectAPI_DeleteProject_Call {
+	return &ProjectAPI_DeleteProject_Call{Call: _e.mock.On("DeleteProject", projectID)}
+}
+
+func (_c *ProjectAPI_DeleteProject_Call) Run(run func(projectID int64)) *ProjectAPI_DeleteProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_DeleteProject_Call) Return(_a0 error) *ProjectAPI_DeleteProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProjectAPI_DeleteProject_Call) RunAndReturn(run func(int64) error) *ProjectAPI_DeleteProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProject provides a mock function with given fields: projectUUID
+func (_m *ProjectAPI) GetProject(projectUUID string) (*flagsmithapi.Project, error) {
+	ret := _m.Called(projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProject")
+	}
+
+	var r0 *flagsmithapi.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flagsmithapi.Project, error)); ok {
+		return rf(projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flagsmithapi.Project); ok {
+		r0 = rf(projectUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_GetProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProject'
+type ProjectAPI_GetProject_Call struct {
+	*mock.Call
+}
+
+// GetProject is a helper method to define mock.On call
+//   - projectUUID string
+func (_e *ProjectAPI_Expecter) GetProject(projectUUID interface{}) *ProjectAPI_GetProject_Call {
+	return &ProjectAPI_GetProject_Call{Call: _e.mock.On("GetProject", projectUUID)}
+}
+
+func (_c *ProjectAPI_GetProject_Call) Run(run func(projectUUID string)) *ProjectAPI_GetProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_GetProject_Call) Return(_a0 *flagsmithapi.Project, _a1 error) *ProjectAPI_GetProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_GetProject_Call) RunAndReturn(run func(string) (*flagsmithapi.Project, error)) *ProjectAPI_GetProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectByID provides a mock function with given fields: projectID
+func (_m *ProjectAPI) GetProjectByID(projectID int64) (*flagsmithapi.Project, error) {
+	ret := _m.Called(projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectByID")
+	}
+
+	var r0 *flagsmithapi.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (*flagsmithapi.Project, error)); ok {
+		return rf(projectID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) *flagsmithapi.Project); ok {
+		r0 = rf(projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_GetProjectByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectByID'
+type ProjectAPI_GetProjectByID_Call struct {
+	*mock.Call
+}
+
+// GetProjectByID is a helper method to define mock.On call
+//   - projectID int64
+func (_e *ProjectAPI_Expecter) GetProjectByID(projectID interface{}) *ProjectAPI_GetProjectByID_Call {
+	return &ProjectAPI_GetProjectByID_Call{Call: _e.mock.On("GetProjectByID", projectID)}
+}
+
+func (_c *ProjectAPI_GetProjectByID_Call) Run(run func(projectID int64)) *ProjectAPI_GetProjectByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_GetProjectByID_Call) Return(_a0 *flagsmithapi.Project, _a1 error) *ProjectAPI_GetProjectByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_GetProjectByID_Call) RunAndReturn(run func(int64) (*flagsmithapi.Project, error)) *ProjectAPI_GetProjectByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProjects provides a mock function with given fields: organisationID
+func (_m *ProjectAPI) ListProjects(organisationID int64) ([]flagsmithapi.Project, error) {
+	ret := _m.Called(organisationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjects")
+	}
+
+	var r0 []flagsmithapi.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]flagsmithapi.Project, error)); ok {
+		return rf(organisationID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []flagsmithapi.Project); ok {
+		r0 = rf(organisationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(organisationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_ListProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjects'
+type ProjectAPI_ListProjects_Call struct {
+	*mock.Call
+}
+
+// ListProjects is a helper method to define mock.On call
+//   - organisationID int64
+func (_e *ProjectAPI_Expecter) ListProjects(organisationID interface{}) *ProjectAPI_ListProjects_Call {
+	return &ProjectAPI_ListProjects_Call{Call: _e.mock.On("ListProjects", organisationID)}
+}
+
+func (_c *ProjectAPI_ListProjects_Call) Run(run func(organisationID int64)) *ProjectAPI_ListProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_ListProjects_Call) Return(_a0 []flagsmithapi.Project, _a1 error) *ProjectAPI_ListProjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_ListProjects_Call) RunAndReturn(run func(int64) ([]flagsmithapi.Project, error)) *ProjectAPI_ListProjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProject provides a mock function with given fields: project
+func (_m *ProjectAPI) UpdateProject(project *flagsmithapi.Project) error {
+	ret := _m.Called(project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Project) error); ok {
+		r0 = rf(project)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProjectAPI_UpdateProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProject'
+type ProjectAPI_UpdateProject_Call struct {
+	*mock.Call
+}
+
+// UpdateProject is a helper method to define mock.On call
+//   - project *flagsmithapi.Project
+func (_e *ProjectAPI_Expecter) UpdateProject(project interface{}) *ProjectAPI_UpdateProject_Call {
+	return &ProjectAPI_UpdateProject_Call{Call: _e.mock.On("UpdateProject", project)}
+}
+
+func (_c *ProjectAPI_UpdateProject_Call) Run(run func(project *flagsmithapi.Project)) *ProjectAPI_UpdateProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Project))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_UpdateProject_Call) Return(_a0 error) *ProjectAPI_UpdateProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProjectAPI_UpdateProject_Call) RunAndReturn(run func(*flagsmithapi.Project) error) *ProjectAPI_UpdateProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProjectAPI creates a new instance of ProjectAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProjectAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProjectAPI {
+	mock := &ProjectAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,291 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagAPI is an autogenerated mock type for the TagAPI type
+type TagAPI struct {
+	mock.Mock
+}
+
+type TagAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagAPI) EXPECT() *TagAPI_Expecter {
+	return &TagAPI_Expecter{mock: &_m.Mock}
+}
+
+// CreateTag provides a mock function with given fields: tag
+func (_m *TagAPI) CreateTag(tag *flagsmithapi.Tag) error {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Tag) error); ok {
+		r0 = rf(tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagAPI_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type TagAPI_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - tag *flagsmithapi.Tag
+func (_e *TagAPI_Expecter) CreateTag(tag interface{}) *TagAPI_CreateTag_Call {
+	return &TagAPI_CreateTag_Call{Call: _e.mock.On("CreateTag", tag)}
+}
+
+func (_c *TagAPI_CreateTag_Call) Run(run func(tag *flagsmithapi.Tag)) *TagAPI_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Tag))
+	})
+	return _c
+}
+
+func (_c *TagAPI_CreateTag_Call) Return(_a0 error) *TagAPI_CreateTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagAPI_CreateTag_Call) RunAndReturn(run func(*flagsmithapi.Tag) error) *TagAPI_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: projectID, tagID
+func (_m *TagAPI) DeleteTag(projectID int64, tagID int64) error {
+	ret := _m.Called(projectID, tagID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(projectID, tagID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagAPI_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type TagAPI_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - projectID int64
+//   - tagID int64
+func (_e *TagAPI_Expecter) DeleteTag(projectID interface{}, tagID interface{}) *TagAPI_DeleteTag_Call {
+	return &TagAPI_DeleteTag_Call{Call: _e.mock.On("DeleteTag", projectID, tagID)}
+}
+
+func (_c *TagAPI_DeleteTag_Call) Run(run func(projectID int64, tagID int64)) *TagAPI_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *TagAPI_DeleteTag_Call) Return(_a0 error) *TagAPI_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagAPI_DeleteTag_Call) RunAndReturn(run func(int64, int64) error) *TagAPI_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTag provides a mock function with given fields: projectUUID, tagUUID
+func (_m *TagAPI) GetTag(projectUUID string, tagUUID string) (*flagsmithapi.Tag, error) {
+	ret := _m.Called(projectUUID, tagUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTag")
+	}
+
+	var r0 *flagsmithapi.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*flagsmithapi.Tag, error)); ok {
+		return rf(projectUUID, tagUUID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *flagsmithapi.Tag); ok {
+		r0 = rf(projectUUID, tagUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flagsmithapi.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(projectUUID, tagUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagAPI_GetTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTag'
+type TagAPI_GetTag_Call struct {
+	*mock.Call
+}
+
+// GetTag is a helper method to define mock.On call
+//   - projectUUID string
+//   - tagUUID string
+func (_e *TagAPI_Expecter) GetTag(projectUUID interface{}, tagUUID interface{}) *TagAPI_GetTag_Call {
+	return &TagAPI_GetTag_Call{Call: _e.mock.On("GetTag", projectUUID, tagUUID)}
+}
+
+func (_c *TagAPI_GetTag_Call) Run(run func(projectUUID string, tagUUID string)) *TagAPI_GetTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TagAPI_GetTag_Call) Return(_a0 *flagsmithapi.Tag, _a1 error) *TagAPI_GetTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagAPI_GetTag_Call) RunAndReturn(run func(string, string) (*flagsmithapi.Tag, error)) *TagAPI_GetTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTags provides a mock function with given fields: projectID
+func (_m *TagAPI) ListTags(projectID int64) ([]flagsmithapi.Tag, error) {
+	ret := _m.Called(projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 []flagsmithapi.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]flagsmithapi.Tag, error)); ok {
+		return rf(projectID)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []flagsmithapi.Tag); ok {
+		r0 = rf(projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flagsmithapi.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagAPI_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type TagAPI_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - projectID int64
+func (_e *TagAPI_Expecter) ListTags(projectID interface{}) *TagAPI_ListTags_Call {
+	return &TagAPI_ListTags_Call{Call: _e.mock.On("ListTags", projectID)}
+}
+
+func (_c *TagAPI_ListTags_Call) Run(run func(projectID int64)) *TagAPI_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *TagAPI_ListTags_Call) Return(_a0 []flagsmithapi.Tag, _a1 error) *TagAPI_ListTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagAPI_ListTags_Call) RunAndReturn(run func(int64) ([]flagsmithapi.Tag, error)) *TagAPI_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: tag
+func (_m *TagAPI) UpdateTag(tag *flagsmithapi.Tag) error {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*flagsmithapi.Tag) error); ok {
+		r0 = rf(tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagAPI_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type TagAPI_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - tag *flagsmithapi.Tag
+func (_e *TagAPI_Expecter) UpdateTag(tag interface{}) *TagAPI_UpdateTag_Call {
+	return &TagAPI_UpdateTag_Call{Call: _e.mock.On("UpdateTag", tag)}
+}
+
+func (_c *TagAPI_UpdateTag_Call) Run(run func(tag *flagsmithapi.Tag)) *TagAPI_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*flagsmithapi.Tag))
+	})
+	return _c
+}
+
+func (_c *TagAPI_UpdateTag_Call) Return(_a0 error) *TagAPI_UpdateTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagAPI_UpdateTag_Call) RunAndReturn(run func(*flagsmithapi.Tag) error) *TagAPI_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagAPI creates a new instance of TagAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagAPI {
+	mock := &TagAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,7 +2,6 @@ package flagsmithapi
 
 import (
 	"encoding/json"
-	"log"
 	"time"
 )
 
@@ -46,6 +45,8 @@ type Feature struct {
 	Owners         *[]int64 `json:"owners,omitempty"`
 	GroupOwners    *[]int64 `json:"group_owners,omitempty"`
 	Tags           []int64  `json:"tags"`
+	// CreatedDate is set by the API and ignored on create and update
+	CreatedDate *time.Time `json:"created_date,omitempty"`
 
 	ProjectUUID string `json:"-"`
 	ProjectID   *int64 `json:"project,omitempty"`
@@ -71,6 +72,7 @@ func (f *Feature) UnmarshalJSON(data []byte) error {
 		GroupOwners    []groupOwner `json:"group_owners,omitempty"`
 		ProjectID      *int64       `json:"project,omitempty"`
 		Tags           []int64      `json:"tags"`
+		CreatedDate    *time.Time   `json:"created_date,omitempty"`
 	}
 
 	err := json.Unmarshal(data, &obj)
@@ -89,6 +91,7 @@ func (f *Feature) UnmarshalJSON(data []byte) error {
 	f.IsArchived = obj.IsArchived
 	f.ProjectID = obj.ProjectID
 	f.Tags = obj.Tags
+	f.CreatedDate = obj.CreatedDate
 	if obj.Owners != nil {
 		f.Owners = &[]int64{}
 		for _, o := range obj.Owners {
@@ -110,22 +113,51 @@ type FeatureStateValue struct {
 	StringValue  *string `json:"string_value"`
 	IntegerValue *int64  `json:"integer_value"`
 	BooleanValue *bool   `json:"boolean_value"`
+	// FloatValue is only set, with the "float" type, for the non-integer values of environment documents
+	FloatValue *float64 `json:"float_value,omitempty"`
+}
+
+// MultivariateFeatureStateValue is the percentage of identities served a multivariate option by a feature state
+type MultivariateFeatureStateValue struct {
+	ID                        *int64  `json:"id,omitempty"`
+	MultivariateFeatureOption int64   `json:"multivariate_feature_option"`
+	PercentageAllocation      float64 `json:"percentage_allocation"`
 }
 
 type FeatureState struct {
-	ID                int64              `json:"id,omitempty"`
-	UUID              string             `json:"uuid,omitempty"`
-	FeatureStateValue *FeatureStateValue `json:"feature_state_value"`
-	Enabled           bool               `json:"enabled"`
-	Feature           int64              `json:"feature"`
-	Environment       *int64             `json:"environment"`
-	FeatureSegment    *int64             `json:"feature_segment,omitempty"`
+	ID                             int64                           `json:"id,omitempty"`
+	UUID                           string                          `json:"uuid,omitempty"`
+	FeatureStateValue              *FeatureStateValue              `json:"feature_state_value"`
+	Enabled                        bool                            `json:"enabled"`
+	Feature                        int64                           `json:"feature"`
+	Environment                    *int64                          `json:"environment"`
+	FeatureSegment                 *int64                          `json:"feature_segment,omitempty"`
+	MultivariateFeatureStateValues []MultivariateFeatureStateValue `json:"multivariate_feature_state_values,omitempty"`
+	// UpdatedAt is set by the API and ignored on create and update
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
 	EnvironmentKey  string `json:"-"`
 	Segment         *int64 `json:"-"`
 	SegmentPriority *int64 `json:"-"`
 }
 
+// MarshalJSON leaves out nil multivariate splits, so that the splits of the feature state are kept, while an empty
+// list removes all of them
+func (fs FeatureState) MarshalJSON() ([]byte, error) {
+	type featureState FeatureState
+	obj := struct {
+		featureState
+		MultivariateFeatureStateValues *[]MultivariateFeatureStateValue `json:"multivariate_feature_state_values,omitempty"`
+	}{featureState: featureState(fs)}
+	if fs.MultivariateFeatureStateValues != nil {
+		obj.MultivariateFeatureStateValues = &fs.MultivariateFeatureStateValues
+	}
+	return json.Marshal(obj)
+}
+
+// UnmarshalJSON decodes both the feature state values of the API, which are objects, and the raw values of the
+// environment feature states; a feature state value that is missing or cannot be decoded is left nil, which the
+// client decoding the response logs as a warning
 func (fs *FeatureState) UnmarshalJSON(data []byte) error {
 	var obj struct {
 		ID                int64           `json:"id"`
@@ -135,6 +167,9 @@ func (fs *FeatureState) UnmarshalJSON(data []byte) error {
 		Feature           int64           `json:"feature"`
 		Environment       *int64          `json:"environment"`
 		FeatureSegment    *int64          `json:"feature_segment"`
+
+		MultivariateFeatureStateValues []MultivariateFeatureStateValue `json:"multivariate_feature_state_values"`
+		UpdatedAt                      *time.Time                      `json:"updated_at"`
 	}
 
 	err := json.Unmarshal(data, &obj)
@@ -148,6 +183,8 @@ func (fs *FeatureState) UnmarshalJSON(data []byte) error {
 	fs.Environment = obj.Environment
 	fs.FeatureSegment = obj.FeatureSegment
 	fs.UUID = obj.UUID
+	fs.UpdatedAt = obj.UpdatedAt
+	fs.MultivariateFeatureStateValues = obj.MultivariateFeatureStateValues
 
 	// If the feature state value is a struct(i.e: we are using `/features/featurestates/` endpoint) then unmarshal, set
 	// and exit
@@ -160,10 +197,7 @@ func (fs *FeatureState) UnmarshalJSON(data []byte) error {
 	}
 	// else(i.e: we are using `/environments/<env_key>/featurestates/` endpoint) convert the feature state value to struct, set and exit
 	var fsValueRaw interface{}
-	err = json.Unmarshal(obj.FeatureStateValue, &fsValueRaw)
-	if err != nil {
-		log.Println("flagsmithapi: Error unmarshalling FeatureStateValue: ", err)
-	}
+	_ = json.Unmarshal(obj.FeatureStateValue, &fsValueRaw)
 
 	switch fsv := fsValueRaw.(type) {
 	case int64:
@@ -294,3 +328,15 @@ type User struct {
 	UUID       string `json:"uuid"`
 	Role       string `json:"role"`
 }
+
+// ChangeRequest proposes changes to the feature states of an environment that require approval before they are
+// applied
+type ChangeRequest struct {
+	ID            int64          `json:"id,omitempty"`
+	Title         string         `json:"title"`
+	Description   string         `json:"description,omitempty"`
+	FeatureStates []FeatureState `json:"feature_states"`
+	IsCommitted   bool           `json:"is_committed,omitempty"`
+
+	EnvironmentKey string `json:"-"`
+}
@@ -0,0 +1,117 @@
+package flagsmithapi
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Flagsmith/flagsmith-go-api-client/httpcache"
+)
+
+// Option configures optional behaviour of a Client created with NewClient.
+type Option func(c *Client)
+
+// WithAuthenticator sets the credentials of the requests, i.e: a UserToken or a CredentialProvider, instead of the
+// master API key given to NewClient, which may then be empty
+func WithAuthenticator(authenticator Authenticator) Option {
+	return func(c *Client) {
+		c.authenticator = authenticator
+	}
+}
+
+// WithValueValidator sets a validator that is run against string values before
+// UpdateFeatureState and CreateFeature send them to the API.
+func WithValueValidator(validator ValueValidator) Option {
+	return func(c *Client) {
+		c.valueValidator = validator
+	}
+}
+
+// WithFeatureNameValidation makes CreateFeature check the name of the feature against the naming policy of its
+// project(see ValidateFeatureName) before sending it to the API. The project is fetched for each new feature unless
+// the client is created WithIDCache too, which caches it for the ttl of the cache or until it is updated or deleted
+// through the client.
+func WithFeatureNameValidation() Option {
+	return func(c *Client) {
+		c.validateFeatureNames = true
+	}
+}
+
+// WithIDCache caches the mappings between the IDs, UUIDs and API keys of projects, environments and features for ttl,
+// so that methods which resolve one into another(i.e: GetFeature, GetTag, CreateSegmentOverride) do not repeat the
+// lookup request. Entries are dropped when the resource is updated or deleted through the client.
+func WithIDCache(ttl time.Duration) Option {
+	return func(c *Client) {
+		c.ids = newIDCache(ttl)
+	}
+}
+
+// WithHTTPCache caches the responses to GET requests in store and revalidates them with conditional requests(see the
+// httpcache package). It wraps the transport set by a WithTransport option given before it.
+func WithHTTPCache(store httpcache.Store) Option {
+	return func(c *Client) {
+		c.client.SetTransport(httpcache.New(store, c.client.GetClient().Transport))
+	}
+}
+
+// WithRateLimiter makes every request of the client wait for the limiter, which may be shared with other clients(see
+// SharedRateLimiter)
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.client.OnBeforeRequest(c.waitForRateLimiter(limiter))
+	}
+}
+
+// WithLogger logs the requests of the client and their responses to handler: successful requests at the debug level,
+// and failed requests and error responses, with their body, at the warn level. Credentials and server-side keys are
+// redacted. The handler also receives the warnings of the client, which otherwise go to the default slog logger.
+func WithLogger(handler slog.Handler) Option {
+	return func(c *Client) {
+		c.logger = slog.New(handler)
+		c.beforeRequestHooks = append(c.beforeRequestHooks, c.logRequest)
+		c.afterResponseHooks = append(c.afterResponseHooks, c.logResponse)
+	}
+}
+
+// WithBeforeRequest calls hook before each request is sent; hooks are called in the order they are given
+func WithBeforeRequest(hook BeforeRequestHook) Option {
+	return func(c *Client) {
+		c.beforeRequestHooks = append(c.beforeRequestHooks, hook)
+	}
+}
+
+// WithAfterResponse calls hook after each request, with its response or error; hooks are called in the order they
+// are given
+func WithAfterResponse(hook AfterResponseHook) Option {
+	return func(c *Client) {
+		c.afterResponseHooks = append(c.afterResponseHooks, hook)
+	}
+}
+
+// WithTelemetry instruments the client with OpenTelemetry; nil providers default to the global ones. Every public
+// method creates a span named after it(i.e: flagsmithapi.GetFeature) with the flagsmith.resource and
+// flagsmith.operation attributes, the IDs it was called with(i.e: flagsmith.feature.uuid) and the status code of its
+// last response. Its requests, and the methods it calls to resolve IDs or load related resources, are nested spans.
+//
+// Requests are also counted in the flagsmith.client.requests and flagsmith.client.request.errors counters, and timed
+// in the flagsmith.client.request.duration histogram.
+func WithTelemetry(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) Option {
+	return func(c *Client) {
+		t := newTelemetry(tracerProvider, meterProvider)
+		c.telemetry = t
+		c.client.OnBeforeRequest(t.beforeRequest)
+		c.client.OnSuccess(t.onSuccess)
+		c.client.OnError(t.onError)
+	}
+}
+
+// WithTransport sets the http.RoundTripper used to send requests, i.e: a cassette.Transport to record and replay
+// interactions in tests
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.client.SetTransport(transport)
+	}
+}
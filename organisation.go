@@ -5,9 +5,12 @@ import (
 )
 
 func (c *Client) GetOrganisationByUUID(orgUUID string) (*Organisation, error) {
+	c, op := c.startOperation("GetOrganisationByUUID", "organisation", "get", uuidAttribute("organisation", orgUUID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/organisations/get-by-uuid/%s/", c.baseURL, orgUUID)
 	organisation := Organisation{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&organisation).
 		Get(url)
 
@@ -22,9 +25,12 @@ func (c *Client) GetOrganisationByUUID(orgUUID string) (*Organisation, error) {
 }
 
 func (c *Client) GetOrganisationUsers(orgID int64) ([]User, error) {
+	c, op := c.startOperation("GetOrganisationUsers", "user", "list", idAttribute("organisation", orgID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/organisations/%d/users/", c.baseURL, orgID)
 	users := []User{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&users).
 		Get(url)
 
@@ -38,6 +44,9 @@ func (c *Client) GetOrganisationUsers(orgID int64) ([]User, error) {
 }
 
 func (c *Client) GetOrganisationUserByEmail(orgID int64, email string) (*User, error) {
+	c, op := c.startOperation("GetOrganisationUserByEmail", "user", "get", idAttribute("organisation", orgID))
+	defer op.end()
+
 	users, err := c.GetOrganisationUsers(orgID)
 	if err != nil {
 		return nil, err
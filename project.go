@@ -2,12 +2,16 @@ package flagsmithapi
 
 import (
 	"fmt"
+	"strconv"
 )
 
 func (c *Client) GetProject(projectUUID string) (*Project, error) {
+	c, op := c.startOperation("GetProject", "project", "get", uuidAttribute("project", projectUUID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/get-by-uuid/%s/", c.baseURL, projectUUID)
 	project := Project{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&project).
 		Get(url)
 
@@ -17,13 +21,17 @@ func (c *Client) GetProject(projectUUID string) (*Project, error) {
 	if !resp.IsSuccess() {
 		return nil, fmt.Errorf("flagsmithapi: Error getting project: %s", resp)
 	}
+	c.ids.setProject(&project)
 	return &project, nil
 
 }
 func (c *Client) GetProjectByID(projectID int64) (*Project, error) {
+	c, op := c.startOperation("GetProjectByID", "project", "get", idAttribute("project", projectID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/%d/", c.baseURL, projectID)
 	project := Project{}
-	resp, err := c.client.R().
+	resp, err := c.request().
 		SetResult(&project).
 		Get(url)
 
@@ -33,13 +41,17 @@ func (c *Client) GetProjectByID(projectID int64) (*Project, error) {
 	if !resp.IsSuccess() {
 		return nil, fmt.Errorf("flagsmithapi: Error getting project: %s", resp)
 	}
+	c.ids.setProject(&project)
 	return &project, nil
 
 }
 
 func (c *Client) CreateProject(project *Project) error {
+	c, op := c.startOperation("CreateProject", "project", "create", idAttribute("organisation", project.Organisation))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/", c.baseURL)
-	resp, err := c.client.R().SetBody(project).SetResult(project).Post(url)
+	resp, err := c.request().SetBody(project).SetResult(project).Post(url)
 
 	if err != nil {
 		return err
@@ -48,13 +60,17 @@ func (c *Client) CreateProject(project *Project) error {
 	if !resp.IsSuccess() {
 		return fmt.Errorf("flagsmithapi: Error creating project: %s", resp)
 	}
+	c.ids.setProject(project)
 
 	return nil
 }
 
 func (c *Client) UpdateProject(project *Project) error {
+	c, op := c.startOperation("UpdateProject", "project", "update", idAttribute("project", project.ID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/%d/", c.baseURL, project.ID)
-	resp, err := c.client.R().SetBody(project).SetResult(project).Put(url)
+	resp, err := c.request().SetBody(project).SetResult(project).Put(url)
 
 	if err != nil {
 		return err
@@ -63,14 +79,18 @@ func (c *Client) UpdateProject(project *Project) error {
 	if !resp.IsSuccess() {
 		return fmt.Errorf("flagsmithapi: Error updating project: %s", resp)
 	}
+	c.ids.deleteProject(project.ID)
 
 	return nil
 }
 
 func (c *Client) DeleteProject(projectID int64) error {
+	c, op := c.startOperation("DeleteProject", "project", "delete", idAttribute("project", projectID))
+	defer op.end()
+
 	url := fmt.Sprintf("%s/projects/%d/", c.baseURL, projectID)
 
-	resp, err := c.client.R().Delete(url)
+	resp, err := c.request().Delete(url)
 	if err != nil {
 		return err
 	}
@@ -78,6 +98,17 @@ func (c *Client) DeleteProject(projectID int64) error {
 	if !resp.IsSuccess() {
 		return fmt.Errorf("flagsmithapi: Error deleting project: %s", resp)
 	}
+	c.ids.deleteProject(projectID)
 
 	return nil
 }
+
+func (c *Client) ListProjects(organisationID int64) ([]Project, error) {
+	c, op := c.startOperation("ListProjects", "project", "list", idAttribute("organisation", organisationID))
+	defer op.end()
+
+	url := fmt.Sprintf("%s/projects/", c.baseURL)
+	return listResults[Project](c, url, map[string]string{
+		"organisation": strconv.FormatInt(organisationID, 10),
+	})
+}
@@ -0,0 +1,357 @@
+package flagsmithapi
+
+import (
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// PromoteOptions selects the changes applied by PromoteEnvironment
+type PromoteOptions struct {
+	// Features restricts the promotion to the features with the given names; every feature is promoted when empty
+	Features []string
+	// SkipSegmentOverrides leaves the segment overrides of the destination environment untouched
+	SkipSegmentOverrides bool
+	// DryRun computes the differences without changing the destination environment
+	DryRun bool
+	// ChangeRequestTitle is the title of the change request created when the destination environment requires
+	// approvals; it defaults to "Promote <source> to <destination>"
+	ChangeRequestTitle string
+}
+
+// Promotion is the result of PromoteEnvironment
+type Promotion struct {
+	Source      *Environment
+	Destination *Environment
+	// Features lists the features whose states differ between the environments, ordered by name
+	Features []FeatureStateDiff
+	// ChangeRequest is set when the destination environment requires approvals and the changes were submitted as a
+	// change request rather than applied
+	ChangeRequest *ChangeRequest
+}
+
+// FeatureStateDiff describes how the states of a feature differ between the source and destination environments
+type FeatureStateDiff struct {
+	Feature Feature
+	// Fields lists the fields of the environment default feature state that differ: "enabled", "value" and
+	// "multivariate_splits"
+	Fields           []string
+	Source           *FeatureState
+	Destination      *FeatureState
+	SegmentOverrides []SegmentOverrideDiff
+}
+
+// SegmentOverrideDiff describes a segment override to create(Destination is nil), delete(Source is nil) or update in
+// the destination environment
+type SegmentOverrideDiff struct {
+	Segment int64
+	// Fields lists the fields that differ: "enabled", "value", "multivariate_splits" and "priority"
+	Fields      []string
+	Source      *FeatureState
+	Destination *FeatureState
+}
+
+// PromoteEnvironment compares the feature states and segment overrides of two environments of the same project and
+// makes the destination environment match the source. If the destination requires change request approvals, the
+// environment default changes are submitted as a single change request instead of being applied; segment overrides
+// can not be changed through change requests, so promoting them to such an environment fails unless
+// SkipSegmentOverrides is set.
+func (c *Client) PromoteEnvironment(sourceKey, destinationKey string, opts PromoteOptions) (*Promotion, error) {
+	c, op := c.startOperation("PromoteEnvironment", "environment", "promote",
+		environmentKeyAttribute(sourceKey), attribute.String("flagsmith.destination_environment.key", destinationKey))
+	defer op.end()
+
+	source, err := c.GetEnvironment(sourceKey)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := c.GetEnvironment(destinationKey)
+	if err != nil {
+		return nil, err
+	}
+	if source.ProjectID != destination.ProjectID {
+		return nil, fmt.Errorf("flagsmithapi: can not promote environment '%s' to '%s' of a different project",
+			source.Name, destination.Name)
+	}
+	promotion := &Promotion{Source: source, Destination: destination, Features: []FeatureStateDiff{}}
+
+	features, err := c.ListFeatures(source.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	features, err = selectFeatures(features, opts.Features)
+	if err != nil {
+		return nil, err
+	}
+	sourceStates, err := c.environmentFeatureStatesByFeature(sourceKey)
+	if err != nil {
+		return nil, err
+	}
+	destinationStates, err := c.environmentFeatureStatesByFeature(destinationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, feature := range features {
+		sourceState, destinationState := sourceStates[*feature.ID], destinationStates[*feature.ID]
+		if sourceState == nil || destinationState == nil {
+			continue
+		}
+		diff := FeatureStateDiff{
+			Feature:     feature,
+			Fields:      diffFeatureStates(sourceState, destinationState),
+			Source:      sourceState,
+			Destination: destinationState,
+		}
+		if !opts.SkipSegmentOverrides {
+			diff.SegmentOverrides, err = c.diffSegmentOverrides(sourceKey, destinationKey, *feature.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(diff.Fields) > 0 || len(diff.SegmentOverrides) > 0 {
+			promotion.Features = append(promotion.Features, diff)
+		}
+	}
+
+	if opts.DryRun || len(promotion.Features) == 0 {
+		return promotion, nil
+	}
+	if destination.MinimumChangeRequestApprovals > 0 {
+		return promotion, c.submitPromotion(promotion, opts)
+	}
+	return promotion, c.applyPromotion(promotion)
+}
+
+// selectFeatures returns the features with the given names(or all features if names is empty), ordered by name
+func selectFeatures(features []Feature, names []string) ([]Feature, error) {
+	byName := map[string]Feature{}
+	for _, feature := range features {
+		byName[feature.Name] = feature
+	}
+	if len(names) > 0 {
+		selected := []Feature{}
+		for _, name := range names {
+			feature, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("flagsmithapi: feature '%s' not found", name)
+			}
+			selected = append(selected, feature)
+		}
+		features = selected
+	}
+	sort.SliceStable(features, func(i, j int) bool { return features[i].Name < features[j].Name })
+	return features, nil
+}
+
+func (c *Client) environmentFeatureStatesByFeature(environmentKey string) (map[int64]*FeatureState, error) {
+	featureStates, err := c.ListEnvironmentFeatureStates(environmentKey)
+	if err != nil {
+		return nil, err
+	}
+	byFeature := map[int64]*FeatureState{}
+	for i := range featureStates {
+		byFeature[featureStates[i].Feature] = &featureStates[i]
+	}
+	return byFeature, nil
+}
+
+func (c *Client) diffSegmentOverrides(sourceKey, destinationKey string, featureID int64) ([]SegmentOverrideDiff, error) {
+	sourceOverrides, err := c.ListSegmentOverrides(sourceKey, featureID)
+	if err != nil {
+		return nil, err
+	}
+	destinationOverrides, err := c.ListSegmentOverrides(destinationKey, featureID)
+	if err != nil {
+		return nil, err
+	}
+	bySegment := map[int64]*FeatureState{}
+	for i := range destinationOverrides {
+		if destinationOverrides[i].Segment != nil {
+			bySegment[*destinationOverrides[i].Segment] = &destinationOverrides[i]
+		}
+	}
+
+	diffs := []SegmentOverrideDiff{}
+	for i := range sourceOverrides {
+		sourceOverride := &sourceOverrides[i]
+		if sourceOverride.Segment == nil {
+			continue
+		}
+		destinationOverride, ok := bySegment[*sourceOverride.Segment]
+		if !ok {
+			diffs = append(diffs, SegmentOverrideDiff{Segment: *sourceOverride.Segment, Source: sourceOverride})
+			continue
+		}
+		delete(bySegment, *sourceOverride.Segment)
+		fields := diffFeatureStates(sourceOverride, destinationOverride)
+		// overrides without a priority rank last, like ListSegmentOverrides orders them
+		if segmentPriority(sourceOverride.SegmentPriority) != segmentPriority(destinationOverride.SegmentPriority) {
+			fields = append(fields, "priority")
+		}
+		if len(fields) > 0 {
+			diffs = append(diffs, SegmentOverrideDiff{
+				Segment: *sourceOverride.Segment, Fields: fields, Source: sourceOverride, Destination: destinationOverride,
+			})
+		}
+	}
+	for i := range destinationOverrides {
+		if destinationOverrides[i].Segment == nil {
+			continue
+		}
+		if destinationOverride, ok := bySegment[*destinationOverrides[i].Segment]; ok {
+			diffs = append(diffs, SegmentOverrideDiff{Segment: *destinationOverride.Segment, Destination: destinationOverride})
+		}
+	}
+	return diffs, nil
+}
+
+// diffFeatureStates returns the fields that differ between the feature states
+func diffFeatureStates(a, b *FeatureState) []string {
+	fields := []string{}
+	if a.Enabled != b.Enabled {
+		fields = append(fields, "enabled")
+	}
+	if !a.FeatureStateValue.Equal(b.FeatureStateValue) {
+		fields = append(fields, "value")
+	}
+	if !equalMVSplits(a.MultivariateFeatureStateValues, b.MultivariateFeatureStateValues) {
+		fields = append(fields, "multivariate_splits")
+	}
+	return fields
+}
+
+// Equal reports whether both feature state values hold the same value
+func (v *FeatureStateValue) Equal(other *FeatureStateValue) bool {
+	return v.value() == other.value()
+}
+
+func (v *FeatureStateValue) value() interface{} {
+	switch {
+	case v == nil:
+		return nil
+	case v.IntegerValue != nil:
+		return *v.IntegerValue
+	case v.BooleanValue != nil:
+		return *v.BooleanValue
+	case v.StringValue != nil:
+		return *v.StringValue
+	}
+	return nil
+}
+
+func equalMVSplits(a, b []MultivariateFeatureStateValue) bool {
+	allocations := map[int64]float64{}
+	for _, value := range a {
+		allocations[value.MultivariateFeatureOption] = value.PercentageAllocation
+	}
+	for _, value := range b {
+		allocation, ok := allocations[value.MultivariateFeatureOption]
+		if !ok || allocation != value.PercentageAllocation {
+			return false
+		}
+		delete(allocations, value.MultivariateFeatureOption)
+	}
+	return len(allocations) == 0
+}
+
+// promotedState returns a copy of the destination feature state with the enabled state, value and multivariate
+// splits of the source feature state
+func promotedState(source, destination *FeatureState) FeatureState {
+	promoted := *destination
+	promoted.Enabled = source.Enabled
+	promoted.FeatureStateValue = source.FeatureStateValue
+	promoted.MultivariateFeatureStateValues = []MultivariateFeatureStateValue{}
+	for _, value := range source.MultivariateFeatureStateValues {
+		value.ID = nil
+		for _, existing := range destination.MultivariateFeatureStateValues {
+			if existing.MultivariateFeatureOption == value.MultivariateFeatureOption {
+				value.ID = existing.ID
+			}
+		}
+		promoted.MultivariateFeatureStateValues = append(promoted.MultivariateFeatureStateValues, value)
+	}
+	return promoted
+}
+
+func (c *Client) applyPromotion(promotion *Promotion) error {
+	for _, diff := range promotion.Features {
+		if len(diff.Fields) > 0 {
+			featureState := promotedState(diff.Source, diff.Destination)
+			if err := c.UpdateFeatureState(&featureState, false); err != nil {
+				return err
+			}
+		}
+		// delete overrides first and create them last so that priorities do not clash
+		for _, override := range diff.SegmentOverrides {
+			if override.Source == nil {
+				if err := c.DeleteFeatureSegment(*override.Destination.FeatureSegment); err != nil {
+					return err
+				}
+			}
+		}
+		for _, override := range diff.SegmentOverrides {
+			if override.Source != nil && override.Destination != nil {
+				featureState := promotedState(override.Source, override.Destination)
+				featureState.SegmentPriority = override.Source.SegmentPriority
+				// a missing priority cannot be set, so the destination override keeps its priority
+				updatePriority := containsField(override.Fields, "priority") && override.Source.SegmentPriority != nil
+				if err := c.UpdateFeatureState(&featureState, updatePriority); err != nil {
+					return err
+				}
+			}
+		}
+		for _, override := range diff.SegmentOverrides {
+			if override.Destination == nil {
+				featureState := promotedState(override.Source, &FeatureState{
+					Feature:         *diff.Feature.ID,
+					EnvironmentKey:  promotion.Destination.APIKey,
+					Segment:         override.Source.Segment,
+					SegmentPriority: override.Source.SegmentPriority,
+				})
+				if err := c.CreateSegmentOverride(&featureState); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) submitPromotion(promotion *Promotion, opts PromoteOptions) error {
+	changeRequest := ChangeRequest{
+		Title:          opts.ChangeRequestTitle,
+		FeatureStates:  []FeatureState{},
+		EnvironmentKey: promotion.Destination.APIKey,
+	}
+	if changeRequest.Title == "" {
+		changeRequest.Title = fmt.Sprintf("Promote %s to %s", promotion.Source.Name, promotion.Destination.Name)
+	}
+	for _, diff := range promotion.Features {
+		if len(diff.SegmentOverrides) > 0 {
+			return fmt.Errorf("flagsmithapi: environment '%s' requires change requests, which can not include the "+
+				"segment overrides of feature '%s'", promotion.Destination.Name, diff.Feature.Name)
+		}
+		if len(diff.Fields) > 0 {
+			featureState := promotedState(diff.Source, diff.Destination)
+			featureState.ID = 0
+			featureState.UUID = ""
+			changeRequest.FeatureStates = append(changeRequest.FeatureStates, featureState)
+		}
+	}
+	if err := c.CreateChangeRequest(&changeRequest); err != nil {
+		return err
+	}
+	promotion.ChangeRequest = &changeRequest
+	return nil
+}
+
+func containsField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,332 @@
+package flagsmithapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+func TestPromoteEnvironment(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	staging := flagsmithapi.Environment{Name: "Staging", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&staging))
+	production := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&production))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID, InitialValue: "a"}
+	require.NoError(t, client.CreateFeature(&feature))
+	optionValue := "b"
+	option := flagsmithapi.FeatureMultivariateOption{
+		Type: "unicode", StringValue: &optionValue, FeatureID: feature.ID, ProjectID: &project.ID,
+	}
+	require.NoError(t, client.CreateFeatureMVOption(&option))
+	segmentIDs := []int64{}
+	for _, name := range []string{"beta", "internal_users", "pro_users"} {
+		segment := flagsmithapi.Segment{
+			Name:      name,
+			ProjectID: &project.ID,
+			Rules:     []flagsmithapi.Rule{flagsmithapi.All(flagsmithapi.Any(flagsmithapi.Eq("group", name)))},
+		}
+		require.NoError(t, client.CreateSegment(&segment))
+		segmentIDs = append(segmentIDs, *segment.ID)
+	}
+	value := "c"
+	featureState, err := client.GetEnvironmentFeatureState(staging.APIKey, *feature.ID)
+	require.NoError(t, err)
+	featureState.Enabled = true
+	featureState.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value}
+	featureState.MultivariateFeatureStateValues[0].PercentageAllocation = 50
+	require.NoError(t, client.UpdateFeatureState(featureState, false))
+	// beta is only overridden in staging, internal_users is overridden in both and pro_users only in production
+	for _, override := range []struct {
+		environmentKey string
+		segmentID      int64
+		priority       int64
+		enabled        bool
+	}{
+		{staging.APIKey, segmentIDs[0], 0, true},
+		{staging.APIKey, segmentIDs[1], 1, true},
+		{production.APIKey, segmentIDs[1], 0, false},
+		{production.APIKey, segmentIDs[2], 1, true},
+	} {
+		segmentOverride := flagsmithapi.FeatureState{
+			Feature:         *feature.ID,
+			Enabled:         override.enabled,
+			EnvironmentKey:  override.environmentKey,
+			Segment:         &override.segmentID,
+			SegmentPriority: &override.priority,
+		}
+		require.NoError(t, client.CreateSegmentOverride(&segmentOverride))
+	}
+
+	// When
+	promotion, err := client.PromoteEnvironment(staging.APIKey, production.APIKey, flagsmithapi.PromoteOptions{})
+
+	// Then
+	require.NoError(t, err)
+	assert.Nil(t, promotion.ChangeRequest)
+	require.Len(t, promotion.Features, 1)
+	diff := promotion.Features[0]
+	assert.Equal(t, "checkout_v2", diff.Feature.Name)
+	assert.Equal(t, []string{"enabled", "value", "multivariate_splits"}, diff.Fields)
+	require.Len(t, diff.SegmentOverrides, 3)
+	assert.Nil(t, diff.SegmentOverrides[0].Destination)
+	assert.Equal(t, []string{"enabled", "priority"}, diff.SegmentOverrides[1].Fields)
+	assert.Nil(t, diff.SegmentOverrides[2].Source)
+
+	featureState, err = client.GetEnvironmentFeatureState(production.APIKey, *feature.ID)
+	require.NoError(t, err)
+	assert.True(t, featureState.Enabled)
+	assert.Equal(t, "c", *featureState.FeatureStateValue.StringValue)
+	require.Len(t, featureState.MultivariateFeatureStateValues, 1)
+	assert.Equal(t, float64(50), featureState.MultivariateFeatureStateValues[0].PercentageAllocation)
+
+	overrides, err := client.ListSegmentOverrides(production.APIKey, *feature.ID)
+	require.NoError(t, err)
+	require.Len(t, overrides, 2)
+	assert.Equal(t, segmentIDs[0], *overrides[0].Segment)
+	assert.Equal(t, segmentIDs[1], *overrides[1].Segment)
+	assert.Equal(t, int64(1), *overrides[1].SegmentPriority)
+	assert.True(t, overrides[1].Enabled)
+
+	// When
+	promotion, err = client.PromoteEnvironment(staging.APIKey, production.APIKey, flagsmithapi.PromoteOptions{})
+
+	// Then
+	require.NoError(t, err)
+	assert.Empty(t, promotion.Features)
+}
+
+func TestPromoteEnvironmentRemovesMultivariateSplits(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	staging := flagsmithapi.Environment{Name: "Staging", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&staging))
+	production := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&production))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID, InitialValue: "a"}
+	require.NoError(t, client.CreateFeature(&feature))
+	optionValue := "b"
+	option := flagsmithapi.FeatureMultivariateOption{
+		Type: "unicode", StringValue: &optionValue, FeatureID: feature.ID, ProjectID: &project.ID,
+	}
+	require.NoError(t, client.CreateFeatureMVOption(&option))
+	value := "a"
+	for _, environmentKey := range []string{staging.APIKey, production.APIKey} {
+		featureState, err := client.GetEnvironmentFeatureState(environmentKey, *feature.ID)
+		require.NoError(t, err)
+		featureState.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value}
+		featureState.MultivariateFeatureStateValues[0].PercentageAllocation = 30
+		require.NoError(t, client.UpdateFeatureState(featureState, false))
+	}
+	featureState, err := client.GetEnvironmentFeatureState(staging.APIKey, *feature.ID)
+	require.NoError(t, err)
+	featureState.MultivariateFeatureStateValues = []flagsmithapi.MultivariateFeatureStateValue{}
+	require.NoError(t, client.UpdateFeatureState(featureState, false))
+
+	// When
+	promotion, err := client.PromoteEnvironment(staging.APIKey, production.APIKey, flagsmithapi.PromoteOptions{})
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, promotion.Features, 1)
+	assert.Equal(t, []string{"multivariate_splits"}, promotion.Features[0].Fields)
+	featureState, err = client.GetEnvironmentFeatureState(production.APIKey, *feature.ID)
+	require.NoError(t, err)
+	assert.Empty(t, featureState.MultivariateFeatureStateValues)
+
+	// When
+	promotion, err = client.PromoteEnvironment(staging.APIKey, production.APIKey, flagsmithapi.PromoteOptions{})
+
+	// Then
+	require.NoError(t, err)
+	assert.Empty(t, promotion.Features)
+}
+
+func TestPromoteEnvironmentWithoutSegmentPriorities(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	staging := flagsmithapi.Environment{Name: "Staging", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&staging))
+	production := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&production))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID, InitialValue: "a"}
+	require.NoError(t, client.CreateFeature(&feature))
+	segmentIDs := []int64{}
+	for _, name := range []string{"beta", "internal_users"} {
+		segment := flagsmithapi.Segment{
+			Name:      name,
+			ProjectID: &project.ID,
+			Rules:     []flagsmithapi.Rule{flagsmithapi.All(flagsmithapi.Any(flagsmithapi.Eq("group", name)))},
+		}
+		require.NoError(t, client.CreateSegment(&segment))
+		segmentIDs = append(segmentIDs, *segment.ID)
+	}
+	for _, override := range []struct {
+		environmentKey string
+		segmentID      int64
+		priority       int64
+		enabled        bool
+	}{
+		{staging.APIKey, segmentIDs[0], 0, true},
+		{staging.APIKey, segmentIDs[1], 1, true},
+		{production.APIKey, segmentIDs[0], 0, false},
+	} {
+		segmentOverride := flagsmithapi.FeatureState{
+			Feature:         *feature.ID,
+			Enabled:         override.enabled,
+			EnvironmentKey:  override.environmentKey,
+			Segment:         &override.segmentID,
+			SegmentPriority: &override.priority,
+		}
+		require.NoError(t, client.CreateSegmentOverride(&segmentOverride))
+	}
+	for _, environmentKey := range []string{staging.APIKey, production.APIKey} {
+		overrides, err := client.ListSegmentOverrides(environmentKey, *feature.ID)
+		require.NoError(t, err)
+		for _, override := range overrides {
+			server.SetFeatureSegmentPriority(*override.FeatureSegment, nil)
+		}
+	}
+
+	// When
+	promotion, err := client.PromoteEnvironment(staging.APIKey, production.APIKey, flagsmithapi.PromoteOptions{})
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, promotion.Features, 1)
+	require.Len(t, promotion.Features[0].SegmentOverrides, 2)
+	assert.Equal(t, []string{"enabled"}, promotion.Features[0].SegmentOverrides[0].Fields)
+	assert.Nil(t, promotion.Features[0].SegmentOverrides[1].Destination)
+	overrides, err := client.ListSegmentOverrides(production.APIKey, *feature.ID)
+	require.NoError(t, err)
+	require.Len(t, overrides, 2)
+	assert.True(t, overrides[0].Enabled)
+	assert.True(t, overrides[1].Enabled)
+}
+
+func TestPromoteEnvironmentDryRunAndFeatureSelection(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	staging := flagsmithapi.Environment{Name: "Staging", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&staging))
+	production := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&production))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID, InitialValue: "a"}
+	require.NoError(t, client.CreateFeature(&feature))
+	banner := flagsmithapi.Feature{Name: "banner", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&banner))
+	value := "c"
+	featureState, err := client.GetEnvironmentFeatureState(staging.APIKey, *feature.ID)
+	require.NoError(t, err)
+	featureState.Enabled = true
+	featureState.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value}
+	require.NoError(t, client.UpdateFeatureState(featureState, false))
+	empty := ""
+	featureState, err = client.GetEnvironmentFeatureState(staging.APIKey, *banner.ID)
+	require.NoError(t, err)
+	featureState.Enabled = true
+	featureState.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &empty}
+	require.NoError(t, client.UpdateFeatureState(featureState, false))
+	server.ResetRequests()
+
+	// When
+	promotion, err := client.PromoteEnvironment(staging.APIKey, production.APIKey, flagsmithapi.PromoteOptions{
+		Features: []string{"banner"},
+		DryRun:   true,
+	})
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, promotion.Features, 1)
+	assert.Equal(t, "banner", promotion.Features[0].Feature.Name)
+	assert.Equal(t, []string{"enabled", "value"}, promotion.Features[0].Fields)
+	for _, request := range server.Requests() {
+		assert.Equal(t, "GET", request.Method, request.Path)
+	}
+
+	// When
+	_, err = client.PromoteEnvironment(staging.APIKey, production.APIKey, flagsmithapi.PromoteOptions{
+		Features: []string{"unknown"},
+	})
+
+	// Then
+	assert.EqualError(t, err, "flagsmithapi: feature 'unknown' not found")
+}
+
+func TestPromoteEnvironmentRequiringApprovals(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+	staging := flagsmithapi.Environment{Name: "Staging", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&staging))
+	production := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&production))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID, InitialValue: "a"}
+	require.NoError(t, client.CreateFeature(&feature))
+	production.MinimumChangeRequestApprovals = 1
+	require.NoError(t, client.UpdateEnvironment(&production))
+	segment := flagsmithapi.Segment{Name: "beta", ProjectID: &project.ID, Rules: []flagsmithapi.Rule{flagsmithapi.All()}}
+	require.NoError(t, client.CreateSegment(&segment))
+	value := "c"
+	featureState, err := client.GetEnvironmentFeatureState(staging.APIKey, *feature.ID)
+	require.NoError(t, err)
+	featureState.Enabled = true
+	featureState.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value}
+	require.NoError(t, client.UpdateFeatureState(featureState, false))
+	priority := int64(0)
+	override := flagsmithapi.FeatureState{
+		Feature: *feature.ID, Enabled: true, EnvironmentKey: staging.APIKey, Segment: segment.ID, SegmentPriority: &priority,
+	}
+	require.NoError(t, client.CreateSegmentOverride(&override))
+
+	// When
+	_, err = client.PromoteEnvironment(staging.APIKey, production.APIKey, flagsmithapi.PromoteOptions{})
+
+	// Then
+	assert.EqualError(t, err, "flagsmithapi: environment 'Production' requires change requests, which can not "+
+		"include the segment overrides of feature 'checkout_v2'")
+	assert.Empty(t, server.ChangeRequests(production.APIKey))
+
+	// When
+	promotion, err := client.PromoteEnvironment(staging.APIKey, production.APIKey, flagsmithapi.PromoteOptions{
+		SkipSegmentOverrides: true,
+	})
+
+	// Then
+	require.NoError(t, err)
+	require.NotNil(t, promotion.ChangeRequest)
+	changeRequests := server.ChangeRequests(production.APIKey)
+	require.Len(t, changeRequests, 1)
+	assert.Equal(t, "Promote Staging to Production", changeRequests[0].Title)
+	require.Len(t, changeRequests[0].FeatureStates, 1)
+	assert.True(t, changeRequests[0].FeatureStates[0].Enabled)
+	assert.Equal(t, "c", *changeRequests[0].FeatureStates[0].FeatureStateValue.StringValue)
+
+	featureState, err = client.GetEnvironmentFeatureState(production.APIKey, *feature.ID)
+	require.NoError(t, err)
+	assert.False(t, featureState.Enabled)
+}
@@ -0,0 +1,124 @@
+package flagsmithapi
+
+import (
+	"context"
+	"math"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// EndpointWeight sets the number of tokens taken by the requests to an endpoint
+type EndpointWeight struct {
+	// Method matches any method when empty
+	Method string
+	// Path is a path.Match pattern for the path relative to the base URL, without the leading slash, i.e:
+	// "environments/*/document/"
+	Path   string
+	Weight int
+}
+
+// RateLimiter is a token bucket limiting the rate of the requests of the clients it is set on(see WithRateLimiter).
+// Requests take one token unless an EndpointWeight matches them.
+type RateLimiter struct {
+	rate    float64
+	burst   float64
+	weights []EndpointWeight
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a limiter allowing requestsPerSecond on average and bursts of up to burst requests; the
+// first matching weight applies to each request. A requestsPerSecond <= 0 does not limit requests.
+func NewRateLimiter(requestsPerSecond float64, burst int, weights ...EndpointWeight) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{rate: requestsPerSecond, burst: float64(burst), weights: weights, tokens: float64(burst)}
+}
+
+var (
+	sharedRateLimitersMu sync.Mutex
+	sharedRateLimiters   = map[string]*RateLimiter{}
+)
+
+// SharedRateLimiter returns the limiter registered under key, creating it with the given settings the first time, so
+// that the clients of a process using the same API key share a single bucket:
+//
+//	limiter := flagsmithapi.SharedRateLimiter(masterAPIKey, 10, 20)
+//	client := flagsmithapi.NewClient(masterAPIKey, baseURL, flagsmithapi.WithRateLimiter(limiter))
+func SharedRateLimiter(key string, requestsPerSecond float64, burst int, weights ...EndpointWeight) *RateLimiter {
+	sharedRateLimitersMu.Lock()
+	defer sharedRateLimitersMu.Unlock()
+	limiter, ok := sharedRateLimiters[key]
+	if !ok {
+		limiter = NewRateLimiter(requestsPerSecond, burst, weights...)
+		sharedRateLimiters[key] = limiter
+	}
+	return limiter
+}
+
+// Wait blocks until n tokens are available and takes them. It returns the error of the context if it is done first,
+// in which case no token is taken.
+func (l *RateLimiter) Wait(ctx context.Context, n int) error {
+	if l.rate <= 0 {
+		return nil
+	}
+	l.mu.Lock()
+	now := time.Now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	// take the tokens ahead of time so that waiting requests are served in order
+	l.tokens -= float64(n)
+	delay := time.Duration(0)
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens += float64(n)
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// weight returns the number of tokens taken by a request to the path, relative to the base URL
+func (l *RateLimiter) weight(method, relativePath string) int {
+	for _, weight := range l.weights {
+		if weight.Method != "" && !strings.EqualFold(weight.Method, method) {
+			continue
+		}
+		if matched, _ := path.Match(weight.Path, relativePath); matched {
+			return weight.Weight
+		}
+	}
+	return 1
+}
+
+// waitForRateLimiter is a resty request middleware that blocks until the limiter allows the request
+func (c *Client) waitForRateLimiter(limiter *RateLimiter) resty.RequestMiddleware {
+	return func(_ *resty.Client, req *resty.Request) error {
+		relativePath := strings.TrimPrefix(strings.TrimPrefix(req.URL, c.baseURL), "/")
+		if i := strings.IndexByte(relativePath, '?'); i >= 0 {
+			relativePath = relativePath[:i]
+		}
+		return limiter.Wait(req.Context(), limiter.weight(req.Method, relativePath))
+	}
+}
@@ -0,0 +1,91 @@
+package flagsmithapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+func TestRateLimiterLimitsClientRequests(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	limiter := flagsmithapi.NewRateLimiter(20, 2, flagsmithapi.EndpointWeight{Method: "GET", Path: "projects/*/", Weight: 2})
+	client := server.Client(flagsmithapi.WithRateLimiter(limiter))
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+
+	// When
+	start := time.Now()
+	// takes the burst
+	require.NoError(t, client.CreateProject(&project))
+	require.NoError(t, client.UpdateProject(&project))
+	// waits for a token, 50ms
+	_, err := client.GetProject(project.UUID)
+	require.NoError(t, err)
+	// waits for two tokens, 100ms
+	_, err = client.GetProjectByID(project.ID)
+	require.NoError(t, err)
+	elapsed := time.Since(start)
+
+	// Then
+	assert.GreaterOrEqual(t, elapsed, 140*time.Millisecond)
+	assert.Less(t, elapsed, time.Second)
+}
+
+func TestRateLimiterWaitRespectsContext(t *testing.T) {
+	// Given
+	limiter := flagsmithapi.NewRateLimiter(1, 1)
+	require.NoError(t, limiter.Wait(context.Background(), 1))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// When
+	err := limiter.Wait(ctx, 1)
+
+	// Then
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	// the tokens of the cancelled wait are given back: the next token is due a second after the first
+	ctx, cancel = context.WithTimeout(context.Background(), 1500*time.Millisecond)
+	defer cancel()
+	assert.NoError(t, limiter.Wait(ctx, 1))
+}
+
+func TestRateLimiterCancelsClientRequestsWithTheirContext(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	t.Cleanup(server.Close)
+	limiter := flagsmithapi.NewRateLimiter(1, 1)
+	client := server.Client(flagsmithapi.WithRateLimiter(limiter))
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	// takes the burst
+	require.NoError(t, client.CreateProject(&project))
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	// When
+	start := time.Now()
+	_, err := client.WithContext(ctx).GetProject(project.UUID)
+
+	// Then
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	// the request was never sent
+	assert.Len(t, server.Requests(), 1)
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	// When
+	first := flagsmithapi.SharedRateLimiter("key-1", 10, 1)
+	second := flagsmithapi.SharedRateLimiter("key-1", 100, 100)
+	other := flagsmithapi.SharedRateLimiter("key-2", 10, 1)
+
+	// Then
+	assert.Same(t, first, second)
+	assert.NotSame(t, first, other)
+}
@@ -0,0 +1,161 @@
+package flagsmithapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// DecodeJSON decodes the string value of the feature state, which is expected to hold a JSON document, into v.
+func (fs *FeatureState) DecodeJSON(v interface{}) error {
+	if fs.FeatureStateValue == nil || fs.FeatureStateValue.StringValue == nil {
+		return fmt.Errorf("flagsmithapi: feature state %d does not have a string value", fs.ID)
+	}
+	return json.Unmarshal([]byte(*fs.FeatureStateValue.StringValue), v)
+}
+
+// DecodeJSON decodes the initial value of the feature, which is expected to hold a JSON document, into v.
+func (f *Feature) DecodeJSON(v interface{}) error {
+	if f.InitialValue == "" {
+		return fmt.Errorf("flagsmithapi: feature '%s' does not have an initial value", f.Name)
+	}
+	return json.Unmarshal([]byte(f.InitialValue), v)
+}
+
+// DecodeFeatureStateJSON decodes the JSON string value of a feature state into a T.
+func DecodeFeatureStateJSON[T any](featureState *FeatureState) (T, error) {
+	var value T
+	err := featureState.DecodeJSON(&value)
+	return value, err
+}
+
+// DecodeFeatureJSON decodes the JSON initial value of a feature into a T.
+func DecodeFeatureJSON[T any](feature *Feature) (T, error) {
+	var value T
+	err := feature.DecodeJSON(&value)
+	return value, err
+}
+
+// ValueValidator validates string feature values before they are sent to the API. featureID is nil when the
+// feature has not been created yet and featureName is empty when only the ID is known(i.e: a feature state without
+// an EnvironmentKey).
+type ValueValidator interface {
+	ValidateValue(featureID *int64, featureName string, value string) error
+}
+
+// JSONSchemaValidator is a ValueValidator that validates values against JSON schemas registered per feature.
+// Values of features without a schema are not validated.
+type JSONSchemaValidator struct {
+	byID   map[int64]*jsonschema.Schema
+	byName map[string]*jsonschema.Schema
+}
+
+func NewJSONSchemaValidator() *JSONSchemaValidator {
+	return &JSONSchemaValidator{
+		byID:   map[int64]*jsonschema.Schema{},
+		byName: map[string]*jsonschema.Schema{},
+	}
+}
+
+// AddFeatureIDSchema registers schema for the feature with the given ID.
+func (v *JSONSchemaValidator) AddFeatureIDSchema(featureID int64, schema string) error {
+	compiled, err := jsonschema.CompileString("feature-"+strconv.FormatInt(featureID, 10)+".json", schema)
+	if err != nil {
+		return fmt.Errorf("flagsmithapi: Error compiling schema for feature %d: %w", featureID, err)
+	}
+	v.byID[featureID] = compiled
+	return nil
+}
+
+// AddFeatureNameSchema registers schema for the feature with the given name.
+func (v *JSONSchemaValidator) AddFeatureNameSchema(featureName string, schema string) error {
+	compiled, err := jsonschema.CompileString("feature-"+featureName+".json", schema)
+	if err != nil {
+		return fmt.Errorf("flagsmithapi: Error compiling schema for feature '%s': %w", featureName, err)
+	}
+	v.byName[featureName] = compiled
+	return nil
+}
+
+func (v *JSONSchemaValidator) ValidateValue(featureID *int64, featureName string, value string) error {
+	var schema *jsonschema.Schema
+	if featureID != nil {
+		schema = v.byID[*featureID]
+	}
+	if schema == nil && featureName != "" {
+		schema = v.byName[featureName]
+	}
+	if schema == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(value)))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return fmt.Errorf("value is not valid JSON: %w", err)
+	}
+	if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return errors.New("value is not valid JSON: unexpected data after the JSON document")
+	}
+	return schema.Validate(document)
+}
+
+// validateFeatureStateValue validates the value of a feature state of the environment, looking up the name of its
+// feature so that schemas registered by name apply as well
+func (c *Client) validateFeatureStateValue(environmentKey string, featureState *FeatureState) error {
+	if c.valueValidator == nil || featureState.FeatureStateValue == nil || featureState.FeatureStateValue.StringValue == nil {
+		return nil
+	}
+	featureID := featureState.Feature
+	featureName, err := c.featureName(environmentKey, featureID)
+	if err != nil {
+		return err
+	}
+	err = c.valueValidator.ValidateValue(&featureID, featureName, *featureState.FeatureStateValue.StringValue)
+	if err != nil {
+		if featureName == "" {
+			featureName = strconv.FormatInt(featureID, 10)
+		}
+		return InvalidFeatureValueError{feature: featureName, err: err}
+	}
+	return nil
+}
+
+// featureName returns the name of the feature with the given ID in the project of the environment, or an empty name
+// when the environment is not known
+func (c *Client) featureName(environmentKey string, featureID int64) (string, error) {
+	if environmentKey == "" {
+		return "", nil
+	}
+	environment, err := c.GetEnvironment(environmentKey)
+	if err != nil {
+		return "", err
+	}
+	features, err := c.ListFeatures(environment.ProjectID)
+	if err != nil {
+		return "", err
+	}
+	for _, feature := range features {
+		if feature.ID != nil && *feature.ID == featureID {
+			return feature.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func (c *Client) validateFeatureInitialValue(feature *Feature) error {
+	if c.valueValidator == nil || feature.InitialValue == "" {
+		return nil
+	}
+	err := c.valueValidator.ValidateValue(feature.ID, feature.Name, feature.InitialValue)
+	if err != nil {
+		return InvalidFeatureValueError{feature: feature.Name, err: err}
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package flagsmithapi_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+type remoteConfig struct {
+	Colour   string `json:"colour"`
+	MaxItems int    `json:"max_items"`
+}
+
+const RemoteConfigSchema = `{
+  "type": "object",
+  "properties": {
+    "colour": {"type": "string"},
+    "max_items": {"type": "integer", "minimum": 1}
+  },
+  "required": ["colour"]
+}`
+
+func TestDecodeFeatureStateJSON(t *testing.T) {
+	// Given
+	value := `{"colour": "red", "max_items": 3}`
+	fs := flagsmithapi.FeatureState{
+		FeatureStateValue: &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value},
+	}
+
+	// When
+	config, err := flagsmithapi.DecodeFeatureStateJSON[remoteConfig](&fs)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, remoteConfig{Colour: "red", MaxItems: 3}, config)
+}
+
+func TestDecodeFeatureStateJSONReturnsErrorForNonStringValue(t *testing.T) {
+	// Given
+	value := int64(10)
+	fs := flagsmithapi.FeatureState{
+		FeatureStateValue: &flagsmithapi.FeatureStateValue{Type: "int", IntegerValue: &value},
+	}
+
+	// When
+	_, err := flagsmithapi.DecodeFeatureStateJSON[remoteConfig](&fs)
+
+	// Then
+	assert.Error(t, err)
+}
+
+func TestDecodeFeatureJSON(t *testing.T) {
+	// Given
+	feature := flagsmithapi.Feature{Name: FeatureName, InitialValue: `{"colour": "blue"}`}
+
+	// When
+	config, err := flagsmithapi.DecodeFeatureJSON[remoteConfig](&feature)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "blue", config.Colour)
+}
+
+func TestJSONSchemaValidator(t *testing.T) {
+	// Given
+	validator := flagsmithapi.NewJSONSchemaValidator()
+	assert.NoError(t, validator.AddFeatureNameSchema(FeatureName, RemoteConfigSchema))
+	featureID := FeatureID
+	otherFeatureID := FeatureID + 1
+
+	// Then
+	assert.NoError(t, validator.ValidateValue(nil, FeatureName, `{"colour": "red", "max_items": 1}`))
+	assert.Error(t, validator.ValidateValue(nil, FeatureName, `{"max_items": 1}`))
+	assert.Error(t, validator.ValidateValue(nil, FeatureName, `{"colour": "red", "max_items": 0}`))
+	assert.Error(t, validator.ValidateValue(nil, FeatureName, `not json`))
+	assert.Error(t, validator.ValidateValue(nil, FeatureName, `{"colour": "red"} garbage`))
+	assert.Error(t, validator.ValidateValue(nil, FeatureName, `{"colour": "red"} {"colour": "blue"}`))
+
+	// features without a schema are not validated
+	assert.NoError(t, validator.ValidateValue(&featureID, "", `not json`))
+
+	// schemas registered by ID take precedence
+	assert.NoError(t, validator.AddFeatureIDSchema(otherFeatureID, `{"type": "array"}`))
+	assert.NoError(t, validator.ValidateValue(&otherFeatureID, FeatureName, `[]`))
+}
+
+func TestJSONSchemaValidatorRejectsInvalidSchema(t *testing.T) {
+	// Given
+	validator := flagsmithapi.NewJSONSchemaValidator()
+
+	// When
+	err := validator.AddFeatureNameSchema(FeatureName, `{"type": 10}`)
+
+	// Then
+	assert.Error(t, err)
+}
+
+func TestUpdateFeatureStateRejectsInvalidValue(t *testing.T) {
+	// Given
+	requestReceived := struct {
+		mu                sync.Mutex
+		isRequestReceived bool
+	}{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requestReceived.mu.Lock()
+		requestReceived.isRequestReceived = true
+		requestReceived.mu.Unlock()
+	}))
+	defer server.Close()
+
+	validator := flagsmithapi.NewJSONSchemaValidator()
+	assert.NoError(t, validator.AddFeatureIDSchema(FeatureID, RemoteConfigSchema))
+	client := flagsmithapi.NewClient(MasterAPIKey, server.URL+"/api/v1", flagsmithapi.WithValueValidator(validator))
+
+	value := `{"max_items": 3}`
+	fs := flagsmithapi.FeatureState{
+		ID:                FeatureStateID,
+		Feature:           FeatureID,
+		FeatureStateValue: &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value},
+	}
+
+	// When
+	err := client.UpdateFeatureState(&fs, false)
+
+	// Then
+	var validationErr flagsmithapi.InvalidFeatureValueError
+	assert.True(t, errors.As(err, &validationErr))
+	requestReceived.mu.Lock()
+	assert.False(t, requestReceived.isRequestReceived)
+}
+
+func TestUpdateFeatureStateValidatesValueAgainstSchemaRegisteredByName(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	setupClient := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, setupClient.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, setupClient.CreateEnvironment(&environment))
+	feature := flagsmithapi.Feature{Name: "checkout_config", ProjectID: &project.ID}
+	require.NoError(t, setupClient.CreateFeature(&feature))
+
+	validator := flagsmithapi.NewJSONSchemaValidator()
+	require.NoError(t, validator.AddFeatureNameSchema("checkout_config", RemoteConfigSchema))
+	client := server.Client(flagsmithapi.WithValueValidator(validator))
+	featureState, err := client.GetEnvironmentFeatureState(environment.APIKey, *feature.ID)
+	require.NoError(t, err)
+	value := `{"max_items": 3}`
+	featureState.FeatureStateValue = &flagsmithapi.FeatureStateValue{Type: "unicode", StringValue: &value}
+
+	// When
+	err = client.UpdateFeatureState(featureState, false)
+
+	// Then
+	var validationErr flagsmithapi.InvalidFeatureValueError
+	require.True(t, errors.As(err, &validationErr))
+	assert.ErrorContains(t, err, "checkout_config")
+	assert.Equal(t, 0, server.RequestCount(http.MethodPut, fmt.Sprintf("/features/featurestates/%d/", featureState.ID)))
+}
+
+func TestCreateFeatureValidatesInitialValue(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		_, err := io.WriteString(rw, CreateFeatureResponseJson)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	validator := flagsmithapi.NewJSONSchemaValidator()
+	assert.NoError(t, validator.AddFeatureNameSchema(FeatureName, RemoteConfigSchema))
+	client := flagsmithapi.NewClient(MasterAPIKey, server.URL+"/api/v1", flagsmithapi.WithValueValidator(validator))
+
+	projectID := ProjectID
+	invalidFeature := flagsmithapi.Feature{Name: FeatureName, ProjectID: &projectID, InitialValue: `{"colour": 1}`}
+	validFeature := flagsmithapi.Feature{Name: FeatureName, ProjectID: &projectID, InitialValue: `{"colour": "red"}`}
+
+	// When
+	invalidErr := client.CreateFeature(&invalidFeature)
+	validErr := client.CreateFeature(&validFeature)
+
+	// Then
+	assert.Error(t, invalidErr)
+	assert.Nil(t, invalidFeature.ID)
+	assert.NoError(t, validErr)
+	assert.Equal(t, FeatureID, *validFeature.ID)
+}
@@ -0,0 +1,250 @@
+package flagsmithapi
+
+import (
+	"crypto/md5"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MatchesIdentity evaluates the segment rules locally against the identity traits using the same operator semantics
+// as the Flagsmith engine. identityKey is the value used for percentage split hashing(i.e: the identity's django ID
+// or composite key).
+func (s *Segment) MatchesIdentity(identityKey string, traits []Trait) (bool, error) {
+	traitValues := make(map[string]interface{}, len(traits))
+	for i := range traits {
+		traitValues[traits[i].TraitKey] = traits[i].value()
+	}
+	evaluation := segmentEvaluation{segment: s, identityKey: identityKey, traits: traitValues}
+
+	for _, rule := range s.Rules {
+		matches, err := evaluation.ruleMatches(rule)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// value returns the trait value as a string, int64, float64 or bool(depending on which value is set), or nil
+func (t *Trait) value() interface{} {
+	switch {
+	case t.StringValue != nil:
+		return *t.StringValue
+	case t.IntegerValue != nil:
+		return int64(*t.IntegerValue)
+	case t.FloatValue != nil:
+		return *t.FloatValue
+	case t.BooleanValue != nil:
+		return *t.BooleanValue
+	}
+	return nil
+}
+
+type segmentEvaluation struct {
+	segment     *Segment
+	identityKey string
+	traits      map[string]interface{}
+}
+
+func (e *segmentEvaluation) ruleMatches(rule Rule) (bool, error) {
+	if len(rule.Conditions) > 0 {
+		matchCount := 0
+		for _, condition := range rule.Conditions {
+			matches, err := e.conditionMatches(condition)
+			if err != nil {
+				return false, err
+			}
+			if matches {
+				matchCount++
+			}
+		}
+		var conditionsMatch bool
+		switch RuleType(rule.Type) {
+		case RuleTypeAll:
+			conditionsMatch = matchCount == len(rule.Conditions)
+		case RuleTypeAny:
+			conditionsMatch = matchCount > 0
+		case RuleTypeNone:
+			conditionsMatch = matchCount == 0
+		default:
+			return false, InvalidSegmentRuleError{path: "rules", reason: fmt.Sprintf("unknown rule type '%s'", rule.Type)}
+		}
+		if !conditionsMatch {
+			return false, nil
+		}
+	}
+	for _, nested := range rule.Rules {
+		matches, err := e.ruleMatches(nested)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (e *segmentEvaluation) conditionMatches(condition Condition) (bool, error) {
+	switch Operator(condition.Operator) {
+	case OperatorPercentageSplit:
+		percentage, err := strconv.ParseFloat(condition.Value, 64)
+		if err != nil {
+			return false, nil
+		}
+		if e.segment.ID == nil {
+			return false, fmt.Errorf("flagsmithapi: segment '%s' must have an ID to evaluate percentage splits", e.segment.Name)
+		}
+		objectIDs := []string{strconv.FormatInt(*e.segment.ID, 10), e.identityKey}
+		return hashedPercentageForObjectIDs(objectIDs) <= percentage, nil
+	case OperatorIsSet:
+		_, ok := e.traits[condition.Property]
+		return ok, nil
+	case OperatorIsNotSet:
+		_, ok := e.traits[condition.Property]
+		return !ok, nil
+	}
+
+	traitValue, ok := e.traits[condition.Property]
+	if !ok || traitValue == nil {
+		return false, nil
+	}
+	return traitValueMatches(condition, traitValue), nil
+}
+
+func traitValueMatches(condition Condition, traitValue interface{}) bool {
+	operator := Operator(condition.Operator)
+	switch operator {
+	case OperatorModulo:
+		divisor, remainder, err := parseModuloValue(condition.Value)
+		if err != nil {
+			return false
+		}
+		var number float64
+		switch v := traitValue.(type) {
+		case int64:
+			number = float64(v)
+		case float64:
+			number = v
+		default:
+			return false
+		}
+		// Python's modulo takes the sign of the divisor
+		return number-math.Floor(number/divisor)*divisor == remainder
+	case OperatorIn:
+		values := strings.Split(condition.Value, ",")
+		switch v := traitValue.(type) {
+		case string:
+			return containsString(values, v)
+		case int64:
+			return containsString(values, strconv.FormatInt(v, 10))
+		}
+		return false
+	}
+
+	switch v := traitValue.(type) {
+	case string:
+		if isSemver(condition.Value) {
+			traitVersion, err := parseSemver(v)
+			if err != nil {
+				return false
+			}
+			conditionVersion, err := parseSemver(strings.TrimSuffix(condition.Value, SemverSuffix))
+			if err != nil {
+				return false
+			}
+			return compareMatches(operator, traitVersion.compare(conditionVersion))
+		}
+		switch operator {
+		case OperatorContains:
+			return strings.Contains(v, condition.Value)
+		case OperatorNotContains:
+			return !strings.Contains(v, condition.Value)
+		case OperatorRegex:
+			re, err := regexp.Compile(condition.Value)
+			if err != nil {
+				return false
+			}
+			// Python's re.match only matches at the start of the string
+			loc := re.FindStringIndex(v)
+			return loc != nil && loc[0] == 0
+		}
+		return compareMatches(operator, strings.Compare(v, condition.Value))
+	case bool:
+		conditionValue := condition.Value == "true" || condition.Value == "True" || condition.Value == "1"
+		switch operator {
+		case OperatorEqual:
+			return v == conditionValue
+		case OperatorNotEqual:
+			return v != conditionValue
+		}
+		return false
+	case int64:
+		conditionValue, err := strconv.ParseInt(condition.Value, 10, 64)
+		if err != nil {
+			return false
+		}
+		return compareMatches(operator, compareOrdered(v, conditionValue))
+	case float64:
+		conditionValue, err := strconv.ParseFloat(condition.Value, 64)
+		if err != nil {
+			return false
+		}
+		return compareMatches(operator, compareOrdered(v, conditionValue))
+	}
+	return false
+}
+
+// compareMatches reports whether the result of comparing a trait value with a condition value satisfies operator
+func compareMatches(operator Operator, comparison int) bool {
+	switch operator {
+	case OperatorEqual:
+		return comparison == 0
+	case OperatorNotEqual:
+		return comparison != 0
+	case OperatorGreaterThan:
+		return comparison > 0
+	case OperatorGreaterThanInclusive:
+		return comparison >= 0
+	case OperatorLessThan:
+		return comparison < 0
+	case OperatorLessThanInclusive:
+		return comparison <= 0
+	}
+	return false
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// hashedPercentageForObjectIDs returns a number in [0, 100) that is consistent for the given object IDs, as used by
+// the Flagsmith engine for percentage splits and multivariate allocation.
+func hashedPercentageForObjectIDs(objectIDs []string) float64 {
+	for iterations := 1; ; iterations++ {
+		toHash := strings.Repeat(strings.Join(objectIDs, ","), iterations)
+		sum := md5.Sum([]byte(toHash))
+		hashed := new(big.Int).SetBytes(sum[:])
+		remainder := new(big.Int).Mod(hashed, big.NewInt(9999)).Int64()
+		value := float64(remainder) / 9998 * 100
+		if value != 100 {
+			return value
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package flagsmithapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+func stringTrait(key, value string) flagsmithapi.Trait {
+	return flagsmithapi.Trait{TraitKey: key, ValueType: "unicode", StringValue: &value}
+}
+
+func intTrait(key string, value int) flagsmithapi.Trait {
+	return flagsmithapi.Trait{TraitKey: key, ValueType: "int", IntegerValue: &value}
+}
+
+func floatTrait(key string, value float64) flagsmithapi.Trait {
+	return flagsmithapi.Trait{TraitKey: key, ValueType: "float", FloatValue: &value}
+}
+
+func boolTrait(key string, value bool) flagsmithapi.Trait {
+	return flagsmithapi.Trait{TraitKey: key, ValueType: "bool", BooleanValue: &value}
+}
+
+func TestSegmentMatchesIdentityOperators(t *testing.T) {
+	traits := []flagsmithapi.Trait{
+		stringTrait("plan", "pro"),
+		stringTrait("email", "someone@example.com"),
+		stringTrait("version", "1.10.0"),
+		intTrait("age", 30),
+		floatTrait("score", 7.5),
+		boolTrait("beta", true),
+	}
+	testCases := []struct {
+		name      string
+		condition flagsmithapi.Condition
+		expected  bool
+	}{
+		{"equal string", flagsmithapi.Eq("plan", "pro"), true},
+		{"not equal string", flagsmithapi.NotEq("plan", "pro"), false},
+		{"missing trait", flagsmithapi.Eq("country", "gb"), false},
+		{"greater than int", flagsmithapi.Gt("age", "18"), true},
+		{"less than inclusive int", flagsmithapi.Lte("age", "30"), true},
+		{"int with non numeric value", flagsmithapi.Eq("age", "thirty"), false},
+		{"greater than float", flagsmithapi.Gte("score", "7.5"), true},
+		{"less than float", flagsmithapi.Lt("score", "7"), false},
+		{"equal bool", flagsmithapi.Eq("beta", "true"), true},
+		{"not equal bool", flagsmithapi.NotEq("beta", "true"), false},
+		{"contains", flagsmithapi.Contains("email", "@example.com"), true},
+		{"not contains", flagsmithapi.NotContains("email", "@example.com"), false},
+		{"regex matches from start", flagsmithapi.Regex("email", `[a-z]+@`), true},
+		{"regex does not search", flagsmithapi.Regex("email", `example`), false},
+		{"in string", flagsmithapi.In("plan", "free", "pro"), true},
+		{"in int", flagsmithapi.In("age", "29", "30"), true},
+		{"not in", flagsmithapi.In("plan", "free"), false},
+		{"is set", flagsmithapi.IsSet("plan"), true},
+		{"is not set", flagsmithapi.IsNotSet("country"), true},
+		{"modulo", flagsmithapi.Modulo("age", 4, 2), true},
+		{"modulo no match", flagsmithapi.Modulo("age", 4, 1), false},
+		{"modulo on string", flagsmithapi.Modulo("plan", 4, 1), false},
+		{"semver greater than", flagsmithapi.SemverGt("version", "1.9.0"), true},
+		{"semver less than", flagsmithapi.SemverLt("version", "1.9.0"), false},
+		{"semver equal", flagsmithapi.SemverEq("version", "1.10.0"), true},
+		{"semver release after pre-release", flagsmithapi.SemverGt("version", "1.10.0-rc.1"), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			segmentID := SegmentID
+			segment := flagsmithapi.Segment{
+				ID:    &segmentID,
+				Name:  "segment",
+				Rules: []flagsmithapi.Rule{flagsmithapi.All(flagsmithapi.Any(tc.condition))},
+			}
+
+			// When
+			matches, err := segment.MatchesIdentity("identity_key", traits)
+
+			// Then
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, matches)
+		})
+	}
+}
+
+func TestSegmentMatchesIdentityRuleTypes(t *testing.T) {
+	traits := []flagsmithapi.Trait{stringTrait("plan", "pro"), intTrait("age", 30)}
+	testCases := []struct {
+		name     string
+		rule     flagsmithapi.Rule
+		expected bool
+	}{
+		{"all", flagsmithapi.All(flagsmithapi.Eq("plan", "pro"), flagsmithapi.Eq("age", "30")), true},
+		{"all with one failing", flagsmithapi.All(flagsmithapi.Eq("plan", "pro"), flagsmithapi.Eq("age", "31")), false},
+		{"any", flagsmithapi.Any(flagsmithapi.Eq("plan", "free"), flagsmithapi.Eq("age", "30")), true},
+		{"none", flagsmithapi.None(flagsmithapi.Eq("plan", "free"), flagsmithapi.Eq("age", "31")), true},
+		{"none with one matching", flagsmithapi.None(flagsmithapi.Eq("plan", "pro")), false},
+		{"nested rule failing", flagsmithapi.All(flagsmithapi.Any(flagsmithapi.Eq("plan", "free"))), false},
+		{"empty rule", flagsmithapi.All(), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			segment := flagsmithapi.Segment{Name: "segment", Rules: []flagsmithapi.Rule{tc.rule}}
+			matches, err := segment.MatchesIdentity("identity_key", traits)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, matches)
+		})
+	}
+}
+
+func TestSegmentMatchesIdentityPercentageSplit(t *testing.T) {
+	// Given
+	segmentID := SegmentID
+	// hashed percentages for segment 300: user_1 => 45.79, user_3 => 14.17
+	segment := flagsmithapi.Segment{
+		ID:    &segmentID,
+		Name:  "segment",
+		Rules: []flagsmithapi.Rule{flagsmithapi.All(flagsmithapi.PercentageSplit(20))},
+	}
+
+	// When
+	userOneMatches, userOneErr := segment.MatchesIdentity("user_1", nil)
+	userThreeMatches, userThreeErr := segment.MatchesIdentity("user_3", nil)
+
+	// Then
+	assert.NoError(t, userOneErr)
+	assert.NoError(t, userThreeErr)
+	assert.False(t, userOneMatches)
+	assert.True(t, userThreeMatches)
+}
+
+func TestSegmentMatchesIdentityPercentageSplitRequiresSegmentID(t *testing.T) {
+	// Given
+	segment := flagsmithapi.Segment{
+		Name:  "segment",
+		Rules: []flagsmithapi.Rule{flagsmithapi.All(flagsmithapi.PercentageSplit(20))},
+	}
+
+	// When
+	_, err := segment.MatchesIdentity("user_1", nil)
+
+	// Then
+	assert.Error(t, err)
+}
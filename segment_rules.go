@@ -0,0 +1,315 @@
+package flagsmithapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type RuleType string
+
+const (
+	RuleTypeAll  RuleType = "ALL"
+	RuleTypeAny  RuleType = "ANY"
+	RuleTypeNone RuleType = "NONE"
+)
+
+type Operator string
+
+const (
+	OperatorEqual                Operator = "EQUAL"
+	OperatorNotEqual             Operator = "NOT_EQUAL"
+	OperatorGreaterThan          Operator = "GREATER_THAN"
+	OperatorGreaterThanInclusive Operator = "GREATER_THAN_INCLUSIVE"
+	OperatorLessThan             Operator = "LESS_THAN"
+	OperatorLessThanInclusive    Operator = "LESS_THAN_INCLUSIVE"
+	OperatorContains             Operator = "CONTAINS"
+	OperatorNotContains          Operator = "NOT_CONTAINS"
+	OperatorRegex                Operator = "REGEX"
+	OperatorPercentageSplit      Operator = "PERCENTAGE_SPLIT"
+	OperatorModulo               Operator = "MODULO"
+	OperatorIsSet                Operator = "IS_SET"
+	OperatorIsNotSet             Operator = "IS_NOT_SET"
+	OperatorIn                   Operator = "IN"
+)
+
+// SemverSuffix marks the value of a comparison condition as a semantic version, i.e: "1.2.3:semver"
+const SemverSuffix = ":semver"
+
+// RuleElement is either a Rule or a Condition and can be passed to the All, Any and None builders.
+type RuleElement interface {
+	addTo(rule *Rule)
+}
+
+func (r Rule) addTo(rule *Rule) {
+	rule.Rules = append(rule.Rules, r)
+}
+
+func (c Condition) addTo(rule *Rule) {
+	rule.Conditions = append(rule.Conditions, c)
+}
+
+func newRule(ruleType RuleType, elements []RuleElement) Rule {
+	rule := Rule{Type: string(ruleType)}
+	for _, element := range elements {
+		element.addTo(&rule)
+	}
+	return rule
+}
+
+// All builds a rule that matches when all of its conditions and nested rules match
+func All(elements ...RuleElement) Rule {
+	return newRule(RuleTypeAll, elements)
+}
+
+// Any builds a rule that matches when any of its conditions match
+func Any(elements ...RuleElement) Rule {
+	return newRule(RuleTypeAny, elements)
+}
+
+// None builds a rule that matches when none of its conditions match
+func None(elements ...RuleElement) Rule {
+	return newRule(RuleTypeNone, elements)
+}
+
+func NewCondition(property string, operator Operator, value string) Condition {
+	return Condition{Property: property, Operator: string(operator), Value: value}
+}
+
+func Eq(property, value string) Condition {
+	return NewCondition(property, OperatorEqual, value)
+}
+
+func NotEq(property, value string) Condition {
+	return NewCondition(property, OperatorNotEqual, value)
+}
+
+func Gt(property, value string) Condition {
+	return NewCondition(property, OperatorGreaterThan, value)
+}
+
+func Gte(property, value string) Condition {
+	return NewCondition(property, OperatorGreaterThanInclusive, value)
+}
+
+func Lt(property, value string) Condition {
+	return NewCondition(property, OperatorLessThan, value)
+}
+
+func Lte(property, value string) Condition {
+	return NewCondition(property, OperatorLessThanInclusive, value)
+}
+
+func Contains(property, value string) Condition {
+	return NewCondition(property, OperatorContains, value)
+}
+
+func NotContains(property, value string) Condition {
+	return NewCondition(property, OperatorNotContains, value)
+}
+
+func Regex(property, pattern string) Condition {
+	return NewCondition(property, OperatorRegex, pattern)
+}
+
+func In(property string, values ...string) Condition {
+	return NewCondition(property, OperatorIn, strings.Join(values, ","))
+}
+
+func IsSet(property string) Condition {
+	return NewCondition(property, OperatorIsSet, "")
+}
+
+func IsNotSet(property string) Condition {
+	return NewCondition(property, OperatorIsNotSet, "")
+}
+
+// Modulo matches identities where the numeric trait property % divisor == remainder
+func Modulo(property string, divisor, remainder float64) Condition {
+	value := strconv.FormatFloat(divisor, 'f', -1, 64) + "|" + strconv.FormatFloat(remainder, 'f', -1, 64)
+	return NewCondition(property, OperatorModulo, value)
+}
+
+// PercentageSplit matches the given percentage of identities
+func PercentageSplit(percentage float64) Condition {
+	return NewCondition("", OperatorPercentageSplit, strconv.FormatFloat(percentage, 'f', -1, 64))
+}
+
+func SemverEq(property, version string) Condition {
+	return NewCondition(property, OperatorEqual, version+SemverSuffix)
+}
+
+func SemverNotEq(property, version string) Condition {
+	return NewCondition(property, OperatorNotEqual, version+SemverSuffix)
+}
+
+func SemverGt(property, version string) Condition {
+	return NewCondition(property, OperatorGreaterThan, version+SemverSuffix)
+}
+
+func SemverGte(property, version string) Condition {
+	return NewCondition(property, OperatorGreaterThanInclusive, version+SemverSuffix)
+}
+
+func SemverLt(property, version string) Condition {
+	return NewCondition(property, OperatorLessThan, version+SemverSuffix)
+}
+
+func SemverLte(property, version string) Condition {
+	return NewCondition(property, OperatorLessThanInclusive, version+SemverSuffix)
+}
+
+// ValidateRules checks rule types, operators and operator/value combinations of the segment rules
+func (s *Segment) ValidateRules() error {
+	for i, rule := range s.Rules {
+		if err := validateRule(rule, fmt.Sprintf("rules[%d]", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateRule(rule Rule, path string) error {
+	switch RuleType(rule.Type) {
+	case RuleTypeAll, RuleTypeAny, RuleTypeNone:
+	default:
+		return InvalidSegmentRuleError{path: path, reason: fmt.Sprintf("unknown rule type '%s'", rule.Type)}
+	}
+	for i, condition := range rule.Conditions {
+		conditionPath := fmt.Sprintf("%s.conditions[%d]", path, i)
+		if reason := validateCondition(condition); reason != "" {
+			return InvalidSegmentRuleError{path: conditionPath, reason: reason}
+		}
+	}
+	for i, nested := range rule.Rules {
+		if err := validateRule(nested, fmt.Sprintf("%s.rules[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateCondition returns the reason the condition is invalid, or an empty string if it is valid
+func validateCondition(condition Condition) string {
+	operator := Operator(condition.Operator)
+	if operator != OperatorPercentageSplit && condition.Property == "" {
+		return fmt.Sprintf("operator %s requires a property", operator)
+	}
+
+	switch operator {
+	case OperatorEqual, OperatorNotEqual:
+		if isSemver(condition.Value) {
+			return validateSemver(condition.Value)
+		}
+	case OperatorGreaterThan, OperatorGreaterThanInclusive, OperatorLessThan, OperatorLessThanInclusive:
+		// The value is cast to the type of the trait when evaluated, so only emptiness and semver can be checked here
+		if condition.Value == "" {
+			return fmt.Sprintf("operator %s requires a value", operator)
+		}
+		if isSemver(condition.Value) {
+			return validateSemver(condition.Value)
+		}
+	case OperatorContains, OperatorNotContains, OperatorRegex:
+		// Regular expressions are evaluated by the server using Python syntax, so they are not compiled here
+		if condition.Value == "" {
+			return fmt.Sprintf("operator %s requires a value", operator)
+		}
+	case OperatorIn:
+		if condition.Value == "" {
+			return "operator IN requires a comma separated list of values"
+		}
+	case OperatorIsSet, OperatorIsNotSet:
+		if condition.Value != "" {
+			return fmt.Sprintf("operator %s does not take a value", operator)
+		}
+	case OperatorPercentageSplit:
+		percentage, err := strconv.ParseFloat(condition.Value, 64)
+		if err != nil || percentage < 0 || percentage > 100 {
+			return fmt.Sprintf("operator PERCENTAGE_SPLIT requires a value between 0 and 100, got '%s'", condition.Value)
+		}
+	case OperatorModulo:
+		if _, _, err := parseModuloValue(condition.Value); err != nil {
+			return err.Error()
+		}
+	default:
+		return fmt.Sprintf("unknown operator '%s'", condition.Operator)
+	}
+	return ""
+}
+
+func isSemver(value string) bool {
+	return strings.HasSuffix(value, SemverSuffix)
+}
+
+func validateSemver(value string) string {
+	if _, err := parseSemver(strings.TrimSuffix(value, SemverSuffix)); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+func parseModuloValue(value string) (divisor float64, remainder float64, err error) {
+	parts := strings.Split(value, "|")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("operator MODULO requires a value of the form 'divisor|remainder', got '%s'", value)
+	}
+	divisor, err = strconv.ParseFloat(parts[0], 64)
+	if err != nil || divisor == 0 {
+		return 0, 0, fmt.Errorf("operator MODULO requires a non zero numeric divisor, got '%s'", parts[0])
+	}
+	remainder, err = strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("operator MODULO requires a numeric remainder, got '%s'", parts[1])
+	}
+	return divisor, remainder, nil
+}
+
+type semver struct {
+	major, minor, patch int64
+	prerelease          string
+}
+
+func parseSemver(value string) (semver, error) {
+	version := semver{}
+	core := value
+	if i := strings.IndexByte(core, '+'); i >= 0 {
+		core = core[:i]
+	}
+	if i := strings.IndexByte(core, '-'); i >= 0 {
+		version.prerelease = core[i+1:]
+		core = core[:i]
+	}
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return version, fmt.Errorf("invalid semantic version '%s'", value)
+	}
+	numbers := make([]int64, 3)
+	for i, part := range parts {
+		n, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || n < 0 {
+			return version, fmt.Errorf("invalid semantic version '%s'", value)
+		}
+		numbers[i] = n
+	}
+	version.major, version.minor, version.patch = numbers[0], numbers[1], numbers[2]
+	return version, nil
+}
+
+// compare returns -1, 0 or 1 if v is lower, equal or greater than other. Pre-release identifiers are compared as
+// a whole, which is enough for the common alpha/beta/rc cases.
+func (v semver) compare(other semver) int {
+	for _, pair := range [][2]int64{{v.major, other.major}, {v.minor, other.minor}, {v.patch, other.patch}} {
+		if pair[0] != pair[1] {
+			return compareOrdered(pair[0], pair[1])
+		}
+	}
+	switch {
+	case v.prerelease == other.prerelease:
+		return 0
+	case v.prerelease == "":
+		return 1
+	case other.prerelease == "":
+		return -1
+	}
+	return strings.Compare(v.prerelease, other.prerelease)
+}
@@ -0,0 +1,109 @@
+package flagsmithapi_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+func TestRuleBuilder(t *testing.T) {
+	// When
+	rule := flagsmithapi.All(
+		flagsmithapi.Any(flagsmithapi.Eq("plan", "pro"), flagsmithapi.In("country", "gb", "fr")),
+		flagsmithapi.PercentageSplit(20),
+		flagsmithapi.None(flagsmithapi.SemverLt("version", "1.2.0")),
+	)
+
+	// Then
+	expected := flagsmithapi.Rule{
+		Type: "ALL",
+		Rules: []flagsmithapi.Rule{
+			{
+				Type: "ANY",
+				Conditions: []flagsmithapi.Condition{
+					{Operator: "EQUAL", Property: "plan", Value: "pro"},
+					{Operator: "IN", Property: "country", Value: "gb,fr"},
+				},
+			},
+			{
+				Type: "NONE",
+				Conditions: []flagsmithapi.Condition{
+					{Operator: "LESS_THAN", Property: "version", Value: "1.2.0:semver"},
+				},
+			},
+		},
+		Conditions: []flagsmithapi.Condition{
+			{Operator: "PERCENTAGE_SPLIT", Value: "20"},
+		},
+	}
+	assert.Equal(t, expected, rule)
+}
+
+func TestValidateRules(t *testing.T) {
+	testCases := []struct {
+		name  string
+		rule  flagsmithapi.Rule
+		valid bool
+	}{
+		{"valid rules", flagsmithapi.All(flagsmithapi.Any(flagsmithapi.Eq("plan", "pro"), flagsmithapi.IsSet("email"))), true},
+		{"valid modulo", flagsmithapi.All(flagsmithapi.Any(flagsmithapi.Modulo("user_id", 2, 0))), true},
+		{"valid semver", flagsmithapi.All(flagsmithapi.Any(flagsmithapi.SemverGte("version", "1.0.0-beta"))), true},
+		{"unknown rule type", flagsmithapi.Rule{Type: "SOME"}, false},
+		{"unknown operator", flagsmithapi.All(flagsmithapi.NewCondition("plan", "EQUALS", "pro")), false},
+		{"missing property", flagsmithapi.All(flagsmithapi.Eq("", "pro")), false},
+		{"percentage out of range", flagsmithapi.All(flagsmithapi.PercentageSplit(120)), false},
+		{"invalid modulo", flagsmithapi.All(flagsmithapi.NewCondition("user_id", flagsmithapi.OperatorModulo, "2")), false},
+		{"zero modulo divisor", flagsmithapi.All(flagsmithapi.Modulo("user_id", 0, 1)), false},
+		{"invalid semver", flagsmithapi.All(flagsmithapi.SemverGt("version", "1.0")), false},
+		{"is set with value", flagsmithapi.All(flagsmithapi.NewCondition("email", flagsmithapi.OperatorIsSet, "x")), false},
+		{"empty in", flagsmithapi.All(flagsmithapi.In("country")), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			segment := flagsmithapi.Segment{Name: "segment", Rules: []flagsmithapi.Rule{tc.rule}}
+			err := segment.ValidateRules()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				var ruleErr flagsmithapi.InvalidSegmentRuleError
+				assert.True(t, errors.As(err, &ruleErr))
+			}
+		})
+	}
+}
+
+func TestCreateSegmentRejectsInvalidRules(t *testing.T) {
+	// Given
+	requestReceived := struct {
+		mu                sync.Mutex
+		isRequestReceived bool
+	}{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requestReceived.mu.Lock()
+		requestReceived.isRequestReceived = true
+		requestReceived.mu.Unlock()
+	}))
+	defer server.Close()
+
+	client := flagsmithapi.NewClient(MasterAPIKey, server.URL+"/api/v1")
+	projectID := ProjectID
+	segment := flagsmithapi.Segment{
+		Name:      "segment",
+		ProjectID: &projectID,
+		Rules:     []flagsmithapi.Rule{flagsmithapi.All(flagsmithapi.Any(flagsmithapi.NewCondition("plan", "EQAUL", "pro")))},
+	}
+
+	// When
+	err := client.CreateSegment(&segment)
+
+	// Then
+	assert.EqualError(t, err, "flagsmithapi: invalid segment rule at rules[0].rules[0].conditions[0]: unknown operator 'EQAUL'")
+	requestReceived.mu.Lock()
+	assert.False(t, requestReceived.isRequestReceived)
+}
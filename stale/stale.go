@@ -0,0 +1,201 @@
+// Package stale finds the features of a project that are candidates for removal, using the project's
+// StaleFlagsLimitDays setting, and can tag or archive them.
+//
+//	report, err := stale.Find(client, projectUUID, stale.Options{})
+//	report.WriteText(os.Stdout)
+//	err = report.Tag(client, "stale")
+//
+// A feature is stale when it has not been modified within the limit, it is enabled(or disabled) in every environment
+// and it has no segment overrides. Features whose last modification time is unknown are never reported.
+package stale
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+)
+
+// DefaultTagColour is the colour of the tag created by Report.Tag
+const DefaultTagColour = "#9E9E9E"
+
+type Options struct {
+	// LimitDays overrides the project's StaleFlagsLimitDays
+	LimitDays int64
+	// Now returns the time the report is generated at; defaults to time.Now
+	Now func() time.Time
+}
+
+type Report struct {
+	Project     *flagsmithapi.Project
+	LimitDays   int64
+	GeneratedAt time.Time
+	// Features are ordered by last modification time, oldest first
+	Features []Feature
+}
+
+type Feature struct {
+	Feature flagsmithapi.Feature
+	// Enabled is the state of the feature in every environment
+	Enabled bool
+	// LastModified is the latest of the creation time of the feature and the update times of its feature states
+	LastModified time.Time
+}
+
+// Find lists the stale features of the project; archived features are not included
+func Find(api flagsmithapi.API, projectUUID string, opts Options) (*Report, error) {
+	project, err := api.GetProject(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now
+	if opts.Now != nil {
+		now = opts.Now
+	}
+	report := &Report{Project: project, LimitDays: project.StaleFlagsLimitDays, GeneratedAt: now(), Features: []Feature{}}
+	if opts.LimitDays != 0 {
+		report.LimitDays = opts.LimitDays
+	}
+	if report.LimitDays <= 0 {
+		return nil, fmt.Errorf("stale: project '%s' does not set stale_flags_limit_days; set Options.LimitDays", project.Name)
+	}
+	cutoff := report.GeneratedAt.AddDate(0, 0, -int(report.LimitDays))
+
+	features, err := api.ListFeatures(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	environments, err := api.ListEnvironments(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	featureStates := map[int64][]flagsmithapi.FeatureState{}
+	for _, environment := range environments {
+		states, err := api.ListEnvironmentFeatureStates(environment.APIKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, state := range states {
+			featureStates[state.Feature] = append(featureStates[state.Feature], state)
+		}
+	}
+
+	for _, feature := range features {
+		if feature.IsArchived || feature.CreatedDate == nil {
+			continue
+		}
+		candidate := Feature{Feature: feature, LastModified: *feature.CreatedDate}
+		states := featureStates[*feature.ID]
+		stale := true
+		for i, state := range states {
+			if state.UpdatedAt == nil || (i > 0 && state.Enabled != candidate.Enabled) {
+				stale = false
+				break
+			}
+			candidate.Enabled = state.Enabled
+			if state.UpdatedAt.After(candidate.LastModified) {
+				candidate.LastModified = *state.UpdatedAt
+			}
+		}
+		if !stale || candidate.LastModified.After(cutoff) {
+			continue
+		}
+		// segment overrides are checked last as they take a few requests per environment
+		overridden, err := hasSegmentOverrides(api, environments, *feature.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !overridden {
+			report.Features = append(report.Features, candidate)
+		}
+	}
+	sort.SliceStable(report.Features, func(i, j int) bool {
+		return report.Features[i].LastModified.Before(report.Features[j].LastModified)
+	})
+	return report, nil
+}
+
+func hasSegmentOverrides(api flagsmithapi.API, environments []flagsmithapi.Environment, featureID int64) (bool, error) {
+	for _, environment := range environments {
+		overrides, err := api.ListSegmentOverrides(environment.APIKey, featureID)
+		if err != nil {
+			return false, err
+		}
+		if len(overrides) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Tag adds the tag with the given name to the stale features, creating the tag if the project does not have it
+func (r *Report) Tag(api flagsmithapi.API, tagName string) error {
+	tags, err := api.ListTags(r.Project.ID)
+	if err != nil {
+		return err
+	}
+	var tag *flagsmithapi.Tag
+	for i := range tags {
+		if tags[i].Name == tagName {
+			tag = &tags[i]
+		}
+	}
+	if tag == nil {
+		tag = &flagsmithapi.Tag{Name: tagName, Colour: DefaultTagColour, ProjectID: &r.Project.ID}
+		if err := api.CreateTag(tag); err != nil {
+			return err
+		}
+	}
+
+	for i := range r.Features {
+		feature := &r.Features[i].Feature
+		if containsID(feature.Tags, *tag.ID) {
+			continue
+		}
+		feature.Tags = append(feature.Tags, *tag.ID)
+		if err := api.UpdateFeature(feature); err != nil {
+			return fmt.Errorf("stale: Error tagging feature '%s': %w", feature.Name, err)
+		}
+	}
+	return nil
+}
+
+// Archive archives the stale features
+func (r *Report) Archive(api flagsmithapi.API) error {
+	for i := range r.Features {
+		feature := &r.Features[i].Feature
+		feature.IsArchived = true
+		if err := api.UpdateFeature(feature); err != nil {
+			return fmt.Errorf("stale: Error archiving feature '%s': %w", feature.Name, err)
+		}
+	}
+	return nil
+}
+
+// WriteText writes the report as a table
+func (r *Report) WriteText(w io.Writer) error {
+	if len(r.Features) == 0 {
+		_, err := fmt.Fprintf(w, "No stale features in project '%s' (limit: %d days).\n", r.Project.Name, r.LimitDays)
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "FEATURE\tENABLED\tLAST MODIFIED\tDAYS")
+	for _, feature := range r.Features {
+		days := int(r.GeneratedAt.Sub(feature.LastModified).Hours() / 24)
+		fmt.Fprintf(tw, "%s\t%t\t%s\t%d\n", feature.Feature.Name, feature.Enabled,
+			feature.LastModified.Format(time.RFC3339), days)
+	}
+	return tw.Flush()
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
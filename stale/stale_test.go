@@ -0,0 +1,166 @@
+package stale_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+	"github.com/Flagsmith/flagsmith-go-api-client/stale"
+)
+
+var now = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func names(report *stale.Report) []string {
+	result := []string{}
+	for _, feature := range report.Features {
+		result = append(result, feature.Feature.Name)
+	}
+	return result
+}
+
+func TestFind(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	server.Clock = func() time.Time { return now.AddDate(0, 0, -60) }
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID, StaleFlagsLimitDays: 30}
+	require.NoError(t, client.CreateProject(&project))
+	development := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&development))
+	production := flagsmithapi.Environment{Name: "Production", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&production))
+
+	features := map[string]*flagsmithapi.Feature{}
+	for _, name := range []string{"old_disabled", "old_enabled", "partially_enabled", "overridden", "archived"} {
+		features[name] = &flagsmithapi.Feature{Name: name, ProjectID: &project.ID}
+		require.NoError(t, client.CreateFeature(features[name]))
+	}
+	for _, enable := range []struct {
+		environmentKey string
+		feature        string
+	}{
+		{development.APIKey, "old_enabled"},
+		{production.APIKey, "old_enabled"},
+		{production.APIKey, "partially_enabled"},
+	} {
+		featureState, err := client.GetEnvironmentFeatureState(enable.environmentKey, *features[enable.feature].ID)
+		require.NoError(t, err)
+		featureState.Enabled = true
+		require.NoError(t, client.UpdateFeatureState(featureState, false))
+	}
+	segment := flagsmithapi.Segment{Name: "beta", ProjectID: &project.ID, Rules: []flagsmithapi.Rule{flagsmithapi.All()}}
+	require.NoError(t, client.CreateSegment(&segment))
+	priority := int64(0)
+	override := flagsmithapi.FeatureState{
+		Feature:         *features["overridden"].ID,
+		EnvironmentKey:  development.APIKey,
+		Segment:         segment.ID,
+		SegmentPriority: &priority,
+	}
+	require.NoError(t, client.CreateSegmentOverride(&override))
+	features["archived"].IsArchived = true
+	require.NoError(t, client.UpdateFeature(features["archived"]))
+
+	server.Clock = func() time.Time { return now.AddDate(0, 0, -45) }
+	olderThanLimit := flagsmithapi.Feature{Name: "older_than_limit", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&olderThanLimit))
+	server.Clock = func() time.Time { return now.AddDate(0, 0, -10) }
+	recent := flagsmithapi.Feature{Name: "recent", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&recent))
+	recentlyChanged := flagsmithapi.Feature{Name: "recently_changed", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&recentlyChanged))
+	server.Clock = func() time.Time { return now.AddDate(0, 0, -1) }
+	featureState, err := client.GetEnvironmentFeatureState(development.APIKey, *recentlyChanged.ID)
+	require.NoError(t, err)
+	featureState.Enabled = true
+	require.NoError(t, client.UpdateFeatureState(featureState, false))
+
+	// When
+	report, err := stale.Find(client, project.UUID, stale.Options{Now: func() time.Time { return now }})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), report.LimitDays)
+	assert.Equal(t, []string{"old_disabled", "old_enabled", "older_than_limit"}, names(report))
+	assert.False(t, report.Features[0].Enabled)
+	assert.True(t, report.Features[1].Enabled)
+	assert.Equal(t, now.AddDate(0, 0, -45), report.Features[2].LastModified)
+
+	// When
+	report, err = stale.Find(client, project.UUID, stale.Options{LimitDays: 50, Now: func() time.Time { return now }})
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old_disabled", "old_enabled"}, names(report))
+	text := bytes.Buffer{}
+	require.NoError(t, report.WriteText(&text))
+	assert.Equal(t, "FEATURE       ENABLED  LAST MODIFIED         DAYS\n"+
+		"old_disabled  false    2024-04-02T12:00:00Z  60\n"+
+		"old_enabled   true     2024-04-02T12:00:00Z  60\n", text.String())
+}
+
+func TestFindRequiresLimit(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, client.CreateProject(&project))
+
+	// When
+	_, err := stale.Find(client, project.UUID, stale.Options{})
+
+	// Then
+	assert.EqualError(t, err, "stale: project 'project-1' does not set stale_flags_limit_days; set Options.LimitDays")
+}
+
+func TestTagAndArchive(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	server.Clock = func() time.Time { return now.AddDate(0, 0, -60) }
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID, StaleFlagsLimitDays: 30}
+	require.NoError(t, client.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, client.CreateEnvironment(&environment))
+	oldFeature := flagsmithapi.Feature{Name: "old_feature", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&oldFeature))
+	server.Clock = func() time.Time { return now }
+	newFeature := flagsmithapi.Feature{Name: "new_feature", ProjectID: &project.ID}
+	require.NoError(t, client.CreateFeature(&newFeature))
+	opts := stale.Options{Now: func() time.Time { return now }}
+	report, err := stale.Find(client, project.UUID, opts)
+	require.NoError(t, err)
+	require.Len(t, report.Features, 1)
+
+	// When
+	require.NoError(t, report.Tag(client, "stale"))
+	require.NoError(t, report.Tag(client, "stale"))
+
+	// Then
+	tags, err := client.ListTags(project.ID)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "stale", tags[0].Name)
+	feature, err := client.GetFeature(oldFeature.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{*tags[0].ID}, feature.Tags)
+
+	// When
+	require.NoError(t, report.Archive(client))
+
+	// Then
+	feature, err = client.GetFeature(oldFeature.UUID)
+	require.NoError(t, err)
+	assert.True(t, feature.IsArchived)
+	report, err = stale.Find(client, project.UUID, opts)
+	require.NoError(t, err)
+	assert.Empty(t, names(report))
+}
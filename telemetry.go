@@ -0,0 +1,217 @@
+package flagsmithapi
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer and meter of the clients
+const instrumentationName = "github.com/Flagsmith/flagsmith-go-api-client"
+
+// Attributes of the spans and metrics
+const (
+	attributeResource   = attribute.Key("flagsmith.resource")
+	attributeOperation  = attribute.Key("flagsmith.operation")
+	attributeMethod     = attribute.Key("http.request.method")
+	attributeURL        = attribute.Key("url.full")
+	attributeStatusCode = attribute.Key("http.response.status_code")
+	attributeErrorType  = attribute.Key("error.type")
+)
+
+// telemetry creates the spans and records the metrics of a client configured WithTelemetry.
+//
+// Every public method of the client starts an operation span with startOperation, which returns a copy of the client
+// carrying the context of the span. The requests made through the copy are client spans nested in the operation span,
+// as are the operations of the methods called on the copy to resolve IDs or to load related resources.
+type telemetry struct {
+	tracer   trace.Tracer
+	requests metric.Int64Counter
+	errors   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+func newTelemetry(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) *telemetry {
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+	meter := meterProvider.Meter(instrumentationName)
+	t := &telemetry{tracer: tracerProvider.Tracer(instrumentationName)}
+	var err error
+	// the instruments returned with an error are no-ops, so the errors are only reported
+	t.requests, err = meter.Int64Counter("flagsmith.client.requests",
+		metric.WithDescription("Number of requests sent to the Flagsmith API"), metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	t.errors, err = meter.Int64Counter("flagsmith.client.request.errors",
+		metric.WithDescription("Number of requests that failed or got an error response"), metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	t.duration, err = meter.Float64Histogram("flagsmith.client.request.duration",
+		metric.WithDescription("Duration of the requests sent to the Flagsmith API"), metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	return t
+}
+
+type operationContextKey struct{}
+
+type requestContextKey struct{}
+
+// operation is the span of a call to a public method of the client
+type operation struct {
+	resource string
+	name     string
+	span     trace.Span
+}
+
+// startOperation starts the span of the public method of the client and returns a copy of the client whose requests
+// and operations are made in the context of the span. It returns the client itself and a nil operation when
+// telemetry is not enabled. Methods shadow their receiver with the copy:
+//
+//	c, op := c.startOperation("GetFeature", "feature", "get", uuidAttribute("feature", featureUUID))
+//	defer op.end()
+func (c *Client) startOperation(method, resource, name string, attrs ...attribute.KeyValue) (*Client, *operation) {
+	if c.telemetry == nil {
+		return c, nil
+	}
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	spanAttrs := []attribute.KeyValue{attributeResource.String(resource), attributeOperation.String(name)}
+	for _, attr := range attrs {
+		if attr.Valid() {
+			spanAttrs = append(spanAttrs, attr)
+		}
+	}
+	ctx, span := c.telemetry.tracer.Start(ctx, "flagsmithapi."+method, trace.WithAttributes(spanAttrs...))
+	op := &operation{resource: resource, name: name, span: span}
+	derived := *c
+	derived.ctx = context.WithValue(ctx, operationContextKey{}, op)
+	return &derived, op
+}
+
+func (op *operation) end() {
+	if op == nil {
+		return
+	}
+	op.span.End()
+}
+
+// idAttribute is the ID of a resource, i.e: flagsmith.project.id
+func idAttribute(resource string, id int64) attribute.KeyValue {
+	return attribute.Int64("flagsmith."+resource+".id", id)
+}
+
+// optionalIDAttribute is the ID of a resource, or an invalid attribute that is left out of the span when the ID is nil
+func optionalIDAttribute(resource string, id *int64) attribute.KeyValue {
+	if id == nil {
+		return attribute.KeyValue{}
+	}
+	return idAttribute(resource, *id)
+}
+
+// uuidAttribute is the UUID of a resource, left out of the span when empty
+func uuidAttribute(resource, uuid string) attribute.KeyValue {
+	if uuid == "" {
+		return attribute.KeyValue{}
+	}
+	return attribute.String("flagsmith."+resource+".uuid", uuid)
+}
+
+// environmentKeyAttribute is the client-side key of an environment, left out of the span when empty
+func environmentKeyAttribute(key string) attribute.KeyValue {
+	if key == "" {
+		return attribute.KeyValue{}
+	}
+	return attribute.String("flagsmith.environment.key", key)
+}
+
+// requestSpan is the span of a request
+type requestSpan struct {
+	span      trace.Span
+	start     time.Time
+	operation *operation
+}
+
+// beforeRequest starts the span of the request as a child of the span of its operation
+func (t *telemetry) beforeRequest(_ *resty.Client, req *resty.Request) error {
+	ctx := req.Context()
+	op, _ := ctx.Value(operationContextKey{}).(*operation)
+	ctx, span := t.tracer.Start(ctx, req.Method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attributeMethod.String(req.Method),
+		attributeURL.String(req.URL),
+	))
+	req.SetContext(context.WithValue(ctx, requestContextKey{}, &requestSpan{span: span, start: time.Now(), operation: op}))
+	return nil
+}
+
+func (t *telemetry) onSuccess(_ *resty.Client, resp *resty.Response) {
+	t.endRequest(resp.Request, resp, nil)
+}
+
+func (t *telemetry) onError(req *resty.Request, err error) {
+	var resp *resty.Response
+	if responseErr, ok := err.(*resty.ResponseError); ok {
+		resp = responseErr.Response
+		err = responseErr.Err
+	}
+	t.endRequest(req, resp, err)
+}
+
+// endRequest ends the span of the request and records its metrics. Error responses and failed requests set the status
+// of the request span and of the span of its operation to Error.
+func (t *telemetry) endRequest(req *resty.Request, resp *resty.Response, err error) {
+	ctx := req.Context()
+	r, ok := ctx.Value(requestContextKey{}).(*requestSpan)
+	if !ok {
+		// a hook that runs before beforeRequest failed, i.e: the context ended while waiting for the rate limiter
+		return
+	}
+	attrs := []attribute.KeyValue{attributeMethod.String(req.Method)}
+	if r.operation != nil {
+		attrs = append(attrs, attributeResource.String(r.operation.resource), attributeOperation.String(r.operation.name))
+	}
+	statusCode := 0
+	if resp != nil && resp.RawResponse != nil {
+		statusCode = resp.StatusCode()
+		attrs = append(attrs, attributeStatusCode.Int(statusCode))
+		r.span.SetAttributes(attributeStatusCode.Int(statusCode))
+		if r.operation != nil {
+			r.operation.span.SetAttributes(attributeStatusCode.Int(statusCode))
+		}
+	}
+	t.requests.Add(ctx, 1, metric.WithAttributes(attrs...))
+	t.duration.Record(ctx, time.Since(r.start).Seconds(), metric.WithAttributes(attrs...))
+
+	if err != nil || statusCode >= 400 {
+		errorType := strconv.Itoa(statusCode)
+		description := "HTTP " + errorType
+		if err != nil {
+			errorType = "_OTHER"
+			description = err.Error()
+			r.span.RecordError(err)
+		}
+		r.span.SetAttributes(attributeErrorType.String(errorType))
+		r.span.SetStatus(codes.Error, description)
+		if r.operation != nil {
+			r.operation.span.SetStatus(codes.Error, description)
+		}
+		t.errors.Add(ctx, 1, metric.WithAttributes(append(attrs, attributeErrorType.String(errorType))...))
+	}
+	r.span.End()
+}
@@ -0,0 +1,136 @@
+package flagsmithapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	flagsmithapi "github.com/Flagsmith/flagsmith-go-api-client"
+	"github.com/Flagsmith/flagsmith-go-api-client/flagsmithtest"
+)
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	result := map[attribute.Key]attribute.Value{}
+	for _, attr := range span.Attributes() {
+		result[attr.Key] = attr.Value
+	}
+	return result
+}
+
+func sum(t *testing.T, reader *sdkmetric.ManualReader, name string) int64 {
+	data := metricdata.ResourceMetrics{}
+	require.NoError(t, reader.Collect(context.Background(), &data))
+	total := int64(0)
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != name {
+				continue
+			}
+			for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				total += point.Value
+			}
+		}
+	}
+	return total
+}
+
+func TestTelemetryNestsFollowUpCalls(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	setupClient := server.Client()
+	project := flagsmithapi.Project{Name: "project-1", Organisation: server.Organisation().ID}
+	require.NoError(t, setupClient.CreateProject(&project))
+	environment := flagsmithapi.Environment{Name: "Development", ProjectID: project.ID}
+	require.NoError(t, setupClient.CreateEnvironment(&environment))
+	feature := flagsmithapi.Feature{Name: "checkout_v2", ProjectID: &project.ID}
+	require.NoError(t, setupClient.CreateFeature(&feature))
+	segment := flagsmithapi.Segment{Name: "beta", ProjectID: &project.ID, Rules: []flagsmithapi.Rule{flagsmithapi.All()}}
+	require.NoError(t, setupClient.CreateSegment(&segment))
+	priority := int64(0)
+	override := flagsmithapi.FeatureState{
+		Feature: *feature.ID, EnvironmentKey: environment.APIKey, Segment: segment.ID, SegmentPriority: &priority,
+	}
+	require.NoError(t, setupClient.CreateSegmentOverride(&override))
+
+	spans := tracetest.NewSpanRecorder()
+	metrics := sdkmetric.NewManualReader()
+	client := server.Client(flagsmithapi.WithTelemetry(
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(metrics)),
+	))
+
+	// When
+	featureState, err := client.GetFeatureState(override.UUID)
+
+	// Then
+	require.NoError(t, err)
+	assert.NotNil(t, featureState.Segment)
+	ended := spans.Ended()
+	require.Len(t, ended, 4)
+	byName := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range ended {
+		if span.Name() != "GET" {
+			byName[span.Name()] = span
+		}
+	}
+	operation := byName["flagsmithapi.GetFeatureState"]
+	followUp := byName["flagsmithapi.GetFeatureSegmentByID"]
+	require.NotNil(t, operation)
+	require.NotNil(t, followUp)
+	assert.False(t, operation.Parent().IsValid())
+	assert.Equal(t, operation.SpanContext().SpanID(), followUp.Parent().SpanID())
+	assert.Equal(t, "feature_state", attributes(operation)["flagsmith.resource"].AsString())
+	assert.Equal(t, "get", attributes(operation)["flagsmith.operation"].AsString())
+	assert.Equal(t, override.UUID, attributes(operation)["flagsmith.feature_state.uuid"].AsString())
+	assert.Equal(t, int64(200), attributes(operation)["http.response.status_code"].AsInt64())
+	assert.Equal(t, *override.FeatureSegment, attributes(followUp)["flagsmith.feature_segment.id"].AsInt64())
+
+	requestParents := []string{}
+	for _, span := range ended {
+		if span.Name() == "GET" {
+			for name, parent := range byName {
+				if parent.SpanContext().SpanID() == span.Parent().SpanID() {
+					requestParents = append(requestParents, name)
+				}
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{"flagsmithapi.GetFeatureState", "flagsmithapi.GetFeatureSegmentByID"}, requestParents)
+	assert.Equal(t, int64(2), sum(t, metrics, "flagsmith.client.requests"))
+	assert.Equal(t, int64(0), sum(t, metrics, "flagsmith.client.request.errors"))
+}
+
+func TestTelemetryRecordsErrors(t *testing.T) {
+	// Given
+	server := flagsmithtest.NewServer()
+	defer server.Close()
+	spans := tracetest.NewSpanRecorder()
+	metrics := sdkmetric.NewManualReader()
+	client := server.Client(flagsmithapi.WithTelemetry(
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(metrics)),
+	))
+
+	// When
+	_, err := client.GetFeature("00000000-0000-0000-0000-000000000000")
+
+	// Then
+	require.Error(t, err)
+	ended := spans.Ended()
+	require.Len(t, ended, 2)
+	for _, span := range ended {
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Equal(t, int64(404), attributes(span)["http.response.status_code"].AsInt64())
+	}
+	assert.Equal(t, int64(1), sum(t, metrics, "flagsmith.client.requests"))
+	assert.Equal(t, int64(1), sum(t, metrics, "flagsmith.client.request.errors"))
+}